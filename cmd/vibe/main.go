@@ -3,6 +3,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 
@@ -12,6 +13,7 @@ import (
 	vibe "github.com/rithyhuot/vibe"
 	"github.com/rithyhuot/vibe/internal/commands"
 	"github.com/rithyhuot/vibe/internal/config"
+	"github.com/rithyhuot/vibe/internal/output"
 )
 
 // commandContextKey is defined in the commands package to ensure type consistency
@@ -24,11 +26,17 @@ var (
 	BuildTime = "unknown"
 
 	// Global flags
-	configFile string
+	configFile   string
+	outputFormat string
+	noCache      bool
 )
 
 func main() {
 	if err := run(); err != nil {
+		var exitErr *commands.ExitError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.Code)
+		}
 		red := color.New(color.FgRed, color.Bold)
 		_, _ = red.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
@@ -38,21 +46,26 @@ func main() {
 func run() error {
 	rootCmd := &cobra.Command{
 		Use:   "vibe",
-		Short: "A CLI tool to streamline developer workflow with ClickUp, GitHub, and CircleCI",
-		Long: `vibe is a production-quality CLI tool that integrates ClickUp (project management),
+		Short: "A CLI tool to streamline developer workflow with ClickUp, Jira or Linear, GitHub, and CircleCI",
+		Long: `vibe is a production-quality CLI tool that integrates ClickUp, Jira or Linear (project management),
 GitHub (code repository), and CircleCI (CI/CD) to streamline developer workflow
 from ticket assignment to PR merge.`,
 		Version:      fmt.Sprintf("%s (built: %s)", Version, BuildTime),
 		SilenceUsage: true,
+		// Errors are printed by main, which also handles ExitError
+		SilenceErrors: true,
 	}
 
 	// Global flags
 	rootCmd.PersistentFlags().StringVar(&configFile, "config", "", "config file (default is ~/.config/vibe/config.yaml)")
+	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "text", "output format for read commands: text, json, or yaml")
+	rootCmd.PersistentFlags().BoolVar(&noCache, "no-cache", false, "bypass cached API responses (fresh responses are still stored)")
 
 	// Add commands that don't require config
 	rootCmd.AddCommand(commands.NewInitCommand(vibe.SkillsFS))
 	rootCmd.AddCommand(commands.NewSkillsCommand(vibe.SkillsFS))
 	rootCmd.AddCommand(newCompletionCommand())
+	rootCmd.AddCommand(commands.NewCacheCommand())
 
 	// Add config-dependent commands
 	addConfigDependentCommands(rootCmd)
@@ -145,17 +158,24 @@ func addConfigDependentCommands(rootCmd *cobra.Command) {
 			return cmdCtx, nil
 		}
 
+		format, err := output.ParseFormat(outputFormat)
+		if err != nil {
+			return nil, err
+		}
+
 		// Load config
 		cfg, err := config.Load(configFile)
 		if err != nil {
 			return nil, fmt.Errorf("failed to load configuration: %w\n\nRun 'vibe init' to create a configuration file", err)
 		}
+		cfg.Cache.Refresh = noCache
 
 		// Create command context
 		cmdCtx, err = commands.NewCommandContext(cfg)
 		if err != nil {
 			return nil, fmt.Errorf("failed to initialize: %w", err)
 		}
+		cmdCtx.Output = format
 
 		return cmdCtx, nil
 	}
@@ -169,7 +189,10 @@ func addConfigDependentCommands(rootCmd *cobra.Command) {
 Examples:
   vibe ticket                    # View ticket for current branch
   vibe ticket abc123             # View specific ticket by ID
-  vibe ticket 86b7x5453          # View ticket with full ClickUp ID`,
+  vibe ticket 86b7x5453          # View ticket with full ClickUp ID
+  vibe ticket ABC-123            # View a Jira issue
+  vibe ticket ENG-482            # View a Linear issue
+  vibe ticket -o json            # Output ticket as JSON`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(_ *cobra.Command, args []string) error {
 			ctx, err := getContext()
@@ -185,11 +208,13 @@ Examples:
 	workonCmd := &cobra.Command{
 		Use:   "workon <ticket-id>",
 		Short: "Start working on a ticket",
-		Long: `Fetches a task from ClickUp, creates a branch, and updates the task status.
+		Long: `Fetches a ticket from ClickUp, Jira or Linear, creates a branch, and updates the ticket status.
 
 Examples:
   vibe workon abc123             # Start working on ticket abc123
   vibe workon 86b7x5453          # Start working with full ClickUp ID
+  vibe workon ABC-123            # Start working on a Jira issue
+  vibe workon ENG-482            # Start working on a Linear issue
   vibe abc123                    # Shorthand: vibe command works the same`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(_ *cobra.Command, args []string) error {
@@ -228,12 +253,12 @@ Examples:
 	dummyCtx := &commands.CommandContext{}
 
 	prCmd := commands.NewPRCommand(dummyCtx)
-	prCmd.PreRunE = func(cmd *cobra.Command, _ []string) error {
+	prCmd.PersistentPreRunE = func(cmd *cobra.Command, _ []string) error {
 		ctx, err := getContext()
 		if err != nil {
 			return err
 		}
-		// Store context in cobra's context so RunE can access it
+		// Store context in cobra's context so RunE and the subcommands can access it
 		cmd.SetContext(context.WithValue(cmd.Context(), commandContextKey, ctx))
 		return nil
 	}
@@ -249,12 +274,12 @@ Examples:
 	}
 
 	prUpdateCmd := commands.NewPRUpdateCommand(dummyCtx)
-	prUpdateCmd.PreRunE = func(_ *cobra.Command, _ []string) error {
+	prUpdateCmd.PreRunE = func(cmd *cobra.Command, _ []string) error {
 		ctx, err := getContext()
 		if err != nil {
 			return err
 		}
-		*prUpdateCmd = *commands.NewPRUpdateCommand(ctx)
+		cmd.SetContext(context.WithValue(cmd.Context(), commandContextKey, ctx))
 		return nil
 	}
 
@@ -271,34 +296,73 @@ Examples:
 
 	// Merge command
 	mergeCmd := commands.NewMergeCommand(dummyCtx)
-	mergeCmd.PreRunE = func(_ *cobra.Command, _ []string) error {
+	mergeCmd.PreRunE = func(cmd *cobra.Command, _ []string) error {
+		ctx, err := getContext()
+		if err != nil {
+			return err
+		}
+		// Store context in cobra's context so RunE can access it
+		cmd.SetContext(context.WithValue(cmd.Context(), commandContextKey, ctx))
+		return nil
+	}
+
+	// Review command
+	reviewCmd := commands.NewReviewCommand(dummyCtx)
+	reviewCmd.PreRunE = func(cmd *cobra.Command, _ []string) error {
+		ctx, err := getContext()
+		if err != nil {
+			return err
+		}
+		// Store context in cobra's context so RunE can access it
+		cmd.SetContext(context.WithValue(cmd.Context(), commandContextKey, ctx))
+		return nil
+	}
+
+	// Stack command group
+	stackCmd := commands.NewStackCommand(dummyCtx)
+	stackCmd.PersistentPreRunE = func(cmd *cobra.Command, _ []string) error {
 		ctx, err := getContext()
 		if err != nil {
 			return err
 		}
-		*mergeCmd = *commands.NewMergeCommand(ctx)
+		// Store context in cobra's context so the subcommands can access it
+		cmd.SetContext(context.WithValue(cmd.Context(), commandContextKey, ctx))
 		return nil
 	}
 
 	// CI Status command
 	ciStatusCmd := commands.NewCIStatusCommand(dummyCtx)
-	ciStatusCmd.PreRunE = func(_ *cobra.Command, _ []string) error {
+	ciStatusCmd.PreRunE = func(cmd *cobra.Command, _ []string) error {
 		ctx, err := getContext()
 		if err != nil {
 			return err
 		}
-		*ciStatusCmd = *commands.NewCIStatusCommand(ctx)
+		// Store context in cobra's context so RunE can access it
+		cmd.SetContext(context.WithValue(cmd.Context(), commandContextKey, ctx))
 		return nil
 	}
 
 	// CI Failure command
 	ciFailureCmd := commands.NewCIFailureCommand(dummyCtx)
-	ciFailureCmd.PreRunE = func(_ *cobra.Command, _ []string) error {
+	ciFailureCmd.PreRunE = func(cmd *cobra.Command, _ []string) error {
 		ctx, err := getContext()
 		if err != nil {
 			return err
 		}
-		*ciFailureCmd = *commands.NewCIFailureCommand(ctx)
+		// Store context in cobra's context so RunE can access it
+		cmd.SetContext(context.WithValue(cmd.Context(), commandContextKey, ctx))
+		return nil
+	}
+
+	// CI command group
+	ciCmd := commands.NewCICommand(dummyCtx)
+	ciCmd.PersistentPreRunE = func(cmd *cobra.Command, _ []string) error {
+		ctx, err := getContext()
+		if err != nil {
+			return err
+		}
+		// Store context in cobra's context so the subcommands can access it
+		cmd.SetContext(context.WithValue(cmd.Context(), commandContextKey, ctx))
 		return nil
 	}
 
@@ -358,6 +422,31 @@ Examples:
 		return nil
 	}
 
+	// Ticket create subcommand
+	ticketCreateCmd := commands.NewTicketCreateCommand(dummyCtx)
+	ticketCreateCmd.PreRunE = func(cmd *cobra.Command, _ []string) error {
+		ctx, err := getContext()
+		if err != nil {
+			return err
+		}
+		// Store context in cobra's context so RunE can access it
+		cmd.SetContext(context.WithValue(cmd.Context(), commandContextKey, ctx))
+		return nil
+	}
+	ticketCmd.AddCommand(ticketCreateCmd)
+
+	// Sprint command
+	sprintCmd := commands.NewSprintCommand(dummyCtx)
+	sprintCmd.PreRunE = func(cmd *cobra.Command, _ []string) error {
+		ctx, err := getContext()
+		if err != nil {
+			return err
+		}
+		// Store context in cobra's context so RunE can access it
+		cmd.SetContext(context.WithValue(cmd.Context(), commandContextKey, ctx))
+		return nil
+	}
+
 	// Add vibeCmd handling to root so "vibe <ticket-id>" works directly
 	rootCmd.Args = func(cmd *cobra.Command, args []string) error {
 		// If there's exactly one arg and it's not a subcommand, treat it as a ticket ID
@@ -399,5 +488,5 @@ Examples:
 		return cmd.Help()
 	}
 
-	rootCmd.AddCommand(workonCmd, ticketCmd, commentCmd, prCmd, prStatusCmd, prUpdateCmd, startCmd, mergeCmd, ciStatusCmd, ciFailureCmd, issuesCmd, issueCmd, issueCreateCmd, issueUpdateCmd, branchCmd)
+	rootCmd.AddCommand(workonCmd, ticketCmd, commentCmd, prCmd, prStatusCmd, prUpdateCmd, startCmd, mergeCmd, reviewCmd, stackCmd, ciStatusCmd, ciFailureCmd, ciCmd, issuesCmd, issueCmd, issueCreateCmd, issueUpdateCmd, branchCmd, sprintCmd)
 }
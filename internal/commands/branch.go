@@ -27,6 +27,7 @@ Branch formats:
 
 Examples:
   vibe branch abc123xyz          # Create branch with ticket ID
+  vibe branch ABC-123            # Create branch with a Jira key
   vibe branch                    # Interactive: prompts for description`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(_ *cobra.Command, args []string) error {
@@ -54,13 +55,14 @@ func runBranch(ctx *CommandContext, ticketID string) error {
 
 	if ticketID != "" {
 		// Validate ticket ID format
-		if !utils.IsTicketID(ticketID) {
-			return fmt.Errorf("invalid ticket ID format: '%s'\n\nTicket ID must be exactly 9 alphanumeric characters (e.g., abc123xyz)", ticketID)
+		ticketID = utils.NormalizeTicketID(ticketID)
+		if !isTicketID(ctx, ticketID) {
+			return fmt.Errorf("invalid ticket ID format: '%s'\n\nTicket ID must be %s", ticketID, ticketIDFormat(ctx))
 		}
 
-		// Create branch name with ticket ID using GenerateBranchName
+		// Create branch name with ticket ID in the configured branch style
 		// Pass empty string for prefix and title to get format: username/ticketid
-		branchName = utils.GenerateBranchName("", ticketID, "", username)
+		branchName = ticketBranchName(ctx, "", ticketID, "", username)
 	} else {
 		// Interactive mode: prompt for branch description
 		var description string
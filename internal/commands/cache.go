@@ -0,0 +1,169 @@
+package commands
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/rithyhuot/vibe/internal/config"
+	"github.com/rithyhuot/vibe/internal/utils"
+)
+
+// cacheNamespaces lists the namespaces that can be passed to cache clear
+var cacheNamespaces = []string{cacheNamespaceClickUp, cacheNamespaceJira, cacheNamespaceGitHub, cacheNamespaceGitLab, cacheNamespaceCircleCI, cacheNamespaceSprint}
+
+// NewCacheCommand creates the cache command
+func NewCacheCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Manage the on-disk response cache",
+		Long: `Inspect or clear the response cache stored under ~/.config/vibe/cache.
+
+GitHub and CircleCI responses are revalidated with ETag/Last-Modified, so
+unchanged data is answered with a 304 that doesn't count against rate limits.
+Use the global --no-cache flag to bypass the cache for a single command.
+
+Examples:
+  vibe cache stats               # Show entries and size per service
+  vibe cache clear               # Remove all cached responses
+  vibe cache clear github        # Remove cached GitHub responses only`,
+	}
+
+	cmd.AddCommand(newCacheStatsCommand(), newCacheClearCommand())
+
+	return cmd
+}
+
+func newCacheStatsCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "stats",
+		Short: "Show cache statistics",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			store, err := openDiskCache()
+			if err != nil {
+				return err
+			}
+			return runCacheStats(store)
+		},
+	}
+}
+
+func newCacheClearCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:       "clear [service]",
+		Short:     "Remove cached responses",
+		Args:      cobra.MaximumNArgs(1),
+		ValidArgs: cacheNamespaces,
+		RunE: func(_ *cobra.Command, args []string) error {
+			store, err := openDiskCache()
+			if err != nil {
+				return err
+			}
+			return runCacheClear(store, args)
+		},
+	}
+}
+
+// openDiskCache opens the disk cache in the default location
+func openDiskCache() (*utils.DiskCache, error) {
+	dir, err := config.GetCacheDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine cache directory: %w", err)
+	}
+	return utils.NewDiskCache(dir), nil
+}
+
+func runCacheStats(store *utils.DiskCache) error {
+	bold := color.New(color.Bold)
+	dim := color.New(color.Faint)
+
+	stats, err := store.Stats()
+	if err != nil {
+		return fmt.Errorf("failed to read cache: %w", err)
+	}
+
+	fmt.Println()
+	_, _ = bold.Println("Response Cache")
+	_, _ = dim.Printf("Location: %s\n", store.Dir())
+	fmt.Println()
+
+	if len(stats) == 0 {
+		_, _ = dim.Println("The cache is empty.")
+		return nil
+	}
+
+	fmt.Printf("%-10s %8s %8s %10s  %s\n", "SERVICE", "ENTRIES", "EXPIRED", "SIZE", "LAST UPDATED")
+
+	var totalEntries int
+	var totalBytes int64
+	for _, s := range stats {
+		lastUpdated := "-"
+		if !s.Newest.IsZero() {
+			lastUpdated = formatCacheAge(time.Since(s.Newest))
+		}
+		fmt.Printf("%-10s %8d %8d %10s  %s\n", s.Namespace, s.Entries, s.Expired, formatBytes(s.Bytes), lastUpdated)
+		totalEntries += s.Entries
+		totalBytes += s.Bytes
+	}
+
+	fmt.Println()
+	_, _ = dim.Printf("%d entries, %s total\n", totalEntries, formatBytes(totalBytes))
+
+	return nil
+}
+
+func runCacheClear(store *utils.DiskCache, args []string) error {
+	green := color.New(color.FgGreen)
+
+	var namespaces []string
+	target := "all services"
+	if len(args) > 0 {
+		if !slices.Contains(cacheNamespaces, args[0]) {
+			return fmt.Errorf("unknown cache service: %s (must be one of: %s)", args[0], strings.Join(cacheNamespaces, ", "))
+		}
+		namespaces = []string{args[0]}
+		target = args[0]
+	}
+
+	removed, err := store.Clear(namespaces...)
+	if err != nil {
+		return fmt.Errorf("failed to clear cache: %w", err)
+	}
+
+	_, _ = green.Printf("✓ Removed %d cached entries for %s\n", removed, target)
+
+	return nil
+}
+
+// formatBytes formats a byte count with a binary unit, e.g. "1.5 KiB"
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// formatCacheAge formats how long ago an entry was stored, e.g. "5m ago"
+func formatCacheAge(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		return fmt.Sprintf("%dm ago", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd ago", int(d.Hours()/24))
+	}
+}
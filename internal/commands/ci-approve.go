@@ -0,0 +1,110 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/rithyhuot/vibe/internal/services/circleci"
+)
+
+// newCIApproveCommand creates the ci approve command
+func newCIApproveCommand(ctx *CommandContext) *cobra.Command {
+	opts := &CIActionOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "approve <hold-job>",
+		Short: "Approve a hold job in the latest pipeline",
+		Long: `Approves an approval (hold) job in the latest CircleCI pipeline for a branch, letting its workflow continue.
+
+Examples:
+  vibe ci approve hold-deploy                # Approve on the current branch
+  vibe ci approve hold-deploy --branch main  # Approve on main`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cobraCmd *cobra.Command, args []string) error {
+			ctx = getCommandContext(cobraCmd, ctx)
+			return runCIApprove(ctx, opts, args[0])
+		},
+	}
+
+	addCIActionFlags(cmd, opts)
+
+	return cmd
+}
+
+func runCIApprove(ctx *CommandContext, opts *CIActionOptions, jobName string) error {
+	client, projectSlug, err := requireCircleCI(ctx, "vibe ci approve", opts.Project)
+	if err != nil {
+		return err
+	}
+
+	status, err := fetchLatestPipeline(ctx, client, projectSlug, opts.Branch)
+	if err != nil || status == nil {
+		return err
+	}
+
+	workflow, job, err := findHoldJob(status.Workflows, jobName)
+	if err != nil {
+		return err
+	}
+
+	confirmed, err := confirmCIAction(opts, fmt.Sprintf("Approve %s in %s (pipeline #%d)?", job.Name, workflow.Name, status.PipelineNumber))
+	if err != nil || !confirmed {
+		return err
+	}
+
+	approvalID := job.ApprovalRequestID
+	if approvalID == "" {
+		approvalID = job.ID
+	}
+
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	s.Suffix = fmt.Sprintf(" Approving %s...", job.Name)
+	s.Start()
+
+	err = client.ApproveJob(context.Background(), workflow.ID, approvalID)
+	s.Stop()
+
+	if err != nil {
+		return err
+	}
+
+	green := color.New(color.FgGreen)
+	dim := color.New(color.Faint)
+	_, _ = green.Printf("✓ Approved %s\n", job.Name)
+	_, _ = dim.Printf("  Workflow %s (%s) continues\n", workflow.Name, workflow.ID)
+
+	return nil
+}
+
+// findHoldJob finds an approval job waiting for approval by name
+func findHoldJob(workflows []circleci.WorkflowStatus, name string) (*circleci.WorkflowStatus, *circleci.Job, error) {
+	var waiting []string
+	for i := range workflows {
+		for j := range workflows[i].Jobs {
+			job := &workflows[i].Jobs[j]
+			if job.Type != "approval" {
+				continue
+			}
+			if job.Name == name {
+				if job.Status != "on_hold" {
+					return nil, nil, fmt.Errorf("job %s isn't waiting for approval (status: %s)", name, job.Status)
+				}
+				return &workflows[i], job, nil
+			}
+			if job.Status == "on_hold" {
+				waiting = append(waiting, job.Name)
+			}
+		}
+	}
+
+	if len(waiting) == 0 {
+		return nil, nil, fmt.Errorf("no approval job named %s, and no jobs are waiting for approval", name)
+	}
+	return nil, nil, fmt.Errorf("no approval job named %s (waiting for approval: %s)", name, strings.Join(waiting, ", "))
+}
@@ -0,0 +1,374 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/rithyhuot/vibe/internal/output"
+	"github.com/rithyhuot/vibe/internal/services/circleci"
+)
+
+// maxArtifactDownloads is how many artifacts are downloaded at once
+const maxArtifactDownloads = 4
+
+// CIArtifactsOptions holds flags for the ci artifacts command
+type CIArtifactsOptions struct {
+	Branch   string
+	Project  string
+	Download string
+	Dest     string
+}
+
+// newCIArtifactsCommand creates the ci artifacts command
+func newCIArtifactsCommand(ctx *CommandContext) *cobra.Command {
+	opts := &CIArtifactsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "artifacts [job-number]",
+		Short: "List and download job artifacts",
+		Long: `Lists the artifacts a CircleCI job stored, such as test reports, screenshots,
+and coverage files. If no job number is provided, uses the first failed job
+from the current branch's latest pipeline.
+
+With --download, the artifacts whose paths match a glob are downloaded
+concurrently into --dest, keeping their paths. Globs without a slash match file
+names in any directory.
+
+Examples:
+  vibe ci artifacts                        # List artifacts of the first failed job
+  vibe ci artifacts 12345                  # List artifacts of job #12345
+  vibe ci artifacts --download '*.png'     # Download screenshots
+  vibe ci artifacts 12345 --download 'coverage/**' --dest /tmp/coverage
+  vibe ci artifacts --download '*'         # Download everything`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cobraCmd *cobra.Command, args []string) error {
+			ctx = getCommandContext(cobraCmd, ctx)
+			jobNumberArg := ""
+			if len(args) > 0 {
+				jobNumberArg = args[0]
+			}
+			return runCIArtifacts(ctx, opts, jobNumberArg)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Branch, "branch", "", "Branch whose first failed job to use (default: current branch)")
+	addCIProjectFlag(cmd, &opts.Project)
+	cmd.Flags().StringVar(&opts.Download, "download", "", "Download the artifacts whose paths match this glob")
+	cmd.Flags().StringVar(&opts.Dest, "dest", "", "Directory to download into (default: artifacts/<job-number>)")
+
+	return cmd
+}
+
+// artifactDownload is the result of downloading one artifact
+type artifactDownload struct {
+	Artifact circleci.Artifact
+	File     string
+	Size     int64
+	Err      error
+}
+
+func runCIArtifacts(ctx *CommandContext, opts *CIArtifactsOptions, jobNumberArg string) error {
+	client, projectSlug, err := requireCircleCI(ctx, "vibe ci artifacts", opts.Project)
+	if err != nil {
+		return err
+	}
+
+	var jobNumber int
+	if jobNumberArg != "" {
+		jobNumber, err = strconv.Atoi(jobNumberArg)
+		if err != nil {
+			return fmt.Errorf("invalid job number: %s", jobNumberArg)
+		}
+	} else {
+		job, err := findFirstFailedJob(ctx, client, projectSlug, opts.Branch)
+		if err != nil || job == nil {
+			return err
+		}
+		jobNumber = job.JobNumber
+	}
+
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	s.Suffix = fmt.Sprintf(" Fetching artifacts for job #%d...", jobNumber)
+	s.Start()
+
+	artifacts, err := client.GetJobArtifacts(context.Background(), projectSlug, jobNumber)
+	s.Stop()
+
+	if err != nil {
+		return err
+	}
+
+	if opts.Download != "" {
+		var matched []circleci.Artifact
+		for _, artifact := range artifacts {
+			if matchArtifact(opts.Download, artifact.Path) {
+				matched = append(matched, artifact)
+			}
+		}
+		artifacts = matched
+	}
+
+	if opts.Download == "" || len(artifacts) == 0 {
+		if ctx.Output.IsStructured() {
+			return writeOutput(ctx, output.KindCIArtifacts, newCIArtifactList(jobNumber, artifacts, nil))
+		}
+		displayArtifacts(jobNumber, artifacts, opts.Download)
+		return nil
+	}
+
+	dest := opts.Dest
+	if dest == "" {
+		dest = filepath.Join("artifacts", strconv.Itoa(jobNumber))
+	}
+
+	downloads := downloadArtifacts(client, artifacts, dest)
+
+	if ctx.Output.IsStructured() {
+		if err := writeOutput(ctx, output.KindCIArtifacts, newCIArtifactList(jobNumber, artifacts, downloads)); err != nil {
+			return err
+		}
+	} else {
+		displayArtifactDownloads(downloads, dest)
+	}
+
+	failed := 0
+	for _, download := range downloads {
+		if download.Err != nil {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to download %d of %d artifacts", failed, len(downloads))
+	}
+	return nil
+}
+
+// findFirstFailedJob finds the first failed job in the latest pipeline of a
+// branch, like ci-failure does. Returns nil when there's none.
+func findFirstFailedJob(ctx *CommandContext, client circleci.Client, projectSlug, branch string) (*circleci.FailedJob, error) {
+	if branch == "" {
+		var err error
+		branch, err = ctx.GitRepo.CurrentBranch()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get current branch: %w", err)
+		}
+	}
+
+	cyan := color.New(color.FgCyan)
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	s.Suffix = fmt.Sprintf(" Finding failed jobs for %s...", cyan.Sprint(branch))
+	s.Start()
+
+	status, err := client.GetCIStatusForBranch(context.Background(), branch, projectSlug)
+	s.Stop()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch CI status: %w", err)
+	}
+
+	if status == nil || len(status.FailedJobs) == 0 {
+		switch {
+		case ctx.Output.IsStructured():
+			return nil, writeOutput(ctx, output.KindCIArtifacts, nil)
+		case status == nil:
+			yellow := color.New(color.FgYellow)
+			_, _ = yellow.Printf("No CI pipelines found for branch: %s\n", branch)
+		default:
+			green := color.New(color.FgGreen)
+			_, _ = green.Println("No failed jobs found. Pass a job number to see its artifacts.")
+		}
+		return nil, nil
+	}
+
+	job := status.FailedJobs[0]
+	if !ctx.Output.IsStructured() {
+		dim := color.New(color.Faint)
+		_, _ = dim.Printf("Found failed job: %s > %s (#%d)\n", job.WorkflowName, job.Name, job.JobNumber)
+	}
+	return &job, nil
+}
+
+// matchArtifact reports whether an artifact path matches a glob. Globs
+// without a slash match the file name, so *.png finds screenshots anywhere,
+// and a trailing /** matches everything under a directory.
+func matchArtifact(glob, artifactPath string) bool {
+	if dir, ok := strings.CutSuffix(glob, "/**"); ok {
+		return strings.HasPrefix(artifactPath, dir+"/")
+	}
+	if ok, _ := path.Match(glob, artifactPath); ok {
+		return true
+	}
+	if !strings.Contains(glob, "/") {
+		ok, _ := path.Match(glob, path.Base(artifactPath))
+		return ok
+	}
+	return false
+}
+
+// downloadArtifacts downloads artifacts into dest concurrently, showing
+// progress while they download
+func downloadArtifacts(client circleci.Client, artifacts []circleci.Artifact, dest string) []artifactDownload {
+	// Parallel jobs can store the same path on each node
+	perNode := false
+	for _, artifact := range artifacts {
+		if artifact.NodeIndex > 0 {
+			perNode = true
+			break
+		}
+	}
+
+	downloads := make([]artifactDownload, len(artifacts))
+
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	s.Suffix = fmt.Sprintf(" Downloading %d artifacts...", len(artifacts))
+	s.Start()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	done := 0
+	var total int64
+	sem := make(chan struct{}, maxArtifactDownloads)
+
+	for i, artifact := range artifacts {
+		wg.Add(1)
+		go func(i int, artifact circleci.Artifact) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			download := downloadArtifact(client, artifact, dest, perNode)
+			downloads[i] = download
+
+			mu.Lock()
+			done++
+			total += download.Size
+			s.Lock()
+			s.Suffix = fmt.Sprintf(" Downloading artifacts... %d/%d (%s)", done, len(artifacts), formatBytes(total))
+			s.Unlock()
+			mu.Unlock()
+		}(i, artifact)
+	}
+
+	wg.Wait()
+	s.Stop()
+
+	return downloads
+}
+
+// downloadArtifact downloads one artifact to its path under dest
+func downloadArtifact(client circleci.Client, artifact circleci.Artifact, dest string, perNode bool) artifactDownload {
+	download := artifactDownload{Artifact: artifact}
+
+	// Cleaning against the root keeps ".." from escaping dest
+	rel := strings.TrimPrefix(path.Clean("/"+artifact.Path), "/")
+	if rel == "" {
+		download.Err = fmt.Errorf("invalid artifact path %q", artifact.Path)
+		return download
+	}
+	if perNode {
+		rel = path.Join(fmt.Sprintf("node-%d", artifact.NodeIndex), rel)
+	}
+	download.File = filepath.Join(dest, filepath.FromSlash(rel))
+
+	if err := os.MkdirAll(filepath.Dir(download.File), 0o755); err != nil {
+		download.Err = fmt.Errorf("failed to create directory: %w", err)
+		return download
+	}
+
+	f, err := os.Create(download.File)
+	if err != nil {
+		download.Err = fmt.Errorf("failed to create file: %w", err)
+		return download
+	}
+
+	download.Size, download.Err = client.DownloadArtifact(context.Background(), artifact.URL, f)
+	if err := f.Close(); err != nil && download.Err == nil {
+		download.Err = fmt.Errorf("failed to write file: %w", err)
+	}
+	if download.Err != nil {
+		_ = os.Remove(download.File)
+	}
+	return download
+}
+
+// newCIArtifactList converts artifacts, and their downloads if any, to their structured form
+func newCIArtifactList(jobNumber int, artifacts []circleci.Artifact, downloads []artifactDownload) output.CIArtifactList {
+	result := output.CIArtifactList{
+		JobNumber: jobNumber,
+		Artifacts: make([]output.CIArtifact, len(artifacts)),
+	}
+	for i, artifact := range artifacts {
+		result.Artifacts[i] = output.CIArtifact{
+			Path:      artifact.Path,
+			NodeIndex: artifact.NodeIndex,
+			URL:       artifact.URL,
+		}
+		if i < len(downloads) && downloads[i].Err == nil {
+			result.Artifacts[i].File = downloads[i].File
+		}
+	}
+	return result
+}
+
+func displayArtifacts(jobNumber int, artifacts []circleci.Artifact, glob string) {
+	bold := color.New(color.Bold)
+	dim := color.New(color.Faint)
+	yellow := color.New(color.FgYellow)
+
+	if len(artifacts) == 0 {
+		if glob != "" {
+			_, _ = yellow.Printf("No artifacts of job #%d match %s\n", jobNumber, glob)
+		} else {
+			_, _ = yellow.Printf("Job #%d has no artifacts\n", jobNumber)
+		}
+		return
+	}
+
+	fmt.Println()
+	_, _ = bold.Printf("Artifacts for job #%d (%d):\n", jobNumber, len(artifacts))
+	for _, artifact := range artifacts {
+		if artifact.NodeIndex > 0 {
+			fmt.Printf("  %s %s\n", artifact.Path, dim.Sprintf("(node %d)", artifact.NodeIndex))
+		} else {
+			fmt.Printf("  %s\n", artifact.Path)
+		}
+	}
+	fmt.Println()
+
+	cyan := color.New(color.FgCyan)
+	_, _ = dim.Printf("Run %s to download them\n", cyan.Sprintf("vibe ci artifacts %d --download '<glob>'", jobNumber))
+}
+
+func displayArtifactDownloads(downloads []artifactDownload, dest string) {
+	green := color.New(color.FgGreen)
+	red := color.New(color.FgRed)
+	dim := color.New(color.Faint)
+
+	var total int64
+	saved := 0
+	for _, download := range downloads {
+		if download.Err != nil {
+			_, _ = red.Printf("✗ %s: %v\n", download.Artifact.Path, download.Err)
+			continue
+		}
+		saved++
+		total += download.Size
+		fmt.Printf("%s %s %s\n", green.Sprint("✓"), download.File, dim.Sprintf("(%s)", formatBytes(download.Size)))
+	}
+
+	if saved > 0 {
+		fmt.Println()
+		_, _ = green.Printf("Downloaded %d artifact(s), %s, to %s\n", saved, formatBytes(total), dest)
+	}
+}
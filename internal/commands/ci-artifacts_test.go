@@ -0,0 +1,105 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rithyhuot/vibe/internal/services/circleci"
+)
+
+// fakeArtifactClient serves artifact downloads from memory
+type fakeArtifactClient struct {
+	circleci.Client
+	contents map[string]string
+}
+
+func (c *fakeArtifactClient) DownloadArtifact(_ context.Context, artifactURL string, w io.Writer) (int64, error) {
+	content, ok := c.contents[artifactURL]
+	if !ok {
+		return 0, errors.New("not found")
+	}
+	n, err := io.Copy(w, strings.NewReader(content))
+	return n, err
+}
+
+func TestMatchArtifact(t *testing.T) {
+	tests := []struct {
+		name     string
+		glob     string
+		path     string
+		expected bool
+	}{
+		{name: "exact path", glob: "coverage/index.html", path: "coverage/index.html", expected: true},
+		{name: "glob in a directory", glob: "coverage/*.html", path: "coverage/index.html", expected: true},
+		{name: "glob doesn't cross directories", glob: "coverage/*.html", path: "coverage/lib/util.html", expected: false},
+		{name: "glob without a directory matches the file name", glob: "*.xml", path: "test-results/jest/junit.xml", expected: true},
+		{name: "file name mismatch", glob: "*.xml", path: "test-results/report.json", expected: false},
+		{name: "directory and everything below it", glob: "screenshots/**", path: "screenshots/e2e/login.png", expected: true},
+		{name: "directory prefix isn't a directory", glob: "screenshots/**", path: "screenshots-old/login.png", expected: false},
+		{name: "directory itself", glob: "screenshots/**", path: "screenshots", expected: false},
+		{name: "malformed glob", glob: "coverage/[", path: "coverage/[", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, matchArtifact(tt.glob, tt.path))
+		})
+	}
+}
+
+func TestDownloadArtifact(t *testing.T) {
+	client := &fakeArtifactClient{contents: map[string]string{"https://example.com/a": "artifact"}}
+
+	tests := []struct {
+		name     string
+		artifact circleci.Artifact
+		perNode  bool
+		expected string // Path of the file under dest, empty when the download fails
+	}{
+		{name: "nested path", artifact: circleci.Artifact{Path: "coverage/index.html"}, expected: "coverage/index.html"},
+		{name: "absolute path stays in dest", artifact: circleci.Artifact{Path: "/tmp/report.xml"}, expected: "tmp/report.xml"},
+		{name: "parent directories stay in dest", artifact: circleci.Artifact{Path: "../../etc/passwd"}, expected: "etc/passwd"},
+		{name: "parent directories inside the path", artifact: circleci.Artifact{Path: "logs/../../secret"}, expected: "secret"},
+		{name: "per node", artifact: circleci.Artifact{Path: "logs/test.log", NodeIndex: 2}, perNode: true, expected: "node-2/logs/test.log"},
+		{name: "empty path", artifact: circleci.Artifact{Path: "/"}, expected: ""},
+		{name: "only parent directories", artifact: circleci.Artifact{Path: "../.."}, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dest := t.TempDir()
+			tt.artifact.URL = "https://example.com/a"
+
+			download := downloadArtifact(client, tt.artifact, dest, tt.perNode)
+			if tt.expected == "" {
+				assert.Error(t, download.Err)
+				return
+			}
+
+			assert.NoError(t, download.Err)
+			assert.Equal(t, filepath.Join(dest, filepath.FromSlash(tt.expected)), download.File)
+			assert.Equal(t, int64(len("artifact")), download.Size)
+			content, err := os.ReadFile(download.File)
+			assert.NoError(t, err)
+			assert.Equal(t, "artifact", string(content))
+		})
+	}
+}
+
+func TestDownloadArtifact_FailureRemovesFile(t *testing.T) {
+	client := &fakeArtifactClient{}
+	dest := t.TempDir()
+
+	download := downloadArtifact(client, circleci.Artifact{Path: "coverage/index.html", URL: "https://example.com/missing"}, dest, false)
+
+	assert.Error(t, download.Err)
+	_, err := os.Stat(download.File)
+	assert.True(t, os.IsNotExist(err), "expected the partial file to be removed")
+}
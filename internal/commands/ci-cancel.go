@@ -0,0 +1,105 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/rithyhuot/vibe/internal/services/circleci"
+)
+
+// newCICancelCommand creates the ci cancel command
+func newCICancelCommand(ctx *CommandContext) *cobra.Command {
+	opts := &CIActionOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "cancel",
+		Short: "Cancel the running workflows of the latest pipeline",
+		Long: `Cancels the running, failing, and on-hold workflows of the latest CircleCI pipeline for a branch.
+
+Examples:
+  vibe ci cancel                 # Cancel workflows on the current branch
+  vibe ci cancel --branch main   # Cancel workflows on main
+  vibe ci cancel -y              # Cancel without confirming`,
+		Args: cobra.NoArgs,
+		RunE: func(cobraCmd *cobra.Command, _ []string) error {
+			ctx = getCommandContext(cobraCmd, ctx)
+			return runCICancel(ctx, opts)
+		},
+	}
+
+	addCIActionFlags(cmd, opts)
+
+	return cmd
+}
+
+func runCICancel(ctx *CommandContext, opts *CIActionOptions) error {
+	client, projectSlug, err := requireCircleCI(ctx, "vibe ci cancel", opts.Project)
+	if err != nil {
+		return err
+	}
+
+	status, err := fetchLatestPipeline(ctx, client, projectSlug, opts.Branch)
+	if err != nil || status == nil {
+		return err
+	}
+
+	active := cancelableWorkflows(status.Workflows)
+	if len(active) == 0 {
+		yellow := color.New(color.FgYellow)
+		_, _ = yellow.Println("No running workflows to cancel.")
+		return nil
+	}
+
+	bold := color.New(color.Bold)
+	fmt.Println()
+	_, _ = bold.Printf("Pipeline #%d (%s)\n", status.PipelineNumber, status.Branch)
+	for _, workflow := range active {
+		fmt.Printf("  %s %s\n", formatWorkflowStatus(workflow.Status), workflow.Name)
+	}
+	fmt.Println()
+
+	confirmed, err := confirmCIAction(opts, fmt.Sprintf("Cancel %d workflow(s)?", len(active)))
+	if err != nil || !confirmed {
+		return err
+	}
+
+	green := color.New(color.FgGreen)
+	dim := color.New(color.Faint)
+	cmdCtx := context.Background()
+
+	for _, workflow := range active {
+		s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+		s.Suffix = fmt.Sprintf(" Canceling %s...", workflow.Name)
+		s.Start()
+
+		err := client.CancelWorkflow(cmdCtx, workflow.ID)
+		s.Stop()
+
+		if err != nil {
+			return fmt.Errorf("failed to cancel %s: %w", workflow.Name, err)
+		}
+
+		_, _ = green.Printf("✓ Canceled %s\n", workflow.Name)
+		_, _ = dim.Printf("  Workflow: %s\n", workflow.ID)
+	}
+
+	return nil
+}
+
+// cancelableWorkflows returns the workflows that are still running or waiting
+// for approval
+func cancelableWorkflows(workflows []circleci.WorkflowStatus) []circleci.WorkflowStatus {
+	var active []circleci.WorkflowStatus
+	for _, workflow := range workflows {
+		switch workflow.Status {
+		case "running", "failing", "on_hold":
+			active = append(active, workflow)
+		}
+	}
+	return active
+}
@@ -0,0 +1,294 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/briandowns/spinner"
+	"github.com/fatih/color"
+
+	"github.com/rithyhuot/vibe/internal/models"
+	"github.com/rithyhuot/vibe/internal/output"
+	"github.com/rithyhuot/vibe/internal/services/ci"
+	"github.com/rithyhuot/vibe/internal/services/circleci"
+	"github.com/rithyhuot/vibe/internal/services/claude"
+	"github.com/rithyhuot/vibe/internal/services/github"
+	"github.com/rithyhuot/vibe/internal/utils"
+)
+
+const (
+	// maxExplainLogChars is how much failure log is sent in one request
+	maxExplainLogChars = 100000
+	// maxExplainChunks is how many chunks of a larger log are condensed; the
+	// end of a log is the most likely to explain the failure
+	maxExplainChunks = 5
+	// maxExplainDiffChars is how much of the branch diff is sent
+	maxExplainDiffChars = 60000
+	// explainTailLines is how much of each failed step's output is kept
+	explainTailLines = 300
+)
+
+// explainDiffExcludes keeps generated lock files out of the diff sent to Claude
+var explainDiffExcludes = []string{
+	":(exclude)go.sum",
+	":(exclude)package-lock.json",
+	":(exclude)yarn.lock",
+	":(exclude)pnpm-lock.yaml",
+	":(exclude)*.lock",
+}
+
+// explainCIFailure asks Claude to diagnose the failed steps of a job, then
+// shows the analysis and optionally posts it on the branch's pull request
+func explainCIFailure(ctx *CommandContext, opts *CIFailureOptions, branch, projectSlug string, jobNumber int, steps []circleci.FailedStep) error {
+	cmdCtx := context.Background()
+	yellow := color.New(color.FgYellow)
+
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	s.Suffix = " Collecting the failure log and branch diff..."
+	s.Start()
+
+	failureLog, err := condenseFailureLog(cmdCtx, ctx.ClaudeClient, s, buildFailureLog(steps))
+	if err != nil {
+		s.Stop()
+		return fmt.Errorf("failed to condense the failure log: %w", err)
+	}
+
+	diff, diffErr := branchDiff(ctx, branch)
+	if diffErr != nil {
+		diff = "(the branch diff isn't available)"
+	}
+
+	s.Lock()
+	s.Suffix = " Asking Claude to diagnose the failure..."
+	s.Unlock()
+
+	explanation, err := ctx.ClaudeClient.ExplainCIFailure(cmdCtx, failureLog, diff)
+	s.Stop()
+	if err != nil {
+		return fmt.Errorf("failed to explain the failure: %w", err)
+	}
+	explanation = strings.TrimSpace(explanation)
+
+	if diffErr != nil && !ctx.Output.IsStructured() {
+		_, _ = yellow.Printf("⚠ Diagnosed without the branch diff: %v\n", diffErr)
+	}
+
+	if ctx.Output.IsStructured() {
+		result := output.NewCIFailure(projectSlug, jobNumber, steps)
+		result.Explanation = explanation
+		if err := writeOutput(ctx, output.KindCIFailure, result); err != nil {
+			return err
+		}
+	} else {
+		displayFailedSteps(steps, opts.Raw)
+
+		bold := color.New(color.Bold)
+		fmt.Println()
+		_, _ = bold.Println("═══ Claude's analysis ═══")
+		fmt.Println()
+		fmt.Println(explanation)
+	}
+
+	if !opts.Comment {
+		return nil
+	}
+	return commentCIExplanation(ctx, branch, jobNumber, explanation)
+}
+
+// buildFailureLog trims the output of failed steps to what explains the
+// failure: the failures parsed from it and its last lines. Secrets are redacted.
+func buildFailureLog(steps []circleci.FailedStep) string {
+	var b strings.Builder
+	for _, step := range steps {
+		fmt.Fprintf(&b, "=== Failed step: %s ===\n", step.Name)
+		for _, action := range step.Actions {
+			if action.ExitCode != nil {
+				fmt.Fprintf(&b, "Exit code: %d\n", *action.ExitCode)
+			}
+			if action.Output == "" {
+				continue
+			}
+
+			if failures := ci.ExtractFailures(action.Output); len(failures) > 0 {
+				b.WriteString("Parsed failures:\n")
+				for _, f := range failures {
+					fmt.Fprintf(&b, "- [%s] %s %s\n  %s\n", f.Tool, f.Location(), f.Test,
+						strings.ReplaceAll(f.Message, "\n", "\n  "))
+				}
+			}
+
+			tail, total := tailLines(action.Output, explainTailLines)
+			if total > explainTailLines {
+				fmt.Fprintf(&b, "Output (last %d of %d lines):\n", explainTailLines, total)
+			} else {
+				b.WriteString("Output:\n")
+			}
+			b.WriteString(tail)
+			b.WriteString("\n")
+		}
+	}
+	return utils.RedactSecrets(b.String())
+}
+
+// condenseFailureLog fits a failure log into one request. A larger log is
+// split into chunks, and Claude extracts the lines that matter from each.
+func condenseFailureLog(cmdCtx context.Context, client claude.Client, s *spinner.Spinner, failureLog string) (string, error) {
+	if len(failureLog) <= maxExplainLogChars {
+		return failureLog, nil
+	}
+
+	chunks := chunkLines(failureLog, maxExplainLogChars)
+	skipped := 0
+	if len(chunks) > maxExplainChunks {
+		skipped = len(chunks) - maxExplainChunks
+		chunks = chunks[skipped:]
+	}
+
+	var parts []string
+	if skipped > 0 {
+		parts = append(parts, fmt.Sprintf("(the first %d parts of the log were left out)", skipped))
+	}
+	for i, chunk := range chunks {
+		s.Lock()
+		s.Suffix = fmt.Sprintf(" Condensing the failure log (part %d of %d)...", i+1, len(chunks))
+		s.Unlock()
+
+		extract, err := client.ExtractLogErrors(cmdCtx, chunk)
+		if err != nil {
+			return "", err
+		}
+		extract = strings.TrimSpace(extract)
+		if strings.EqualFold(extract, "none") {
+			continue
+		}
+		parts = append(parts, extract)
+	}
+
+	condensed := strings.Join(parts, "\n\n")
+	if len(condensed) > maxExplainLogChars {
+		start := len(condensed) - maxExplainLogChars
+		for start < len(condensed) && !utf8.RuneStart(condensed[start]) {
+			start++
+		}
+		condensed = condensed[start:]
+	}
+	return condensed, nil
+}
+
+// chunkLines splits text into chunks of at most size bytes, at line breaks
+// where possible and never inside a multi-byte character
+func chunkLines(text string, size int) []string {
+	var chunks []string
+	var current strings.Builder
+
+	for _, line := range strings.SplitAfter(text, "\n") {
+		for len(line) > size {
+			if current.Len() > 0 {
+				chunks = append(chunks, current.String())
+				current.Reset()
+			}
+			cut := runeStart(line, size)
+			if cut == 0 {
+				_, cut = utf8.DecodeRuneInString(line)
+			}
+			chunks = append(chunks, line[:cut])
+			line = line[cut:]
+		}
+		if current.Len()+len(line) > size {
+			chunks = append(chunks, current.String())
+			current.Reset()
+		}
+		current.WriteString(line)
+	}
+	if current.Len() > 0 {
+		chunks = append(chunks, current.String())
+	}
+	return chunks
+}
+
+// branchDiff returns the redacted diff of a branch against git.base_branch,
+// preferring origin's copies, without lock files
+func branchDiff(ctx *CommandContext, branch string) (string, error) {
+	base := ctx.Config.Git.BaseBranch
+	if _, err := ctx.GitRepo.RemoteCommit(base); err == nil {
+		base = "origin/" + base
+	}
+
+	head := "HEAD"
+	if current, err := ctx.GitRepo.CurrentBranch(); branch != "" && (err != nil || current != branch) {
+		head = branch
+		if exists, _ := ctx.GitRepo.BranchExists(branch); !exists {
+			head = "origin/" + branch
+		}
+	}
+
+	args := append([]string{"diff", "--no-color", fmt.Sprintf("%s...%s", base, head), "--", ":(top)"}, explainDiffExcludes...)
+	diffOutput, err := exec.Command("git", args...).Output()
+	if err != nil {
+		return "", fmt.Errorf("git diff %s...%s failed: %w", base, head, err)
+	}
+
+	diff := utils.RedactSecrets(string(diffOutput))
+	if diff == "" {
+		return "(no changes against the base branch)", nil
+	}
+	if len(diff) > maxExplainDiffChars {
+		cut := strings.LastIndexByte(diff[:maxExplainDiffChars], '\n')
+		if cut <= 0 {
+			cut = runeStart(diff, maxExplainDiffChars)
+		}
+		diff = diff[:cut] + "\n... (diff truncated)"
+	}
+	return diff, nil
+}
+
+// runeStart backs i up to the start of the character it falls in, so that
+// s[:i] doesn't end in part of a multi-byte character
+func runeStart(s string, i int) int {
+	for i > 0 && i < len(s) && !utf8.RuneStart(s[i]) {
+		i--
+	}
+	return i
+}
+
+// commentCIExplanation posts an analysis on the pull request of a branch
+func commentCIExplanation(ctx *CommandContext, branch string, jobNumber int, explanation string) error {
+	cmdCtx := context.Background()
+
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	s.Suffix = " Posting the analysis on the pull request..."
+	s.Start()
+
+	body := fmt.Sprintf("## CI failure analysis: job #%d\n\n%s\n\n---\n_Generated by `vibe ci-failure --explain`_", jobNumber, explanation)
+
+	// Comment with the client that found the PR, which may be the git remote's repo
+	pr, err := withRepoFallback(ctx, s, func(client github.Client) (*models.PullRequest, error) {
+		pr, err := client.GetPRForBranch(cmdCtx, branch)
+		if err != nil || pr == nil {
+			return pr, err
+		}
+		if err := client.AddComment(cmdCtx, pr.Number, body); err != nil {
+			// Not wrapped, so a failed comment isn't retried on another repo
+			return nil, fmt.Errorf("failed to comment on PR #%d: %v", pr.Number, err)
+		}
+		return pr, nil
+	})
+	s.Stop()
+	if err != nil {
+		return err
+	}
+	if pr == nil {
+		return fmt.Errorf("no open pull request for branch %s to comment on", branch)
+	}
+
+	if !ctx.Output.IsStructured() {
+		green := color.New(color.FgGreen)
+		fmt.Println()
+		_, _ = green.Printf("✓ Posted the analysis on PR #%d\n", pr.Number)
+	}
+	return nil
+}
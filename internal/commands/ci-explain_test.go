@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunkLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		size     int
+		expected []string
+	}{
+		{name: "fits in one chunk", text: "a\nb\n", size: 10, expected: []string{"a\nb\n"}},
+		{name: "splits at line breaks", text: "one\ntwo\nthree\n", size: 8, expected: []string{"one\ntwo\n", "three\n"}},
+		{name: "splits long lines", text: "abcdefgh\n", size: 4, expected: []string{"abcd", "efgh", "\n"}},
+		// "é" is two bytes, so a cut at 3 bytes would split the second one
+		{name: "keeps multi-byte characters whole", text: "éééé", size: 3, expected: []string{"é", "é", "é", "é"}},
+		{name: "character longer than the chunk", text: "✓✓", size: 2, expected: []string{"✓", "✓"}},
+		{name: "empty", text: "", size: 4, expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chunks := chunkLines(tt.text, tt.size)
+			assert.Equal(t, tt.expected, chunks)
+			for _, chunk := range chunks {
+				assert.True(t, utf8.ValidString(chunk), "chunk %q isn't valid UTF-8", chunk)
+			}
+			assert.Equal(t, tt.text, strings.Join(chunks, ""))
+		})
+	}
+}
+
+func TestRuneStart(t *testing.T) {
+	text := "aé✓" // Bytes: a, é (2), ✓ (3)
+
+	assert.Equal(t, 0, runeStart(text, 0))
+	assert.Equal(t, 1, runeStart(text, 1))
+	assert.Equal(t, 1, runeStart(text, 2))
+	assert.Equal(t, 3, runeStart(text, 3))
+	assert.Equal(t, 3, runeStart(text, 5))
+	assert.Equal(t, len(text), runeStart(text, len(text)))
+}
@@ -4,22 +4,32 @@ package commands
 import (
 	"context"
 	"fmt"
-	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/briandowns/spinner"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 
+	"github.com/rithyhuot/vibe/internal/output"
+	"github.com/rithyhuot/vibe/internal/services/ci"
 	"github.com/rithyhuot/vibe/internal/services/circleci"
 )
 
 // CIFailureOptions holds flags for the ci-failure command
 type CIFailureOptions struct {
-	Branch string
+	Branch  string
+	Project string
+	Raw     bool
+	Explain bool
+	Comment bool
 }
 
+// maxFailureTailLines is how much of a step's output is shown when no
+// failures could be parsed from it
+const maxFailureTailLines = 40
+
 // NewCIFailureCommand creates the ci-failure command
 func NewCIFailureCommand(ctx *CommandContext) *cobra.Command {
 	opts := &CIFailureOptions{}
@@ -27,14 +37,34 @@ func NewCIFailureCommand(ctx *CommandContext) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "ci-failure [job-number]",
 		Short: "Show detailed failure output from a CI job",
-		Long: `Shows the full, untruncated error output from a failed CircleCI job. If no job number is provided, uses the first failed job from the current branch.
+		Long: `Shows why a CI job failed. If no job number is provided, uses the first failed job from the current branch.
+
+The output of each failed step is parsed for failed tests, lint issues, and
+compile errors from go test, golangci-lint, Jest, Vitest, pytest, ESLint, and
+tsc, and a summary with their file:line locations is shown. When nothing can be
+parsed, the end of the output is shown instead. Use --raw for the full output.
+
+With --explain, the trimmed failure log and the branch's diff against
+git.base_branch are sent to Claude, which suggests a root cause, the implicated
+files, and a fix. Secrets are redacted first. --comment posts the analysis on
+the branch's pull request.
+
+For GitHub Actions and GitLab, pass the job ID as the job number. With
+circleci.extra_projects, job numbers are looked up in the main project unless
+--project names another.
 
 Examples:
-  vibe ci-failure                # Show failure from current branch's first failed job
-  vibe ci-failure 12345          # Show failure details for job #12345
-  vibe ci-failure --branch main  # Show failure from main branch`,
+  vibe ci-failure                # Summarize the current branch's first failed job
+  vibe ci-failure 12345          # Summarize job #12345
+  vibe ci-failure --raw          # Show the full, untruncated output
+  vibe ci-failure --explain      # Ask Claude for the likely root cause and fix
+  vibe ci-failure --explain --comment  # Also post the analysis on the PR
+  vibe ci-failure --branch main  # Show failure from main branch
+  vibe ci-failure 812 --project gh/acme/deploy  # Job #812 of another project
+  vibe ci-failure -o json        # Output failed steps and parsed failures as JSON`,
 		Args: cobra.MaximumNArgs(1),
-		RunE: func(_ *cobra.Command, args []string) error {
+		RunE: func(cobraCmd *cobra.Command, args []string) error {
+			ctx = getCommandContext(cobraCmd, ctx)
 			jobNumberArg := ""
 			if len(args) > 0 {
 				jobNumberArg = args[0]
@@ -44,34 +74,42 @@ Examples:
 	}
 
 	cmd.Flags().StringVar(&opts.Branch, "branch", "", "Branch to check (default: current branch)")
+	cmd.Flags().StringVar(&opts.Project, "project", "", "CircleCI project of the job number, from circleci.extra_projects (default: the main project)")
+	cmd.Flags().BoolVar(&opts.Raw, "raw", false, "Show the full output of failed steps instead of a summary")
+	cmd.Flags().BoolVar(&opts.Explain, "explain", false, "Ask Claude to diagnose the failure (requires ai.enabled)")
+	cmd.Flags().BoolVar(&opts.Comment, "comment", false, "Post the --explain analysis as a comment on the branch's pull request")
 
 	return cmd
 }
 
 func runCIFailure(ctx *CommandContext, opts *CIFailureOptions, jobNumberArg string) error {
-	// Get CircleCI token
-	token := ctx.Config.CircleCI.APIToken
-	if token == "" {
-		token = os.Getenv("CIRCLECI_TOKEN")
-		if token == "" {
-			token = os.Getenv("CIRCLE_TOKEN")
-		}
+	if opts.Project != "" && jobNumberArg == "" {
+		return fmt.Errorf("--project requires a job number")
 	}
-
-	if token == "" {
-		return fmt.Errorf("CircleCI API token not found")
+	if opts.Comment && !opts.Explain {
+		return fmt.Errorf("--comment requires --explain")
+	}
+	if opts.Explain && ctx.ClaudeClient == nil {
+		return fmt.Errorf("--explain requires AI to be enabled\n\nSet ai.enabled: true and configure claude.api_key or install the claude CLI")
 	}
 
-	// Get project slug
-	projectSlug, err := circleci.GetProjectSlug()
+	// Create CI provider
+	provider, err := newCIProvider(ctx)
 	if err != nil {
-		return fmt.Errorf("could not determine project from git remote: %w", err)
+		return err
 	}
 
-	// Create CircleCI client
-	client := circleci.NewClient(token)
 	cmdCtx := context.Background()
 
+	// A job number doesn't need a branch, unless there's a PR to comment on
+	branch := opts.Branch
+	if branch == "" {
+		branch, err = ctx.GitRepo.CurrentBranch()
+		if err != nil && (jobNumberArg == "" || opts.Comment) {
+			return fmt.Errorf("failed to get current branch: %w", err)
+		}
+	}
+
 	var jobNumber int
 
 	// If job number provided, use it directly
@@ -80,28 +118,28 @@ func runCIFailure(ctx *CommandContext, opts *CIFailureOptions, jobNumberArg stri
 		if err != nil {
 			return fmt.Errorf("invalid job number: %s", jobNumberArg)
 		}
+		provider, err = providerForProject(provider, opts.Project)
+		if err != nil {
+			return err
+		}
 	} else {
 		// Otherwise, find the first failed job for the branch
-		branch := opts.Branch
-		if branch == "" {
-			branch, err = ctx.GitRepo.CurrentBranch()
-			if err != nil {
-				return fmt.Errorf("failed to get current branch: %w", err)
-			}
-		}
-
 		cyan := color.New(color.FgCyan)
 		s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
 		s.Suffix = fmt.Sprintf(" Finding failed jobs for %s...", cyan.Sprint(branch))
 		s.Start()
 
-		status, err := client.GetCIStatusForBranch(cmdCtx, branch, projectSlug)
+		status, err := provider.GetCIStatusForBranch(cmdCtx, branch)
 		s.Stop()
 
 		if err != nil {
 			return fmt.Errorf("failed to fetch CI status: %w", err)
 		}
 
+		if ctx.Output.IsStructured() && (status == nil || len(status.FailedJobs) == 0) {
+			return writeOutput(ctx, output.KindCIFailure, nil)
+		}
+
 		if status == nil {
 			yellow := color.New(color.FgYellow)
 			_, _ = yellow.Printf("No CI pipelines found for branch: %s\n", branch)
@@ -114,12 +152,18 @@ func runCIFailure(ctx *CommandContext, opts *CIFailureOptions, jobNumberArg stri
 			return nil
 		}
 
-		// Use the first failed job
+		// Use the first failed job, from whichever project it ran in
 		failedJob := status.FailedJobs[0]
 		jobNumber = failedJob.JobNumber
+		provider, err = providerForProject(provider, failedJob.ProjectSlug)
+		if err != nil {
+			return err
+		}
 
-		dim := color.New(color.Faint)
-		_, _ = dim.Printf("Found failed job: %s > %s\n", failedJob.WorkflowName, failedJob.Name)
+		if !ctx.Output.IsStructured() {
+			dim := color.New(color.Faint)
+			_, _ = dim.Printf("Found failed job: %s > %s\n", failedJob.WorkflowName, failedJob.Name)
+		}
 	}
 
 	// Fetch failure details
@@ -127,13 +171,21 @@ func runCIFailure(ctx *CommandContext, opts *CIFailureOptions, jobNumberArg stri
 	s.Suffix = fmt.Sprintf(" Fetching failure details for job #%d...", jobNumber)
 	s.Start()
 
-	failedSteps, err := client.GetBuildDetails(cmdCtx, projectSlug, jobNumber)
+	failedSteps, err := provider.GetFailedSteps(cmdCtx, jobNumber)
 	s.Stop()
 
 	if err != nil {
 		return fmt.Errorf("failed to fetch failure details: %w", err)
 	}
 
+	if opts.Explain && len(failedSteps) > 0 {
+		return explainCIFailure(ctx, opts, branch, provider.ProjectSlug(), jobNumber, failedSteps)
+	}
+
+	if ctx.Output.IsStructured() {
+		return writeOutput(ctx, output.KindCIFailure, output.NewCIFailure(provider.ProjectSlug(), jobNumber, failedSteps))
+	}
+
 	if len(failedSteps) == 0 {
 		yellow := color.New(color.FgYellow)
 		_, _ = yellow.Println("No failed steps found for this job.")
@@ -141,27 +193,110 @@ func runCIFailure(ctx *CommandContext, opts *CIFailureOptions, jobNumberArg stri
 	}
 
 	// Display failed steps
-	displayFailedSteps(failedSteps)
+	displayFailedSteps(failedSteps, opts.Raw)
 
 	return nil
 }
 
-func displayFailedSteps(steps []circleci.FailedStep) {
+func displayFailedSteps(steps []circleci.FailedStep, raw bool) {
 	red := color.New(color.FgRed, color.Bold)
 	dim := color.New(color.Faint)
 
+	truncated := false
 	for _, step := range steps {
 		fmt.Println()
 		_, _ = red.Printf("═══ Failed Step: %s ═══\n\n", step.Name)
 
 		for _, action := range step.Actions {
-			if action.Output != "" {
-				fmt.Println(action.Output)
-			} else {
+			if action.Output == "" {
 				if action.ExitCode != nil {
 					_, _ = dim.Printf("Exit code: %d\n", *action.ExitCode)
 				}
+				continue
 			}
+
+			if raw {
+				fmt.Println(action.Output)
+				continue
+			}
+
+			if failures := ci.ExtractFailures(action.Output); len(failures) > 0 {
+				displayFailureSummary(failures)
+			} else {
+				displayOutputTail(action.Output)
+			}
+			truncated = true
 		}
 	}
+
+	if truncated {
+		fmt.Println()
+		_, _ = dim.Println("Run with --raw for the full output")
+	}
+}
+
+// displayFailureSummary shows the failures parsed from a step's output
+func displayFailureSummary(failures []ci.Failure) {
+	red := color.New(color.FgRed)
+	dim := color.New(color.Faint)
+	cyan := color.New(color.FgCyan)
+
+	noun := "failures"
+	if len(failures) == 1 {
+		noun = "failure"
+	}
+	_, _ = red.Printf("%d %s found:\n", len(failures), noun)
+
+	for _, f := range failures {
+		fmt.Println()
+		title := f.Test
+		if title == "" {
+			title = firstLine(f.Message)
+		}
+		_, _ = red.Printf("  ✗ %s", title)
+		_, _ = dim.Printf("  [%s]\n", f.Tool)
+		if loc := f.Location(); loc != "" {
+			_, _ = cyan.Printf("    %s\n", loc)
+		}
+
+		lines := strings.Split(f.Message, "\n")
+		if f.Test == "" {
+			lines = lines[1:]
+		}
+		maxLines := 10
+		for i, line := range lines {
+			if i == maxLines {
+				_, _ = dim.Printf("    ... (%d more lines)\n", len(lines)-maxLines)
+				break
+			}
+			fmt.Printf("    %s\n", line)
+		}
+	}
+}
+
+// displayOutputTail shows the end of a step's output, where errors usually are
+func displayOutputTail(output string) {
+	dim := color.New(color.Faint)
+
+	tail, total := tailLines(output, maxFailureTailLines)
+	if total > maxFailureTailLines {
+		_, _ = dim.Printf("... (showing the last %d of %d lines)\n", maxFailureTailLines, total)
+	}
+	fmt.Println(tail)
+}
+
+// tailLines returns the last n lines of output and how many lines it has
+func tailLines(output string, n int) (string, int) {
+	lines := strings.Split(strings.TrimRight(output, "\n"), "\n")
+	total := len(lines)
+	if total > n {
+		lines = lines[total-n:]
+	}
+	return strings.Join(lines, "\n"), total
+}
+
+// firstLine returns the first line of a message
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(s, "\n")
+	return line
 }
@@ -0,0 +1,268 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/rithyhuot/vibe/internal/output"
+	"github.com/rithyhuot/vibe/internal/services/circleci"
+)
+
+// defaultFlakyPipelines is how many pipelines ci flaky analyzes by default
+const defaultFlakyPipelines = 20
+
+// CIFlakyOptions holds flags for the ci flaky command
+type CIFlakyOptions struct {
+	Branch  string
+	Project string
+	Base    bool
+	Limit   int
+}
+
+// newCIFlakyCommand creates the ci flaky command
+func newCIFlakyCommand(ctx *CommandContext) *cobra.Command {
+	opts := &CIFlakyOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "flaky",
+		Short: "Find flaky tests in recent pipelines",
+		Long: `Finds flaky tests in the recent CircleCI pipelines of a branch.
+
+The test results of the last --limit pipelines are compared per test. A test is
+flaky when it both passed and failed on the same commit, such as after a rerun,
+or when it flipped from passing to failing and back across consecutive runs.
+Tests are ranked by how often they failed. Flaky tests that CircleCI Insights
+detected across the project are included when Insights is available.
+
+ci-status marks failed tests that Insights knows to be flaky.
+
+Examples:
+  vibe ci flaky                  # Analyze the current branch
+  vibe ci flaky --base           # Analyze the base branch (git.base_branch)
+  vibe ci flaky --branch main --limit 50
+  vibe ci flaky -o json          # Output as JSON`,
+		Args: cobra.NoArgs,
+		RunE: func(cobraCmd *cobra.Command, _ []string) error {
+			ctx = getCommandContext(cobraCmd, ctx)
+			return runCIFlaky(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Branch, "branch", "", "Branch to analyze (default: current branch)")
+	addCIProjectFlag(cmd, &opts.Project)
+	cmd.Flags().BoolVar(&opts.Base, "base", false, "Analyze the base branch from git.base_branch")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "n", defaultFlakyPipelines, "Number of recent pipelines to analyze")
+
+	return cmd
+}
+
+// pipelineJob is a job of one of the analyzed pipelines
+type pipelineJob struct {
+	Pipeline circleci.Pipeline
+	Job      circleci.Job
+}
+
+func runCIFlaky(ctx *CommandContext, opts *CIFlakyOptions) error {
+	if opts.Limit <= 0 {
+		return fmt.Errorf("--limit must be positive")
+	}
+
+	client, projectSlug, err := requireCircleCI(ctx, "vibe ci flaky", opts.Project)
+	if err != nil {
+		return err
+	}
+
+	branch, err := historyBranch(ctx, opts.Branch, opts.Base)
+	if err != nil {
+		return err
+	}
+
+	cyan := color.New(color.FgCyan)
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	s.Suffix = fmt.Sprintf(" Fetching the last %d pipelines for %s...", opts.Limit, cyan.Sprint(branch))
+	s.Start()
+
+	cmdCtx := context.Background()
+	pipelines, err := client.ListPipelines(cmdCtx, projectSlug, branch, opts.Limit)
+	if err != nil {
+		s.Stop()
+		return err
+	}
+
+	jobs, err := collectPipelineJobs(cmdCtx, client, pipelines)
+	if err != nil {
+		s.Stop()
+		return err
+	}
+
+	s.Lock()
+	s.Suffix = " Comparing test results..."
+	s.Unlock()
+
+	runs, err := collectTestRuns(cmdCtx, client, projectSlug, jobs)
+	if err != nil {
+		s.Stop()
+		return err
+	}
+
+	// Insights isn't available on every plan, so history alone is enough
+	insights, _ := client.GetFlakyTests(cmdCtx, projectSlug)
+	s.Stop()
+
+	flaky := circleci.MergeFlakyTests(circleci.DetectFlakyTests(runs), insights)
+
+	if ctx.Output.IsStructured() {
+		return writeOutput(ctx, output.KindFlakyTests, output.NewFlakyTestList(projectSlug, branch, len(pipelines), flaky))
+	}
+
+	displayFlakyTests(branch, len(pipelines), flaky)
+	return nil
+}
+
+// historyBranch returns the branch whose pipeline history to analyze
+func historyBranch(ctx *CommandContext, branch string, base bool) (string, error) {
+	if base {
+		return ctx.Config.Git.BaseBranch, nil
+	}
+	if branch != "" {
+		return branch, nil
+	}
+
+	branch, err := ctx.GitRepo.CurrentBranch()
+	if err != nil {
+		return "", fmt.Errorf("failed to get current branch: %w", err)
+	}
+	return branch, nil
+}
+
+// collectPipelineJobs retrieves the jobs of every workflow of the pipelines
+func collectPipelineJobs(ctx context.Context, client circleci.Client, pipelines []circleci.Pipeline) ([]pipelineJob, error) {
+	var mu sync.Mutex
+	var jobs []pipelineJob
+
+	err := forEachConcurrently(len(pipelines), maxCIFetches, func(i int) error {
+		workflows, err := client.GetWorkflows(ctx, pipelines[i].ID)
+		if err != nil {
+			return err
+		}
+		for _, workflow := range workflows {
+			workflowJobs, err := client.GetJobs(ctx, workflow.ID)
+			if err != nil {
+				return err
+			}
+
+			mu.Lock()
+			for _, job := range workflowJobs {
+				jobs = append(jobs, pipelineJob{Pipeline: pipelines[i], Job: job})
+			}
+			mu.Unlock()
+		}
+		return nil
+	})
+
+	return jobs, err
+}
+
+// collectTestRuns retrieves the test results of the finished runs of every
+// job that failed at least once, since only those can have flaky tests
+func collectTestRuns(ctx context.Context, client circleci.Client, projectSlug string, jobs []pipelineJob) ([]circleci.TestRun, error) {
+	failing := make(map[string]bool)
+	for _, pj := range jobs {
+		if pj.Job.Status == "failed" {
+			failing[pj.Job.Name] = true
+		}
+	}
+
+	var candidates []pipelineJob
+	for _, pj := range jobs {
+		finished := pj.Job.Status == "success" || pj.Job.Status == "failed"
+		if failing[pj.Job.Name] && finished && pj.Job.JobNumber > 0 {
+			candidates = append(candidates, pj)
+		}
+	}
+
+	var mu sync.Mutex
+	var runs []circleci.TestRun
+
+	err := forEachConcurrently(len(candidates), maxCIFetches, func(i int) error {
+		pj := candidates[i]
+		tests, err := client.GetTestMetadata(ctx, projectSlug, pj.Job.JobNumber)
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		for _, test := range tests {
+			runs = append(runs, circleci.TestRun{
+				PipelineNumber: pj.Pipeline.Number,
+				Revision:       pj.Pipeline.VCS.Revision,
+				JobName:        pj.Job.Name,
+				Test:           test,
+			})
+		}
+		return nil
+	})
+
+	return runs, err
+}
+
+func displayFlakyTests(branch string, pipelines int, flaky []circleci.FlakyTestStats) {
+	bold := color.New(color.Bold)
+	dim := color.New(color.Faint)
+	red := color.New(color.FgRed)
+	yellow := color.New(color.FgYellow)
+	green := color.New(color.FgGreen)
+
+	if len(flaky) == 0 {
+		_, _ = green.Printf("No flaky tests found in the last %d pipelines of %s\n", pipelines, branch)
+		return
+	}
+
+	fmt.Println()
+	_, _ = bold.Printf("Flaky tests on %s (%d found, last %d pipelines)\n", branch, len(flaky), pipelines)
+	fmt.Println()
+
+	for _, test := range flaky {
+		name := test.Name
+		if test.Classname != "" {
+			name = fmt.Sprintf("%s > %s", test.Classname, test.Name)
+		}
+
+		rate := "   -"
+		if test.Runs > 0 {
+			rate = fmt.Sprintf("%3.0f%%", test.FlakeRate()*100)
+		}
+		rateColor := yellow
+		if test.FlakeRate() >= 0.2 {
+			rateColor = red
+		}
+		fmt.Printf("  %s  %s\n", rateColor.Sprint(rate), name)
+
+		var details []string
+		if test.Runs > 0 {
+			details = append(details, fmt.Sprintf("failed %d of %d runs", test.Failures, test.Runs))
+		}
+		if test.SameCommit {
+			details = append(details, "passed and failed on the same commit")
+		}
+		if test.TimesFlaked > 0 {
+			details = append(details, fmt.Sprintf("flaked %d times per Insights", test.TimesFlaked))
+		}
+		if test.JobName != "" {
+			details = append(details, "job "+test.JobName)
+		}
+		if test.File != "" {
+			details = append(details, test.File)
+		}
+		_, _ = dim.Printf("        %s\n", strings.Join(details, " · "))
+	}
+	fmt.Println()
+}
@@ -0,0 +1,288 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/rithyhuot/vibe/internal/output"
+	"github.com/rithyhuot/vibe/internal/services/circleci"
+)
+
+// defaultHistoryPipelines is how many pipelines ci history shows by default
+const defaultHistoryPipelines = 20
+
+// CIHistoryOptions holds flags for the ci history command
+type CIHistoryOptions struct {
+	Branch  string
+	Project string
+	Limit   int
+}
+
+// newCIHistoryCommand creates the ci history command
+func newCIHistoryCommand(ctx *CommandContext) *cobra.Command {
+	opts := &CIHistoryOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Show recent pipelines and job duration trends",
+		Long: `Shows the recent CircleCI pipelines of a branch, newest first.
+
+Each pipeline shows its commit, who triggered it, the outcome of each workflow,
+its wall-clock time, and how much of that time no job was running (waiting for
+an executor or on a hold). Below the table, the p50 and p95 durations of each
+job over the last 30 days come from CircleCI Insights, when available.
+
+Examples:
+  vibe ci history                       # Pipelines of the current branch
+  vibe ci history --branch main -n 50   # The last 50 pipelines of main
+  vibe ci history -o json               # Output as JSON`,
+		Args: cobra.NoArgs,
+		RunE: func(cobraCmd *cobra.Command, _ []string) error {
+			ctx = getCommandContext(cobraCmd, ctx)
+			return runCIHistory(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Branch, "branch", "", "Branch to show (default: current branch)")
+	addCIProjectFlag(cmd, &opts.Project)
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "n", defaultHistoryPipelines, "Number of recent pipelines to show")
+
+	return cmd
+}
+
+func runCIHistory(ctx *CommandContext, opts *CIHistoryOptions) error {
+	if opts.Limit <= 0 {
+		return fmt.Errorf("--limit must be positive")
+	}
+
+	client, projectSlug, err := requireCircleCI(ctx, "vibe ci history", opts.Project)
+	if err != nil {
+		return err
+	}
+
+	branch, err := historyBranch(ctx, opts.Branch, false)
+	if err != nil {
+		return err
+	}
+
+	cyan := color.New(color.FgCyan)
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	s.Suffix = fmt.Sprintf(" Fetching the last %d pipelines for %s...", opts.Limit, cyan.Sprint(branch))
+	s.Start()
+
+	cmdCtx := context.Background()
+	pipelines, err := client.ListPipelines(cmdCtx, projectSlug, branch, opts.Limit)
+	if err != nil {
+		s.Stop()
+		return err
+	}
+
+	runs, err := collectPipelineRuns(cmdCtx, client, pipelines)
+	if err != nil {
+		s.Stop()
+		return err
+	}
+
+	s.Lock()
+	s.Suffix = " Fetching job durations..."
+	s.Unlock()
+
+	insights := collectJobInsights(cmdCtx, client, projectSlug, branch, runs)
+	s.Stop()
+
+	history := output.NewCIHistory(projectSlug, branch, runs, insights, time.Now())
+
+	if ctx.Output.IsStructured() {
+		return writeOutput(ctx, output.KindCIHistory, history)
+	}
+
+	displayCIHistory(history)
+	return nil
+}
+
+// collectPipelineRuns retrieves the workflows and jobs of the pipelines,
+// keeping the pipelines in order
+func collectPipelineRuns(ctx context.Context, client circleci.Client, pipelines []circleci.Pipeline) ([]circleci.PipelineRun, error) {
+	runs := make([]circleci.PipelineRun, len(pipelines))
+
+	err := forEachConcurrently(len(pipelines), maxCIFetches, func(i int) error {
+		workflows, err := client.GetWorkflows(ctx, pipelines[i].ID)
+		if err != nil {
+			return err
+		}
+
+		run := circleci.PipelineRun{Pipeline: pipelines[i]}
+		for _, workflow := range workflows {
+			jobs, err := client.GetJobs(ctx, workflow.ID)
+			if err != nil {
+				return err
+			}
+			run.Workflows = append(run.Workflows, circleci.WorkflowRun{Workflow: workflow, Jobs: jobs})
+		}
+		runs[i] = run
+		return nil
+	})
+
+	return runs, err
+}
+
+// collectJobInsights retrieves the Insights job metrics of every workflow in
+// the runs. Insights isn't available on every plan, so workflows whose metrics
+// can't be fetched are left out.
+func collectJobInsights(ctx context.Context, client circleci.Client, projectSlug, branch string, runs []circleci.PipelineRun) map[string][]circleci.JobInsights {
+	seen := make(map[string]bool)
+	var workflows []string
+	for _, run := range runs {
+		for _, w := range run.Workflows {
+			if !seen[w.Workflow.Name] {
+				seen[w.Workflow.Name] = true
+				workflows = append(workflows, w.Workflow.Name)
+			}
+		}
+	}
+
+	var mu sync.Mutex
+	insights := make(map[string][]circleci.JobInsights)
+
+	_ = forEachConcurrently(len(workflows), maxCIFetches, func(i int) error {
+		jobs, err := client.GetJobInsights(ctx, projectSlug, workflows[i], branch)
+		if err != nil || len(jobs) == 0 {
+			return nil
+		}
+
+		mu.Lock()
+		insights[workflows[i]] = jobs
+		mu.Unlock()
+		return nil
+	})
+
+	return insights
+}
+
+func displayCIHistory(history output.CIHistory) {
+	bold := color.New(color.Bold)
+	dim := color.New(color.Faint)
+	yellow := color.New(color.FgYellow)
+
+	if len(history.Pipelines) == 0 {
+		_, _ = yellow.Printf("No CI pipelines found for branch: %s\n", history.Branch)
+		return
+	}
+
+	fmt.Println()
+	_, _ = bold.Printf("Pipelines on %s (last %d)\n", history.Branch, len(history.Pipelines))
+	fmt.Println()
+
+	_, _ = dim.Printf("  %-7s %-8s %-16s %-9s %-8s %-8s %s\n", "#", "COMMIT", "ACTOR", "CREATED", "WALL", "QUEUED", "WORKFLOWS")
+
+	now := time.Now()
+	for _, p := range history.Pipelines {
+		actor := p.Actor
+		if actor == "" {
+			actor = p.Trigger
+		}
+		if actorRunes := []rune(actor); len(actorRunes) > 16 {
+			actor = string(actorRunes[:13]) + "..."
+		}
+
+		wall, queued := "-", "-"
+		if len(p.Workflows) > 0 {
+			wall = formatCIDuration(time.Duration(p.WallSeconds) * time.Second)
+			queued = formatCIDuration(time.Duration(p.QueuedSeconds) * time.Second)
+		}
+
+		fmt.Printf("  %-7d %-8s %-16s %-9s %-8s %-8s %s\n",
+			p.Number, shortSHA(p.Revision), actor,
+			formatCacheAge(now.Sub(p.CreatedAt)), wall, queued, formatWorkflowResults(p.Workflows))
+	}
+
+	displayJobDurations(history.JobDurations)
+	fmt.Println()
+}
+
+// formatWorkflowResults formats the outcome of each workflow of a pipeline
+func formatWorkflowResults(workflows []output.WorkflowResult) string {
+	if len(workflows) == 0 {
+		return color.New(color.Faint).Sprint("no workflows")
+	}
+
+	parts := make([]string, len(workflows))
+	for i, w := range workflows {
+		parts[i] = fmt.Sprintf("%s %s", workflowResultSymbol(w.Status), w.Name)
+	}
+	return strings.Join(parts, "  ")
+}
+
+// workflowResultSymbol returns a colored symbol for a workflow status
+func workflowResultSymbol(status string) string {
+	switch status {
+	case "success":
+		return color.New(color.FgGreen).Sprint("✓")
+	case "failed", "error", "failing", "unauthorized":
+		return color.New(color.FgRed).Sprint("✗")
+	case "running":
+		return color.New(color.FgBlue).Sprint("◔")
+	case "canceled":
+		return color.New(color.FgYellow).Sprint("⊘")
+	case "on_hold":
+		return color.New(color.FgYellow).Sprint("⏸")
+	default:
+		return color.New(color.Faint).Sprint("○")
+	}
+}
+
+// displayJobDurations shows the Insights duration percentiles of each job,
+// slowest p95 first within each workflow
+func displayJobDurations(trends []output.JobDurationTrend) {
+	bold := color.New(color.Bold)
+	dim := color.New(color.Faint)
+
+	fmt.Println()
+	if len(trends) == 0 {
+		_, _ = dim.Println("  Job durations aren't available (CircleCI Insights returned no data)")
+		return
+	}
+
+	_, _ = bold.Println("Job durations (last 30 days)")
+	fmt.Println()
+
+	byWorkflow := make(map[string][]output.JobDurationTrend)
+	var workflows []string
+	for _, trend := range trends {
+		if _, ok := byWorkflow[trend.Workflow]; !ok {
+			workflows = append(workflows, trend.Workflow)
+		}
+		byWorkflow[trend.Workflow] = append(byWorkflow[trend.Workflow], trend)
+	}
+
+	for _, workflow := range workflows {
+		jobs := byWorkflow[workflow]
+		sort.SliceStable(jobs, func(i, j int) bool {
+			return jobs[i].P95Seconds > jobs[j].P95Seconds
+		})
+
+		width := len("JOB")
+		for _, job := range jobs {
+			if len(job.Job) > width {
+				width = len(job.Job)
+			}
+		}
+
+		_, _ = bold.Printf("  %s\n", workflow)
+		_, _ = dim.Printf("    %-*s  %-8s %-8s %-6s %s\n", width, "JOB", "P50", "P95", "RUNS", "SUCCESS")
+		for _, job := range jobs {
+			fmt.Printf("    %-*s  %-8s %-8s %-6d %.0f%%\n", width, job.Job,
+				formatCIDuration(time.Duration(job.P50Seconds)*time.Second),
+				formatCIDuration(time.Duration(job.P95Seconds)*time.Second),
+				job.Runs, job.SuccessRate*100)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/rithyhuot/vibe/internal/services/circleci"
+)
+
+// CIRerunOptions holds flags for the ci rerun command
+type CIRerunOptions struct {
+	CIActionOptions
+	FailedOnly bool
+	SSH        bool
+}
+
+// newCIRerunCommand creates the ci rerun command
+func newCIRerunCommand(ctx *CommandContext) *cobra.Command {
+	opts := &CIRerunOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "rerun",
+		Short: "Rerun the unsuccessful workflows of the latest pipeline",
+		Long: `Reruns the failed, errored, and canceled workflows of the latest CircleCI
+pipeline for a branch from the start. Successful workflows aren't rerun.
+
+With --failed-only, only failed workflows are rerun, starting from their failed
+jobs. With --ssh, the failed jobs of failed workflows are rerun with SSH
+enabled, so you can connect to the job's container to debug.
+
+Examples:
+  vibe ci rerun                  # Rerun unsuccessful workflows from the start
+  vibe ci rerun --failed-only    # Rerun failed workflows from failed jobs
+  vibe ci rerun --ssh            # Rerun failed jobs with SSH access
+  vibe ci rerun --branch main -y # Rerun main's pipeline without confirming`,
+		Args: cobra.NoArgs,
+		RunE: func(cobraCmd *cobra.Command, _ []string) error {
+			ctx = getCommandContext(cobraCmd, ctx)
+			return runCIRerun(ctx, opts)
+		},
+	}
+
+	addCIActionFlags(cmd, &opts.CIActionOptions)
+	cmd.Flags().BoolVar(&opts.FailedOnly, "failed-only", false, "Rerun only failed workflows, from their failed jobs")
+	cmd.Flags().BoolVar(&opts.SSH, "ssh", false, "Rerun failed jobs with SSH enabled (implies --failed-only)")
+
+	return cmd
+}
+
+// workflowRerun is a workflow to rerun with its request
+type workflowRerun struct {
+	Workflow circleci.WorkflowStatus
+	Request  *circleci.RerunRequest
+}
+
+func runCIRerun(ctx *CommandContext, opts *CIRerunOptions) error {
+	client, projectSlug, err := requireCircleCI(ctx, "vibe ci rerun", opts.Project)
+	if err != nil {
+		return err
+	}
+
+	status, err := fetchLatestPipeline(ctx, client, projectSlug, opts.Branch)
+	if err != nil || status == nil {
+		return err
+	}
+
+	reruns := planReruns(status.Workflows, opts)
+	if len(reruns) == 0 {
+		yellow := color.New(color.FgYellow)
+		if opts.FailedOnly || opts.SSH {
+			_, _ = yellow.Println("No failed workflows to rerun.")
+		} else {
+			_, _ = yellow.Println("No failed or canceled workflows to rerun.")
+		}
+		return nil
+	}
+
+	bold := color.New(color.Bold)
+	fmt.Println()
+	_, _ = bold.Printf("Pipeline #%d (%s)\n", status.PipelineNumber, status.Branch)
+	for _, rerun := range reruns {
+		fmt.Printf("  %s %s\n", formatWorkflowStatus(rerun.Workflow.Status), rerun.Workflow.Name)
+	}
+	fmt.Println()
+
+	confirmed, err := confirmCIAction(&opts.CIActionOptions, fmt.Sprintf("Rerun %d workflow(s)?", len(reruns)))
+	if err != nil || !confirmed {
+		return err
+	}
+
+	green := color.New(color.FgGreen)
+	dim := color.New(color.Faint)
+	cmdCtx := context.Background()
+
+	for _, rerun := range reruns {
+		s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+		s.Suffix = fmt.Sprintf(" Rerunning %s...", rerun.Workflow.Name)
+		s.Start()
+
+		workflowID, err := client.RerunWorkflow(cmdCtx, rerun.Workflow.ID, rerun.Request)
+		s.Stop()
+
+		if err != nil {
+			return fmt.Errorf("failed to rerun %s: %w", rerun.Workflow.Name, err)
+		}
+
+		_, _ = green.Printf("✓ Reran %s\n", rerun.Workflow.Name)
+		_, _ = dim.Printf("  New workflow: %s\n", workflowID)
+	}
+
+	if opts.SSH {
+		fmt.Println()
+		_, _ = dim.Println("SSH details appear in the \"Enable SSH\" step of each rerun job once it starts.")
+	}
+
+	return nil
+}
+
+// planReruns selects the workflows to rerun: failed, errored, and canceled
+// ones by default, and only failed ones with --failed-only or --ssh.
+// Successful workflows and those that haven't finished aren't rerun.
+func planReruns(workflows []circleci.WorkflowStatus, opts *CIRerunOptions) []workflowRerun {
+	var reruns []workflowRerun
+	for _, workflow := range workflows {
+		switch workflow.Status {
+		case "failed", "error", "canceled":
+		default:
+			continue
+		}
+
+		switch {
+		case opts.SSH:
+			// SSH reruns must name their jobs, so they can't use from_failed
+			var jobIDs []string
+			for _, job := range workflow.Jobs {
+				if job.Status == "failed" {
+					jobIDs = append(jobIDs, job.ID)
+				}
+			}
+			if workflow.Status != "failed" || len(jobIDs) == 0 {
+				continue
+			}
+			reruns = append(reruns, workflowRerun{workflow, &circleci.RerunRequest{EnableSSH: true, Jobs: jobIDs}})
+		case opts.FailedOnly:
+			if workflow.Status != "failed" {
+				continue
+			}
+			reruns = append(reruns, workflowRerun{workflow, &circleci.RerunRequest{FromFailed: true}})
+		default:
+			reruns = append(reruns, workflowRerun{workflow, &circleci.RerunRequest{}})
+		}
+	}
+	return reruns
+}
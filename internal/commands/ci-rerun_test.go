@@ -0,0 +1,141 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rithyhuot/vibe/internal/services/circleci"
+)
+
+func TestPlanReruns(t *testing.T) {
+	workflows := []circleci.WorkflowStatus{
+		{ID: "wf-success", Name: "lint", Status: "success"},
+		{ID: "wf-failed", Name: "test", Status: "failed", Jobs: []circleci.Job{
+			{ID: "job-unit", Name: "unit", Status: "success"},
+			{ID: "job-e2e", Name: "e2e", Status: "failed"},
+			{ID: "job-api", Name: "api", Status: "failed"},
+		}},
+		{ID: "wf-error", Name: "build", Status: "error"},
+		{ID: "wf-canceled", Name: "deploy", Status: "canceled"},
+		{ID: "wf-running", Name: "nightly", Status: "running"},
+		{ID: "wf-failing", Name: "integration", Status: "failing", Jobs: []circleci.Job{
+			{ID: "job-int", Name: "int", Status: "failed"},
+		}},
+		{ID: "wf-hold", Name: "release", Status: "on_hold"},
+	}
+
+	tests := []struct {
+		name     string
+		opts     *CIRerunOptions
+		expected map[string]*circleci.RerunRequest // By workflow ID
+	}{
+		{
+			name: "default reruns unsuccessful workflows from the start",
+			opts: &CIRerunOptions{},
+			expected: map[string]*circleci.RerunRequest{
+				"wf-failed":   {},
+				"wf-error":    {},
+				"wf-canceled": {},
+			},
+		},
+		{
+			name: "failed only reruns failed workflows from failed jobs",
+			opts: &CIRerunOptions{FailedOnly: true},
+			expected: map[string]*circleci.RerunRequest{
+				"wf-failed": {FromFailed: true},
+			},
+		},
+		{
+			name: "ssh reruns the failed jobs of failed workflows",
+			opts: &CIRerunOptions{SSH: true},
+			expected: map[string]*circleci.RerunRequest{
+				"wf-failed": {EnableSSH: true, Jobs: []string{"job-e2e", "job-api"}},
+			},
+		},
+		{
+			name: "ssh wins over failed only",
+			opts: &CIRerunOptions{SSH: true, FailedOnly: true},
+			expected: map[string]*circleci.RerunRequest{
+				"wf-failed": {EnableSSH: true, Jobs: []string{"job-e2e", "job-api"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make(map[string]*circleci.RerunRequest)
+			for _, rerun := range planReruns(workflows, tt.opts) {
+				got[rerun.Workflow.ID] = rerun.Request
+			}
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestPlanReruns_SSHWithoutFailedJobs(t *testing.T) {
+	// A workflow can fail without a failed job, e.g. when a job was blocked
+	workflows := []circleci.WorkflowStatus{
+		{ID: "wf-failed", Name: "test", Status: "failed", Jobs: []circleci.Job{{ID: "job-unit", Status: "blocked"}}},
+	}
+
+	assert.Empty(t, planReruns(workflows, &CIRerunOptions{SSH: true}))
+	assert.Len(t, planReruns(workflows, &CIRerunOptions{FailedOnly: true}), 1)
+}
+
+func TestCancelableWorkflows(t *testing.T) {
+	workflows := []circleci.WorkflowStatus{
+		{ID: "wf-running", Status: "running"},
+		{ID: "wf-success", Status: "success"},
+		{ID: "wf-failing", Status: "failing"},
+		{ID: "wf-failed", Status: "failed"},
+		{ID: "wf-hold", Status: "on_hold"},
+		{ID: "wf-canceled", Status: "canceled"},
+	}
+
+	var ids []string
+	for _, workflow := range cancelableWorkflows(workflows) {
+		ids = append(ids, workflow.ID)
+	}
+	assert.Equal(t, []string{"wf-running", "wf-failing", "wf-hold"}, ids)
+	assert.Empty(t, cancelableWorkflows(nil))
+}
+
+func TestFindHoldJob(t *testing.T) {
+	workflows := []circleci.WorkflowStatus{
+		{ID: "wf-test", Name: "test", Jobs: []circleci.Job{
+			{Name: "unit", Type: "build", Status: "success"},
+		}},
+		{ID: "wf-deploy", Name: "deploy", Jobs: []circleci.Job{
+			{Name: "hold-staging", Type: "approval", Status: "success"},
+			{Name: "hold-production", Type: "approval", Status: "on_hold", ApprovalRequestID: "req-1"},
+		}},
+	}
+
+	t.Run("waiting job", func(t *testing.T) {
+		workflow, job, err := findHoldJob(workflows, "hold-production")
+		assert.NoError(t, err)
+		assert.Equal(t, "wf-deploy", workflow.ID)
+		assert.Equal(t, "req-1", job.ApprovalRequestID)
+	})
+
+	t.Run("already approved", func(t *testing.T) {
+		_, _, err := findHoldJob(workflows, "hold-staging")
+		assert.ErrorContains(t, err, "isn't waiting for approval")
+	})
+
+	t.Run("unknown job lists the waiting ones", func(t *testing.T) {
+		_, _, err := findHoldJob(workflows, "hold-qa")
+		assert.ErrorContains(t, err, "waiting for approval: hold-production")
+	})
+
+	t.Run("build jobs aren't approval jobs", func(t *testing.T) {
+		_, _, err := findHoldJob(workflows, "unit")
+		assert.Error(t, err)
+	})
+
+	t.Run("nothing waiting", func(t *testing.T) {
+		_, _, err := findHoldJob(workflows[:1], "hold-production")
+		assert.ErrorContains(t, err, "no jobs are waiting for approval")
+	})
+}
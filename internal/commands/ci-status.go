@@ -3,7 +3,6 @@ package commands
 import (
 	"context"
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
@@ -11,61 +10,84 @@ import (
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 
+	"github.com/rithyhuot/vibe/internal/output"
+	"github.com/rithyhuot/vibe/internal/services/ci"
 	"github.com/rithyhuot/vibe/internal/services/circleci"
 )
 
+// CIStatusOptions holds flags for the ci-status command
+type CIStatusOptions struct {
+	CIWatchOptions
+	Watch bool
+}
+
 // NewCIStatusCommand creates the ci-status command
 func NewCIStatusCommand(ctx *CommandContext) *cobra.Command {
+	opts := &CIStatusOptions{}
+
 	cmd := &cobra.Command{
 		Use:   "ci-status [branch]",
 		Short: "Show CI status for a branch",
-		Long: `Shows CircleCI pipeline status including workflows, jobs, and failed tests. If no branch is provided, uses the current branch.
+		Long: `Shows CI pipeline status including workflows, jobs, and failed tests. If no branch is provided, uses the current branch.
+
+The CI provider is set with ci.provider in config, or detected from the repository:
+  - GitLab remotes use GitLab pipelines, with stages shown as workflows
+  - .circleci/config.yml uses CircleCI
+  - .github/workflows uses GitHub Actions, with the runs of the latest commit shown as workflows
+
+With --watch, the status is redrawn in place until the pipeline settles. Polling
+starts at --interval and slows down while nothing changes. Watch waits for a
+pipeline on the commit last pushed to origin, and exits with:
+  0  all workflows passed
+  1  a job or workflow failed
+  2  the pipeline was canceled
 
 Examples:
   vibe ci-status                 # Check CI for current branch
   vibe ci-status main            # Check CI for main branch
-  vibe ci-status feature-branch  # Check CI for specific branch`,
+  vibe ci-status feature-branch  # Check CI for specific branch
+  vibe ci-status -o json         # Output as JSON
+  vibe ci-status --watch         # Watch until CI finishes
+  vibe ci-status -w --notify     # Watch and show a desktop notification when done
+  git push && vibe ci-status --watch && vibe merge`,
 		Args: cobra.MaximumNArgs(1),
-		RunE: func(_ *cobra.Command, args []string) error {
+		RunE: func(cobraCmd *cobra.Command, args []string) error {
+			ctx = getCommandContext(cobraCmd, ctx)
 			branch := ""
 			if len(args) > 0 {
 				branch = args[0]
 			}
-			return runCIStatus(ctx, branch)
+			return runCIStatus(ctx, branch, opts)
 		},
 	}
 
+	cmd.Flags().BoolVarP(&opts.Watch, "watch", "w", false, "Poll until the pipeline finishes; the exit code reflects the result")
+	addCIWatchFlags(cmd, &opts.CIWatchOptions)
+
 	return cmd
 }
 
-func runCIStatus(ctx *CommandContext, branchArg string) error {
-	// Get CircleCI token
-	token := ctx.Config.CircleCI.APIToken
-	if token == "" {
-		token = os.Getenv("CIRCLECI_TOKEN")
-		if token == "" {
-			token = os.Getenv("CIRCLE_TOKEN")
-		}
-	}
-
-	if token == "" {
-		return fmt.Errorf("CircleCI API token not found.\nSet one of the following:\n  - Add circleci.apiToken to your vibe config\n  - Set CIRCLECI_TOKEN environment variable\n  - Set CIRCLE_TOKEN environment variable")
+func runCIStatus(ctx *CommandContext, branchArg string, opts *CIStatusOptions) error {
+	// Create CI provider
+	provider, err := newCIProvider(ctx)
+	if err != nil {
+		return err
 	}
 
 	// Get branch
 	branch := branchArg
 	if branch == "" {
-		var err error
 		branch, err = ctx.GitRepo.CurrentBranch()
 		if err != nil {
 			return fmt.Errorf("failed to get current branch: %w", err)
 		}
 	}
 
-	// Get project slug
-	projectSlug, err := circleci.GetProjectSlug()
-	if err != nil {
-		return fmt.Errorf("could not determine project from git remote: %w\nMake sure you have a GitHub remote configured", err)
+	if opts.Watch {
+		// Right after a push the latest pipeline may still be the previous
+		// one, so wait for the pipeline of the commit origin has
+		revision, _ := ctx.GitRepo.RemoteCommit(branch)
+		return watchCIStatus(ctx, provider, ciWatchTarget{Branch: branch, Revision: revision}, &opts.CIWatchOptions)
 	}
 
 	cyan := color.New(color.FgCyan)
@@ -74,22 +96,33 @@ func runCIStatus(ctx *CommandContext, branchArg string) error {
 	s.Suffix = fmt.Sprintf(" Checking CI status for %s...", cyan.Sprint(branch))
 	s.Start()
 
-	// Create CircleCI client
-	client := circleci.NewClient(token)
 	cmdCtx := context.Background()
 
-	status, err := client.GetCIStatusForBranch(cmdCtx, branch, projectSlug)
+	status, err := provider.GetCIStatusForBranch(cmdCtx, branch)
 	s.Stop()
 
 	if err != nil {
 		return fmt.Errorf("failed to fetch CI status: %w", err)
 	}
 
+	if status != nil {
+		annotateKnownFlakes(provider, status)
+	}
+
+	if ctx.Output.IsStructured() {
+		if status == nil {
+			return writeOutput(ctx, output.KindCIStatus, nil)
+		}
+		result := output.NewCIStatus(status)
+		result.Status = ciOverallState(countJobs(status.Workflows), status.Workflows)
+		return writeOutput(ctx, output.KindCIStatus, result)
+	}
+
 	if status == nil {
 		yellow := color.New(color.FgYellow)
 		_, _ = yellow.Printf("\nNo CI pipelines found for branch: %s\n", branch)
 		dim := color.New(color.Faint)
-		_, _ = dim.Println("This branch may not have been pushed or CircleCI may not be configured.")
+		_, _ = dim.Println("This branch may not have been pushed or CI may not be configured.")
 		return nil
 	}
 
@@ -99,6 +132,21 @@ func runCIStatus(ctx *CommandContext, branchArg string) error {
 	return nil
 }
 
+// annotateKnownFlakes marks the failed tests that the provider knows to be
+// flaky. Flaky test data is best effort, so errors are ignored.
+func annotateKnownFlakes(provider ci.Provider, status *circleci.CIStatus) {
+	reporter, ok := provider.(ci.FlakyTestReporter)
+	if !ok || len(status.FailedJobs) == 0 {
+		return
+	}
+
+	flaky, err := reporter.FlakyTests(context.Background())
+	if err != nil {
+		return
+	}
+	circleci.MarkKnownFlakes(status, flaky)
+}
+
 func displayCIStatus(status *circleci.CIStatus) {
 	displayCIHeader(status)
 
@@ -126,13 +174,46 @@ func displayCIHeader(status *circleci.CIStatus) {
 
 	fmt.Println()
 	_, _ = bold.Printf("CI Status: %s\n", status.Branch)
-	_, _ = dim.Printf("Project: %s\n", status.ProjectSlug)
-	_, _ = dim.Printf("Pipeline #%d\n", status.PipelineNumber)
+	if len(status.Projects) > 1 {
+		for _, project := range status.Projects {
+			_, _ = dim.Printf("Project: %s, pipeline #%d\n", project.ProjectSlug, project.PipelineNumber)
+		}
+	} else {
+		_, _ = dim.Printf("Project: %s\n", status.ProjectSlug)
+		_, _ = dim.Printf("Pipeline #%d\n", status.PipelineNumber)
+	}
 	fmt.Println()
 }
 
+// workflowLabel names a workflow, with its project when the status spans several
+func workflowLabel(workflow circleci.WorkflowStatus) string {
+	if workflow.ProjectSlug != "" {
+		return fmt.Sprintf("%s (%s)", workflow.Name, workflow.ProjectSlug)
+	}
+	return workflow.Name
+}
+
 func aggregateAndDisplayWorkflows(workflows []circleci.WorkflowStatus) jobCounts {
 	bold := color.New(color.Bold)
+	counts := countJobs(workflows)
+
+	for _, workflow := range workflows {
+		// Display workflow status
+		_, _ = bold.Printf("%s: ", workflowLabel(workflow))
+		fmt.Println(formatWorkflowStatus(workflow.Status))
+
+		// Display jobs
+		for _, job := range workflow.Jobs {
+			fmt.Printf("  %s %s\n", formatJobStatus(job.Status), job.Name)
+		}
+		fmt.Println()
+	}
+
+	return counts
+}
+
+// countJobs aggregates job counts across workflows
+func countJobs(workflows []circleci.WorkflowStatus) jobCounts {
 	counts := jobCounts{}
 
 	for _, workflow := range workflows {
@@ -146,16 +227,6 @@ func aggregateAndDisplayWorkflows(workflows []circleci.WorkflowStatus) jobCounts
 		if workflow.Status == "running" || wfCounts.running > 0 || wfCounts.pending > 0 {
 			counts.isRunning = true
 		}
-
-		// Display workflow status
-		_, _ = bold.Printf("%s: ", workflow.Name)
-		fmt.Println(formatWorkflowStatus(workflow.Status))
-
-		// Display jobs
-		for _, job := range workflow.Jobs {
-			fmt.Printf("  %s %s\n", formatJobStatus(job.Status), job.Name)
-		}
-		fmt.Println()
 	}
 
 	return counts
@@ -197,23 +268,39 @@ func displayOverallStatus(counts jobCounts, workflows []circleci.WorkflowStatus)
 	blue := color.New(color.FgBlue)
 	yellow := color.New(color.FgYellow)
 
-	running := counts.isRunning || counts.running > 0 || counts.pending > 0
-	passed := !running && counts.failed == 0 && allWorkflowsSuccess(workflows)
+	state := ciOverallState(counts, workflows)
 
-	if running {
+	switch {
+	case state == "running":
 		inProgressCount := counts.running + counts.pending
 		jobText := "job"
 		if inProgressCount != 1 {
 			jobText = "jobs"
 		}
 		_, _ = blue.Printf("CI is still running... (%d %s pending)\n", inProgressCount, jobText)
-	} else if passed {
+	case state == "success":
 		_, _ = green.Println("All CI checks passed!")
-	} else if counts.failed > 0 {
+	case counts.failed > 0:
 		_, _ = red.Printf("%d job(s) failed.\n", counts.failed)
-	} else {
-		statuses := getUniqueStatuses(workflows)
-		_, _ = yellow.Printf("CI status: %s\n", statuses)
+	default:
+		_, _ = yellow.Printf("CI status: %s\n", state)
+	}
+}
+
+// ciOverallState summarizes a pipeline as "running", "success", "failed",
+// or the comma-separated workflow statuses when none of those apply
+func ciOverallState(counts jobCounts, workflows []circleci.WorkflowStatus) string {
+	running := counts.isRunning || counts.running > 0 || counts.pending > 0
+
+	switch {
+	case running:
+		return "running"
+	case counts.failed == 0 && allWorkflowsSuccess(workflows):
+		return "success"
+	case counts.failed > 0:
+		return "failed"
+	default:
+		return getUniqueStatuses(workflows)
 	}
 }
 
@@ -235,6 +322,8 @@ func formatJobStatus(status string) string {
 		return gray.Sprint("○ pending")
 	case "canceled":
 		return yellow.Sprint("⊘ canceled")
+	case "allowed_failure":
+		return yellow.Sprint("! failed (allowed)")
 	default:
 		return gray.Sprint(status)
 	}
@@ -286,7 +375,12 @@ func displayFailedJob(job *circleci.FailedJob) {
 			}
 
 			fmt.Println()
-			_, _ = red.Printf("    %s\n", testName)
+			if test.KnownFlake {
+				_, _ = red.Printf("    %s", testName)
+				_, _ = yellow.Println(" (known flake)")
+			} else {
+				_, _ = red.Printf("    %s\n", testName)
+			}
 			if test.File != "" {
 				_, _ = dim.Printf("    File: %s\n", test.File)
 			}
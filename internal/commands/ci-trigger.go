@@ -0,0 +1,152 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/rithyhuot/vibe/internal/services/ci"
+	"github.com/rithyhuot/vibe/internal/services/circleci"
+)
+
+// CITriggerOptions holds flags for the ci trigger command
+type CITriggerOptions struct {
+	CIWatchOptions
+	Branch  string
+	Project string
+	Params  []string
+	Watch   bool
+}
+
+// newCITriggerCommand creates the ci trigger command
+func newCITriggerCommand(ctx *CommandContext) *cobra.Command {
+	opts := &CITriggerOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "trigger",
+		Short: "Trigger a pipeline with parameters",
+		Long: `Triggers a CircleCI pipeline for a branch, optionally with pipeline parameters.
+
+Parameters are checked against the parameters block of .circleci/config.yml:
+names must be declared, values must match the declared type (string, boolean,
+integer, or one of an enum's values), and parameters without a default must be
+set. Without a local config, booleans and integers are inferred from the values.
+
+With --watch, the new pipeline is watched like ci-status --watch, including
+its exit codes.
+
+Examples:
+  vibe ci trigger                                   # Trigger the current branch
+  vibe ci trigger --param run_e2e=true              # Set a boolean parameter
+  vibe ci trigger --branch main --param deploy_env=staging
+  vibe ci trigger --param run_e2e=true --watch      # Trigger and watch until done`,
+		Args: cobra.NoArgs,
+		RunE: func(cobraCmd *cobra.Command, _ []string) error {
+			ctx = getCommandContext(cobraCmd, ctx)
+			return runCITrigger(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Branch, "branch", "", "Branch to run the pipeline on (default: current branch)")
+	addCIProjectFlag(cmd, &opts.Project)
+	cmd.Flags().StringArrayVarP(&opts.Params, "param", "p", nil, "Pipeline parameter as name=value (repeatable)")
+	cmd.Flags().BoolVarP(&opts.Watch, "watch", "w", false, "Watch the pipeline until it finishes; the exit code reflects the result")
+	addCIWatchFlags(cmd, &opts.CIWatchOptions)
+
+	return cmd
+}
+
+func runCITrigger(ctx *CommandContext, opts *CITriggerOptions) error {
+	client, projectSlug, err := requireCircleCI(ctx, "vibe ci trigger", opts.Project)
+	if err != nil {
+		return err
+	}
+
+	branch := opts.Branch
+	if branch == "" {
+		branch, err = ctx.GitRepo.CurrentBranch()
+		if err != nil {
+			return fmt.Errorf("failed to get current branch: %w", err)
+		}
+	}
+
+	declared, err := loadPipelineParameters(ctx)
+	if err != nil {
+		return err
+	}
+
+	params, err := circleci.ParsePipelineParameters(opts.Params, declared)
+	if err != nil {
+		return err
+	}
+
+	cyan := color.New(color.FgCyan)
+
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	s.Suffix = fmt.Sprintf(" Triggering pipeline for %s...", cyan.Sprint(branch))
+	s.Start()
+
+	pipeline, err := client.TriggerPipeline(context.Background(), projectSlug, &circleci.TriggerPipelineRequest{
+		Branch:     branch,
+		Parameters: params,
+	})
+	s.Stop()
+
+	if err != nil {
+		return err
+	}
+
+	green := color.New(color.FgGreen)
+	dim := color.New(color.Faint)
+	_, _ = green.Printf("✓ Triggered pipeline #%d for %s\n", pipeline.Number, branch)
+	_, _ = dim.Printf("  https://app.circleci.com/pipelines/%s/%d\n", projectSlug, pipeline.Number)
+
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		_, _ = dim.Printf("  %s = %v\n", name, params[name])
+	}
+
+	if !opts.Watch {
+		return nil
+	}
+
+	// Until the triggered pipeline is listed, the branch's latest is an earlier one
+	target := ciWatchTarget{Branch: branch, Pipeline: pipeline.Number}
+	return watchCIStatus(ctx, ci.NewCircleCI(client, projectSlug), target, &opts.CIWatchOptions)
+}
+
+// loadPipelineParameters reads the declared pipeline parameters from the
+// repository's CircleCI config. Returns nil when there's no local config.
+func loadPipelineParameters(ctx *CommandContext) (map[string]circleci.PipelineParameter, error) {
+	root, err := ctx.GitRepo.GetRootPath()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository root: %w", err)
+	}
+
+	cfg, err := circleci.LoadConfig(filepath.Join(root, circleci.ConfigPath))
+	if errors.Is(err, os.ErrNotExist) {
+		yellow := color.New(color.FgYellow)
+		_, _ = yellow.Printf("No %s found; parameters aren't validated\n", circleci.ConfigPath)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Parameters == nil {
+		return map[string]circleci.PipelineParameter{}, nil
+	}
+	return cfg.Parameters, nil
+}
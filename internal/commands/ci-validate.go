@@ -0,0 +1,208 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/rithyhuot/vibe/internal/output"
+	"github.com/rithyhuot/vibe/internal/services/circleci"
+)
+
+// compileConfigTimeout bounds the CircleCI compile request, so a slow
+// network doesn't hold up a push
+const compileConfigTimeout = 15 * time.Second
+
+// CIValidateOptions holds flags for the ci validate command
+type CIValidateOptions struct {
+	Offline bool
+	Strict  bool
+	Quiet   bool
+}
+
+// newCIValidateCommand creates the ci validate command
+func newCIValidateCommand(ctx *CommandContext) *cobra.Command {
+	opts := &CIValidateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "validate [path]",
+		Short: "Validate the CircleCI config before pushing",
+		Long: `Validates .circleci/config.yml, or the config at path, before it's pushed.
+
+The structure is checked offline: the version, orb references, that the jobs,
+executors, and commands used are declared, that requires only names jobs of
+the same workflow and has no cycles, and that parameter defaults and the
+arguments passed to jobs and commands match the declared types.
+
+When a CircleCI token is configured, the config is then compiled by CircleCI,
+which resolves orbs and catches what the offline checks can't. If CircleCI
+can't be reached, only the offline checks count. Use --offline to skip it.
+
+Exits with status 1 when there are errors (or warnings, with --strict), so
+it can run as a git pre-push hook. Without a config, it passes.
+
+Examples:
+  vibe ci validate                     # Validate .circleci/config.yml
+  vibe ci validate --offline           # Only the local structural checks
+  vibe ci validate path/to/config.yml  # Validate another config
+  vibe ci validate --offline --quiet   # In a pre-push hook: only print issues`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cobraCmd *cobra.Command, args []string) error {
+			ctx = getCommandContext(cobraCmd, ctx)
+			path := ""
+			if len(args) > 0 {
+				path = args[0]
+			}
+			return runCIValidate(ctx, opts, path)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Offline, "offline", false, "Skip compiling the config with CircleCI")
+	cmd.Flags().BoolVar(&opts.Strict, "strict", false, "Fail on warnings too")
+	cmd.Flags().BoolVarP(&opts.Quiet, "quiet", "q", false, "Only print issues")
+
+	return cmd
+}
+
+func runCIValidate(ctx *CommandContext, opts *CIValidateOptions, path string) error {
+	displayPath := path
+	if path == "" {
+		root, err := ctx.GitRepo.GetRootPath()
+		if err != nil {
+			return fmt.Errorf("failed to get repository root: %w", err)
+		}
+		path = filepath.Join(root, circleci.ConfigPath)
+		displayPath = circleci.ConfigPath
+
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			if !opts.Quiet && !ctx.Output.IsStructured() {
+				yellow := color.New(color.FgYellow)
+				_, _ = yellow.Printf("No %s found; nothing to validate\n", circleci.ConfigPath)
+			}
+			return nil
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", displayPath, err)
+	}
+
+	cfg, err := circleci.ParseConfig(data)
+	if err != nil {
+		return fmt.Errorf("failed to parse %s: %w", displayPath, err)
+	}
+
+	issues := cfg.Validate()
+
+	var compiled *circleci.CompileConfigResponse
+	if !opts.Offline && getCircleCIToken(ctx) != "" {
+		compiled = compileCIConfig(ctx, opts, string(data))
+	}
+
+	result := output.NewCIConfigValidation(displayPath, issues, compiled)
+	failed := !result.Valid || (opts.Strict && len(issues) > 0)
+
+	if ctx.Output.IsStructured() {
+		if err := writeOutput(ctx, output.KindCIConfig, result); err != nil {
+			return err
+		}
+	} else {
+		displayConfigIssues(displayPath, issues, result.CompileErrors)
+		if !failed && !opts.Quiet {
+			displayConfigValid(displayPath, issues, compiled != nil)
+		}
+	}
+
+	if failed {
+		return &ExitError{Code: 1}
+	}
+	return nil
+}
+
+// compileCIConfig compiles a config with CircleCI. Returns nil when
+// CircleCI can't be reached, so validation falls back to the offline checks.
+func compileCIConfig(ctx *CommandContext, opts *CIValidateOptions, configYAML string) *circleci.CompileConfigResponse {
+	client, err := newCircleCIClient(ctx)
+	if err != nil {
+		return nil
+	}
+
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	s.Suffix = " Compiling the config with CircleCI..."
+	if !opts.Quiet {
+		s.Start()
+	}
+
+	cmdCtx, cancel := context.WithTimeout(context.Background(), compileConfigTimeout)
+	defer cancel()
+
+	resp, err := client.CompileConfig(cmdCtx, configYAML)
+	s.Stop()
+
+	if err != nil {
+		if !ctx.Output.IsStructured() {
+			yellow := color.New(color.FgYellow)
+			_, _ = yellow.Printf("⚠ Couldn't compile the config with CircleCI, so only the offline checks ran: %v\n", err)
+		}
+		return nil
+	}
+	return resp
+}
+
+// displayConfigIssues prints issues as path:line: severity: message, the
+// format editors and terminals link to
+func displayConfigIssues(path string, issues []circleci.ConfigIssue, compileErrors []string) {
+	red := color.New(color.FgRed)
+	yellow := color.New(color.FgYellow)
+	dim := color.New(color.Faint)
+
+	for _, issue := range issues {
+		location := path
+		if issue.Line > 0 {
+			location = fmt.Sprintf("%s:%d", path, issue.Line)
+		}
+
+		severity := red
+		if issue.Severity == circleci.SeverityWarning {
+			severity = yellow
+		}
+		fmt.Printf("%s: ", location)
+		_, _ = severity.Printf("%s: ", issue.Severity)
+		fmt.Print(issue.Message)
+		_, _ = dim.Printf("  (%s)\n", issue.Path)
+	}
+
+	if len(compileErrors) > 0 {
+		if len(issues) > 0 {
+			fmt.Println()
+		}
+		_, _ = red.Println("CircleCI couldn't compile the config:")
+		for _, message := range compileErrors {
+			fmt.Printf("  %s\n", message)
+		}
+	}
+}
+
+// displayConfigValid confirms that a config passed validation
+func displayConfigValid(path string, issues []circleci.ConfigIssue, compiled bool) {
+	green := color.New(color.FgGreen)
+	dim := color.New(color.Faint)
+
+	if len(issues) > 0 {
+		fmt.Println()
+	}
+	_, _ = green.Printf("✓ %s is valid\n", path)
+	if compiled {
+		_, _ = dim.Println("  Checked offline and compiled by CircleCI")
+	} else {
+		_, _ = dim.Println("  Checked offline; orbs weren't resolved")
+	}
+}
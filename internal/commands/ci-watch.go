@@ -0,0 +1,462 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/rithyhuot/vibe/internal/output"
+	"github.com/rithyhuot/vibe/internal/services/ci"
+	"github.com/rithyhuot/vibe/internal/services/circleci"
+	"github.com/rithyhuot/vibe/internal/utils"
+)
+
+const (
+	// defaultCIWatchInterval is how often a watched pipeline is polled after it changes
+	defaultCIWatchInterval = 10 * time.Second
+
+	// maxCIWatchInterval caps the poll interval while a pipeline doesn't change
+	maxCIWatchInterval = time.Minute
+
+	// maxCIWatchErrors is how many polls in a row may fail before watching stops
+	maxCIWatchErrors = 3
+
+	// Exit codes of a watched pipeline
+	ciExitSuccess  = 0
+	ciExitFailed   = 1
+	ciExitCanceled = 2
+)
+
+// ciWatchPipelineWait is how long to wait for a pipeline on the pushed commit
+// before falling back to the branch's latest pipeline. A variable so tests
+// can shorten it.
+var ciWatchPipelineWait = 2 * time.Minute
+
+// CIWatchOptions holds flags for watching a pipeline until it settles
+type CIWatchOptions struct {
+	Interval time.Duration
+	Notify   bool
+	Bell     bool
+}
+
+// addCIWatchFlags registers the flags for watching a pipeline
+func addCIWatchFlags(cmd *cobra.Command, opts *CIWatchOptions) {
+	cmd.Flags().DurationVar(&opts.Interval, "interval", defaultCIWatchInterval, "Shortest time between polls while watching")
+	cmd.Flags().BoolVar(&opts.Notify, "notify", false, "Show a desktop notification when the pipeline finishes")
+	cmd.Flags().BoolVar(&opts.Bell, "bell", false, "Ring the terminal bell when the pipeline finishes")
+}
+
+// ciWatchTarget identifies the pipeline to watch on a branch
+type ciWatchTarget struct {
+	Branch   string
+	Revision string // Commit the pipeline runs on; empty for any commit
+	Pipeline int    // Number of a triggered pipeline; 0 for any pipeline
+}
+
+// match returns the latest pipeline of the branch when it's the one being
+// watched, or nil to keep waiting. Pipelines for other commits are only
+// skipped until waited, since the commit may not get a pipeline of its own,
+// but a triggered pipeline is only ever matched by its number.
+func (t ciWatchTarget) match(latest *circleci.CIStatus, waited bool) *circleci.CIStatus {
+	switch {
+	case latest == nil:
+		return nil
+	case t.Pipeline > 0 && latest.PipelineNumber != t.Pipeline:
+		return nil
+	case t.Revision != "" && latest.Revision != "" && latest.Revision != t.Revision && !waited:
+		return nil
+	}
+	return latest
+}
+
+// superseded reports whether a newer pipeline replaced a triggered one as the
+// latest of the branch, which hides the triggered one from then on
+func (t ciWatchTarget) superseded(latest *circleci.CIStatus) bool {
+	return t.Pipeline > 0 && latest != nil && latest.PipelineNumber > t.Pipeline
+}
+
+// watchCIStatus polls the latest pipeline of a branch until it settles,
+// redrawing it in place on a terminal. Pipelines that don't match the target
+// are skipped for up to ciWatchPipelineWait. Returns an ExitError unless the
+// pipeline passed.
+func watchCIStatus(ctx *CommandContext, provider ci.Provider, target ciWatchTarget, opts *CIWatchOptions) error {
+	if opts.Interval <= 0 {
+		return fmt.Errorf("--interval must be positive")
+	}
+
+	structured := ctx.Output.IsStructured()
+	screen := &ciWatchScreen{interactive: !structured && isTerminal()}
+
+	started := time.Now()
+
+	var status *circleci.CIStatus
+	var fetchErr error
+	failures := 0
+	interval := opts.Interval
+	lastSignature := ""
+
+	for {
+		latest, err := provider.GetCIStatusForBranch(context.Background(), target.Branch)
+		fetchErr = err
+		if err != nil {
+			failures++
+			if failures >= maxCIWatchErrors {
+				return fmt.Errorf("failed to fetch CI status: %w", err)
+			}
+		} else {
+			failures = 0
+			if target.superseded(latest) {
+				return fmt.Errorf("CI pipeline #%d was superseded by #%d on branch %s; use 'vibe ci-status --watch' to watch the new one", target.Pipeline, latest.PipelineNumber, target.Branch)
+			}
+			waited := time.Since(started) >= ciWatchPipelineWait
+			// CircleCI lists no status while a pipeline has no workflows, so a
+			// pipeline already being watched is kept until the next poll
+			if latest = target.match(latest, waited); latest != nil {
+				status = latest
+			}
+			if status == nil && waited {
+				if target.Pipeline > 0 {
+					return fmt.Errorf("CI pipeline #%d not found for branch %s after %s", target.Pipeline, target.Branch, ciWatchPipelineWait)
+				}
+				return fmt.Errorf("no CI pipeline found for branch %s after %s", target.Branch, ciWatchPipelineWait)
+			}
+		}
+
+		signature := ciStatusSignature(status)
+		changed := signature != lastSignature
+		lastSignature = signature
+		if changed {
+			interval = opts.Interval
+		} else {
+			interval = nextCIWatchInterval(interval)
+		}
+
+		if status != nil {
+			if settled, code := ciWatchOutcome(status); settled {
+				annotateKnownFlakes(provider, status)
+				return finishCIWatch(ctx, screen, status, code, opts)
+			}
+		}
+
+		if !structured && !screen.interactive && changed {
+			screen.draw(renderCIWatchStatus(status, target, time.Now()))
+		}
+
+		next := time.Now().Add(interval)
+		screen.wait(next, func(now time.Time) string {
+			return renderCIWatchStatus(status, target, now) + renderCIWatchFooter(now, next, fetchErr)
+		})
+	}
+}
+
+// finishCIWatch shows the settled pipeline and signals that watching is done
+func finishCIWatch(ctx *CommandContext, screen *ciWatchScreen, status *circleci.CIStatus, code int, opts *CIWatchOptions) error {
+	if ctx.Output.IsStructured() {
+		result := output.NewCIStatus(status)
+		result.Status = ciWatchResult(code)
+		if err := writeOutput(ctx, output.KindCIStatus, result); err != nil {
+			return err
+		}
+	} else {
+		screen.draw(renderCIWatchStatus(status, ciWatchTarget{Branch: status.Branch}, time.Now()))
+		displayFailedJobs(status.FailedJobs)
+		displayCIWatchResult(code, countJobs(status.Workflows))
+	}
+
+	notifyCIWatchDone(status, code, opts)
+
+	if code != ciExitSuccess {
+		return &ExitError{Code: code}
+	}
+	return nil
+}
+
+// ciWatchResult names the result of a settled pipeline
+func ciWatchResult(code int) string {
+	switch code {
+	case ciExitFailed:
+		return "failed"
+	case ciExitCanceled:
+		return "canceled"
+	default:
+		return "success"
+	}
+}
+
+// displayCIWatchResult prints the result of a settled pipeline. Jobs that
+// didn't run aren't pending once the pipeline has settled, so this doesn't
+// use displayOverallStatus.
+func displayCIWatchResult(code int, counts jobCounts) {
+	switch code {
+	case ciExitFailed:
+		red := color.New(color.FgRed)
+		if counts.failed > 0 {
+			_, _ = red.Printf("%d job(s) failed.\n", counts.failed)
+		} else {
+			_, _ = red.Println("CI failed.")
+		}
+	case ciExitCanceled:
+		yellow := color.New(color.FgYellow)
+		_, _ = yellow.Println("CI was canceled.")
+	default:
+		green := color.New(color.FgGreen)
+		_, _ = green.Println("All CI checks passed!")
+	}
+}
+
+// ciWatchOutcome reports whether a pipeline has settled and the exit code
+// for its result. Pipelines waiting for approval, or for their workflows to
+// be created, haven't settled.
+func ciWatchOutcome(status *circleci.CIStatus) (bool, int) {
+	workflows := status.Workflows
+
+	switch {
+	case len(workflows) == 0:
+		return false, 0
+	case hasWorkflowStatus(workflows, "running", "failing", "queued", "on_hold"):
+		return false, 0
+	case hasWorkflowStatus(workflows, "failed", "error", "unauthorized"):
+		return true, ciExitFailed
+	case hasWorkflowStatus(workflows, "canceled"):
+		return true, ciExitCanceled
+	case countJobs(workflows).failed > 0:
+		return true, ciExitFailed
+	default:
+		return true, ciExitSuccess
+	}
+}
+
+// hasWorkflowStatus reports whether any workflow has one of the statuses
+func hasWorkflowStatus(workflows []circleci.WorkflowStatus, statuses ...string) bool {
+	for _, workflow := range workflows {
+		for _, status := range statuses {
+			if workflow.Status == status {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ciStatusSignature summarizes the workflow and job statuses of a pipeline,
+// so polls can tell whether anything changed
+func ciStatusSignature(status *circleci.CIStatus) string {
+	if status == nil {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(status.PipelineID)
+	for _, workflow := range status.Workflows {
+		fmt.Fprintf(&b, "|%s=%s", workflow.ID, workflow.Status)
+		for _, job := range workflow.Jobs {
+			fmt.Fprintf(&b, ",%s=%s", job.Name, job.Status)
+		}
+	}
+	return b.String()
+}
+
+// nextCIWatchInterval backs off the poll interval while a pipeline doesn't change
+func nextCIWatchInterval(current time.Duration) time.Duration {
+	next := current * 3 / 2
+	if next > maxCIWatchInterval {
+		next = maxCIWatchInterval
+	}
+	return max(next, current)
+}
+
+// renderCIWatchStatus renders a watched pipeline with job and workflow
+// durations, or what it's waiting for when there's no pipeline yet
+func renderCIWatchStatus(status *circleci.CIStatus, target ciWatchTarget, now time.Time) string {
+	bold := color.New(color.Bold)
+	dim := color.New(color.Faint)
+	yellow := color.New(color.FgYellow)
+
+	var b strings.Builder
+	fmt.Fprintln(&b)
+
+	if status == nil {
+		switch {
+		case target.Pipeline > 0:
+			_, _ = yellow.Fprintf(&b, "Waiting for CI pipeline #%d on %s...\n\n", target.Pipeline, target.Branch)
+		case target.Revision != "":
+			_, _ = yellow.Fprintf(&b, "Waiting for a CI pipeline on %s (%s)...\n\n", target.Branch, shortSHA(target.Revision))
+		default:
+			_, _ = yellow.Fprintf(&b, "Waiting for a CI pipeline on %s...\n\n", target.Branch)
+		}
+		return b.String()
+	}
+
+	_, _ = bold.Fprintf(&b, "CI Status: %s\n", status.Branch)
+	switch {
+	case len(status.Projects) > 1:
+		for _, project := range status.Projects {
+			_, _ = dim.Fprintf(&b, "Project: %s, pipeline #%d\n", project.ProjectSlug, project.PipelineNumber)
+		}
+	case status.Revision != "":
+		_, _ = dim.Fprintf(&b, "Project: %s\n", status.ProjectSlug)
+		_, _ = dim.Fprintf(&b, "Pipeline #%d (%s)\n", status.PipelineNumber, shortSHA(status.Revision))
+	default:
+		_, _ = dim.Fprintf(&b, "Project: %s\n", status.ProjectSlug)
+		_, _ = dim.Fprintf(&b, "Pipeline #%d\n", status.PipelineNumber)
+	}
+	fmt.Fprintln(&b)
+
+	for _, workflow := range status.Workflows {
+		_, _ = bold.Fprintf(&b, "%s: ", workflowLabel(workflow))
+		b.WriteString(formatWorkflowStatus(workflow.Status))
+		if d := workflowDuration(workflow, now); d > 0 {
+			_, _ = dim.Fprintf(&b, " (%s)", formatCIDuration(d))
+		}
+		fmt.Fprintln(&b)
+
+		for _, job := range workflow.Jobs {
+			fmt.Fprintf(&b, "  %s %s", formatJobStatus(job.Status), job.Name)
+			if d := jobDuration(job, now); d > 0 {
+				_, _ = dim.Fprintf(&b, " (%s)", formatCIDuration(d))
+			}
+			fmt.Fprintln(&b)
+		}
+		fmt.Fprintln(&b)
+	}
+
+	return b.String()
+}
+
+// renderCIWatchFooter renders when the next poll happens and the last poll's error
+func renderCIWatchFooter(now, next time.Time, fetchErr error) string {
+	dim := color.New(color.Faint)
+	yellow := color.New(color.FgYellow)
+
+	var b strings.Builder
+	if fetchErr != nil {
+		_, _ = yellow.Fprintf(&b, "Failed to fetch CI status, retrying: %v\n", fetchErr)
+	}
+	remaining := next.Sub(now).Round(time.Second)
+	_, _ = dim.Fprintf(&b, "Next check in %s (Ctrl+C to stop)\n", formatCIDuration(max(remaining, 0)))
+	return b.String()
+}
+
+// jobDuration returns how long a job ran, or has been running; zero if it hasn't started
+func jobDuration(job circleci.Job, now time.Time) time.Duration {
+	if job.StartedAt == nil {
+		return 0
+	}
+	end := now
+	if job.StoppedAt != nil {
+		end = *job.StoppedAt
+	}
+	return end.Sub(*job.StartedAt)
+}
+
+// workflowDuration returns the time from a workflow's first job starting to
+// its last job stopping, or to now while it runs
+func workflowDuration(workflow circleci.WorkflowStatus, now time.Time) time.Duration {
+	var start, end time.Time
+	running := workflow.Status == "running"
+	for _, job := range workflow.Jobs {
+		if job.StartedAt == nil {
+			continue
+		}
+		if start.IsZero() || job.StartedAt.Before(start) {
+			start = *job.StartedAt
+		}
+		if job.StoppedAt == nil {
+			running = true
+		} else if job.StoppedAt.After(end) {
+			end = *job.StoppedAt
+		}
+	}
+
+	if start.IsZero() {
+		return 0
+	}
+	if running {
+		end = now
+	}
+	return end.Sub(start)
+}
+
+// formatCIDuration formats a duration to the second, e.g. 45s, 3m05s, or 1h02m
+func formatCIDuration(d time.Duration) string {
+	d = d.Round(time.Second)
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm%02ds", int(d.Minutes()), int(d.Seconds())%60)
+	default:
+		return fmt.Sprintf("%dh%02dm", int(d.Hours()), int(d.Minutes())%60)
+	}
+}
+
+// shortSHA abbreviates a commit SHA for display
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
+
+// notifyCIWatchDone rings the bell and shows a desktop notification when requested
+func notifyCIWatchDone(status *circleci.CIStatus, code int, opts *CIWatchOptions) {
+	if opts.Bell {
+		// The bell goes to stderr so it doesn't end up in structured output
+		_, _ = fmt.Fprint(os.Stderr, "\a")
+	}
+
+	if !opts.Notify {
+		return
+	}
+
+	title := "vibe: CI passed"
+	if code != ciExitSuccess {
+		title = "vibe: CI " + ciWatchResult(code)
+	}
+	message := fmt.Sprintf("%s: pipeline #%d", status.Branch, status.PipelineNumber)
+	if err := utils.Notify(title, message); err != nil {
+		yellow := color.New(color.FgYellow)
+		_, _ = yellow.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+}
+
+// ciWatchScreen draws the frames of a watched pipeline, replacing the
+// previous frame on a terminal
+type ciWatchScreen struct {
+	interactive bool
+	lines       int
+}
+
+// draw prints a frame, first clearing the previous one on a terminal
+func (s *ciWatchScreen) draw(frame string) {
+	if s.interactive && s.lines > 0 {
+		// Move the cursor to the start of the previous frame and clear to the end of the screen
+		fmt.Printf("\x1b[%dA\r\x1b[J", s.lines)
+	}
+	fmt.Print(frame)
+	s.lines = strings.Count(frame, "\n")
+}
+
+// wait sleeps until the next poll. On a terminal the frame is redrawn every
+// second so running durations and the countdown stay current.
+func (s *ciWatchScreen) wait(until time.Time, frame func(now time.Time) string) {
+	if !s.interactive {
+		time.Sleep(time.Until(until))
+		return
+	}
+
+	for {
+		now := time.Now()
+		s.draw(frame(now))
+		remaining := until.Sub(now)
+		if remaining <= 0 {
+			return
+		}
+		time.Sleep(min(remaining, time.Second))
+	}
+}
@@ -0,0 +1,203 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rithyhuot/vibe/internal/output"
+	"github.com/rithyhuot/vibe/internal/services/ci"
+	"github.com/rithyhuot/vibe/internal/services/circleci"
+)
+
+// fakeCIProvider returns a scripted status on each poll, repeating the last one
+type fakeCIProvider struct {
+	ci.Provider
+	statuses []*circleci.CIStatus
+	polls    int
+}
+
+func (p *fakeCIProvider) GetCIStatusForBranch(_ context.Context, _ string) (*circleci.CIStatus, error) {
+	status := p.statuses[min(p.polls, len(p.statuses)-1)]
+	p.polls++
+	return status, nil
+}
+
+func TestWatchCIStatus_KeepsPipelineWhenPollIsEmpty(t *testing.T) {
+	wait := ciWatchPipelineWait
+	ciWatchPipelineWait = 0
+	defer func() { ciWatchPipelineWait = wait }()
+
+	workflow := func(status string) []circleci.WorkflowStatus {
+		return []circleci.WorkflowStatus{{ID: "wf-1", Name: "build", Status: status}}
+	}
+	provider := &fakeCIProvider{statuses: []*circleci.CIStatus{
+		{Branch: "main", PipelineNumber: 7, Workflows: workflow("running")},
+		nil, // The pipeline briefly has no workflows
+		{Branch: "main", PipelineNumber: 7, Workflows: workflow("failed")},
+	}}
+
+	ctx := &CommandContext{Output: output.FormatJSON}
+	err := watchCIStatus(ctx, provider, ciWatchTarget{Branch: "main"}, &CIWatchOptions{Interval: time.Millisecond})
+
+	var exitErr *ExitError
+	if assert.True(t, errors.As(err, &exitErr), "expected an exit error, got %v", err) {
+		assert.Equal(t, ciExitFailed, exitErr.Code)
+	}
+	assert.Equal(t, 3, provider.polls)
+}
+
+func TestWatchCIStatus_NoPipeline(t *testing.T) {
+	wait := ciWatchPipelineWait
+	ciWatchPipelineWait = 0
+	defer func() { ciWatchPipelineWait = wait }()
+
+	provider := &fakeCIProvider{statuses: []*circleci.CIStatus{nil}}
+
+	ctx := &CommandContext{Output: output.FormatJSON}
+	err := watchCIStatus(ctx, provider, ciWatchTarget{Branch: "main"}, &CIWatchOptions{Interval: time.Millisecond})
+
+	assert.ErrorContains(t, err, "no CI pipeline found for branch main")
+}
+
+func TestWatchCIStatus_TriggeredPipelineSuperseded(t *testing.T) {
+	provider := &fakeCIProvider{statuses: []*circleci.CIStatus{
+		{Branch: "main", PipelineNumber: 42, Workflows: []circleci.WorkflowStatus{{ID: "wf-1", Status: "running"}}},
+		{Branch: "main", PipelineNumber: 43, Workflows: []circleci.WorkflowStatus{{ID: "wf-2", Status: "success"}}},
+	}}
+
+	ctx := &CommandContext{Output: output.FormatJSON}
+	err := watchCIStatus(ctx, provider, ciWatchTarget{Branch: "main", Pipeline: 42}, &CIWatchOptions{Interval: time.Millisecond})
+
+	assert.ErrorContains(t, err, "CI pipeline #42 was superseded by #43")
+}
+
+func TestCIWatchTarget_Match(t *testing.T) {
+	earlier := &circleci.CIStatus{PipelineNumber: 41, Revision: "aaa111"}
+	triggered := &circleci.CIStatus{PipelineNumber: 42, Revision: "aaa111"}
+	pushed := &circleci.CIStatus{PipelineNumber: 43, Revision: "bbb222"}
+
+	tests := []struct {
+		name     string
+		target   ciWatchTarget
+		latest   *circleci.CIStatus
+		waited   bool
+		expected *circleci.CIStatus
+	}{
+		{name: "no pipeline", target: ciWatchTarget{Branch: "main"}, latest: nil, expected: nil},
+		{name: "any pipeline", target: ciWatchTarget{Branch: "main"}, latest: earlier, expected: earlier},
+		{name: "triggered pipeline", target: ciWatchTarget{Pipeline: 42}, latest: triggered, expected: triggered},
+		{name: "pipeline before the triggered one", target: ciWatchTarget{Pipeline: 42}, latest: earlier, expected: nil},
+		{name: "pipeline before the triggered one after waiting", target: ciWatchTarget{Pipeline: 42}, latest: earlier, waited: true, expected: nil},
+		{name: "pipeline after the triggered one", target: ciWatchTarget{Pipeline: 42}, latest: pushed, expected: nil},
+		{name: "pipeline after the triggered one after waiting", target: ciWatchTarget{Pipeline: 42}, latest: pushed, waited: true, expected: nil},
+		{name: "pipeline on the revision", target: ciWatchTarget{Revision: "bbb222"}, latest: pushed, expected: pushed},
+		{name: "pipeline on another revision", target: ciWatchTarget{Revision: "bbb222"}, latest: triggered, expected: nil},
+		{name: "pipeline on another revision after waiting", target: ciWatchTarget{Revision: "bbb222"}, latest: triggered, waited: true, expected: triggered},
+		{name: "pipeline without a revision", target: ciWatchTarget{Revision: "bbb222"}, latest: &circleci.CIStatus{PipelineNumber: 7}, expected: &circleci.CIStatus{PipelineNumber: 7}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.target.match(tt.latest, tt.waited))
+		})
+	}
+}
+
+func TestCIWatchOutcome(t *testing.T) {
+	workflow := func(status string, jobs ...circleci.Job) circleci.WorkflowStatus {
+		return circleci.WorkflowStatus{ID: "wf-" + status, Name: "build", Status: status, Jobs: jobs}
+	}
+
+	tests := []struct {
+		name        string
+		workflows   []circleci.WorkflowStatus
+		wantSettled bool
+		wantCode    int
+	}{
+		{name: "running", workflows: []circleci.WorkflowStatus{workflow("running")}, wantSettled: false},
+		{name: "failing keeps running", workflows: []circleci.WorkflowStatus{workflow("failing")}, wantSettled: false},
+		{name: "on hold waits for approval", workflows: []circleci.WorkflowStatus{workflow("success"), workflow("on_hold")}, wantSettled: false},
+		{name: "success", workflows: []circleci.WorkflowStatus{workflow("success")}, wantSettled: true, wantCode: ciExitSuccess},
+		{name: "workflows not created yet", workflows: nil, wantSettled: false},
+		{name: "failed", workflows: []circleci.WorkflowStatus{workflow("success"), workflow("failed")}, wantSettled: true, wantCode: ciExitFailed},
+		{name: "error", workflows: []circleci.WorkflowStatus{workflow("error")}, wantSettled: true, wantCode: ciExitFailed},
+		{name: "unauthorized", workflows: []circleci.WorkflowStatus{workflow("unauthorized")}, wantSettled: true, wantCode: ciExitFailed},
+		{name: "failed wins over canceled", workflows: []circleci.WorkflowStatus{workflow("canceled"), workflow("failed")}, wantSettled: true, wantCode: ciExitFailed},
+		{name: "canceled", workflows: []circleci.WorkflowStatus{workflow("success"), workflow("canceled")}, wantSettled: true, wantCode: ciExitCanceled},
+		{
+			name:        "failed job in a settled workflow",
+			workflows:   []circleci.WorkflowStatus{workflow("success", circleci.Job{Name: "test", Status: "failed"})},
+			wantSettled: true,
+			wantCode:    ciExitFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settled, code := ciWatchOutcome(&circleci.CIStatus{Workflows: tt.workflows})
+			assert.Equal(t, tt.wantSettled, settled)
+			if tt.wantSettled {
+				assert.Equal(t, tt.wantCode, code)
+			}
+		})
+	}
+}
+
+func TestNextCIWatchInterval(t *testing.T) {
+	tests := []struct {
+		current  time.Duration
+		expected time.Duration
+	}{
+		{current: 10 * time.Second, expected: 15 * time.Second},
+		{current: 15 * time.Second, expected: 22500 * time.Millisecond},
+		{current: 50 * time.Second, expected: time.Minute},
+		{current: time.Minute, expected: time.Minute},
+		// An --interval above the cap is never shortened
+		{current: 2 * time.Minute, expected: 2 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.current.String(), func(t *testing.T) {
+			assert.Equal(t, tt.expected, nextCIWatchInterval(tt.current))
+		})
+	}
+
+	// Backing off from the default reaches the cap and stays there
+	interval := defaultCIWatchInterval
+	for range 10 {
+		interval = nextCIWatchInterval(interval)
+	}
+	assert.Equal(t, maxCIWatchInterval, interval)
+}
+
+func TestCIStatusSignature(t *testing.T) {
+	status := func(workflowStatus, jobStatus string) *circleci.CIStatus {
+		return &circleci.CIStatus{
+			PipelineID: "pipeline-1",
+			Workflows: []circleci.WorkflowStatus{{
+				ID:     "wf-1",
+				Status: workflowStatus,
+				Jobs:   []circleci.Job{{Name: "test", Status: jobStatus}},
+			}},
+		}
+	}
+
+	assert.Empty(t, ciStatusSignature(nil))
+	assert.Equal(t, ciStatusSignature(status("running", "running")), ciStatusSignature(status("running", "running")))
+	assert.NotEqual(t, ciStatusSignature(status("running", "running")), ciStatusSignature(status("running", "success")))
+	assert.NotEqual(t, ciStatusSignature(status("running", "success")), ciStatusSignature(status("success", "success")))
+
+	other := status("running", "running")
+	other.PipelineID = "pipeline-2"
+	assert.NotEqual(t, ciStatusSignature(status("running", "running")), ciStatusSignature(other))
+
+	// Durations change on every poll, so they aren't part of the signature
+	started := time.Now()
+	timed := status("running", "running")
+	timed.Workflows[0].Jobs[0].StartedAt = &started
+	assert.Equal(t, ciStatusSignature(status("running", "running")), ciStatusSignature(timed))
+}
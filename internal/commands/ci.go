@@ -0,0 +1,112 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	survey "github.com/AlecAivazis/survey/v2"
+	"github.com/briandowns/spinner"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/rithyhuot/vibe/internal/services/circleci"
+)
+
+// CIActionOptions holds the flags shared by the ci subcommands that change a pipeline
+type CIActionOptions struct {
+	Branch  string
+	Project string
+	Yes     bool
+}
+
+// NewCICommand creates the ci command, grouping actions on CI pipelines
+func NewCICommand(ctx *CommandContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ci",
+		Short: "Act on CI pipelines",
+		Long: `Trigger CircleCI pipelines, validate the CircleCI config, download job artifacts, find flaky tests, show pipeline history, and rerun, cancel, or approve the workflows of a branch's latest pipeline.
+
+Use ci-status and ci-failure to inspect a pipeline first.
+
+Examples:
+  vibe ci trigger -p run_e2e=true       # Trigger a pipeline with a parameter
+  vibe ci validate                      # Validate .circleci/config.yml before pushing
+  vibe ci artifacts --download '*.png'  # Download screenshots of the failed job
+  vibe ci flaky --base                  # Find flaky tests on the base branch
+  vibe ci history --branch main         # Recent pipelines and job durations on main
+  vibe ci rerun --failed-only           # Rerun the failed jobs of the current branch
+  vibe ci rerun --ssh                   # Rerun failed jobs with SSH enabled
+  vibe ci cancel                        # Cancel running workflows
+  vibe ci approve hold-deploy           # Approve the hold-deploy job`,
+	}
+
+	cmd.AddCommand(
+		newCITriggerCommand(ctx),
+		newCIValidateCommand(ctx),
+		newCIArtifactsCommand(ctx),
+		newCIFlakyCommand(ctx),
+		newCIHistoryCommand(ctx),
+		newCIRerunCommand(ctx),
+		newCICancelCommand(ctx),
+		newCIApproveCommand(ctx),
+	)
+
+	return cmd
+}
+
+// addCIActionFlags registers the flags shared by the ci action subcommands
+func addCIActionFlags(cmd *cobra.Command, opts *CIActionOptions) {
+	cmd.Flags().StringVar(&opts.Branch, "branch", "", "Branch whose latest pipeline to act on (default: current branch)")
+	addCIProjectFlag(cmd, &opts.Project)
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Skip confirmation prompts")
+}
+
+// addCIProjectFlag registers the --project flag of the CircleCI-only subcommands
+func addCIProjectFlag(cmd *cobra.Command, project *string) {
+	cmd.Flags().StringVar(project, "project", "", "CircleCI project to use, from circleci.extra_projects (default: the main project)")
+}
+
+// fetchLatestPipeline retrieves the CI status of the latest CircleCI pipeline
+// for a branch, defaulting to the current branch. Returns nil if there's none.
+func fetchLatestPipeline(ctx *CommandContext, client circleci.Client, projectSlug, branch string) (*circleci.CIStatus, error) {
+	if branch == "" {
+		var err error
+		branch, err = ctx.GitRepo.CurrentBranch()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get current branch: %w", err)
+		}
+	}
+
+	cyan := color.New(color.FgCyan)
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	s.Suffix = fmt.Sprintf(" Finding the latest pipeline for %s...", cyan.Sprint(branch))
+	s.Start()
+
+	status, err := client.GetCIStatusForBranch(context.Background(), branch, projectSlug)
+	s.Stop()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch CI status: %w", err)
+	}
+
+	if status == nil {
+		yellow := color.New(color.FgYellow)
+		_, _ = yellow.Printf("No CI pipelines found for branch: %s\n", branch)
+	}
+
+	return status, nil
+}
+
+// confirmCIAction asks before changing a pipeline unless --yes was given
+func confirmCIAction(opts *CIActionOptions, message string) (bool, error) {
+	if opts.Yes {
+		return true, nil
+	}
+
+	confirmed := false
+	if err := survey.AskOne(&survey.Confirm{Message: message, Default: true}, &confirmed); err != nil {
+		return false, err
+	}
+	return confirmed, nil
+}
@@ -0,0 +1,236 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/rithyhuot/vibe/internal/config"
+	"github.com/rithyhuot/vibe/internal/services/ci"
+	"github.com/rithyhuot/vibe/internal/services/circleci"
+	"github.com/rithyhuot/vibe/internal/services/github"
+)
+
+// newCIProvider creates the CI provider selected by ci.provider, or detected
+// from the repository when it isn't set
+func newCIProvider(ctx *CommandContext) (ci.Provider, error) {
+	switch selectedCIProvider(ctx) {
+	case config.CIProviderGitLab:
+		if ctx.GitLabClient == nil {
+			return nil, fmt.Errorf("ci.provider is gitlab, but the origin remote isn't hosted on GitLab")
+		}
+		return ci.NewGitLab(ctx.GitLabClient), nil
+	case config.CIProviderGitHubActions:
+		client, err := newActionsClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return ci.NewGitHubActions(client), nil
+	default:
+		client, projectSlugs, err := newCircleCIProjects(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(projectSlugs) == 1 {
+			return ci.NewCircleCI(client, projectSlugs[0]), nil
+		}
+		return ci.NewCircleCIProjects(client, projectSlugs), nil
+	}
+}
+
+// providerForProject returns the provider of one project of a provider that
+// spans several, or provider itself when slug is empty or its main project
+func providerForProject(provider ci.Provider, slug string) (ci.Provider, error) {
+	if slug == "" || slug == provider.ProjectSlug() {
+		return provider, nil
+	}
+	multi, ok := provider.(ci.MultiProject)
+	if !ok {
+		return nil, fmt.Errorf("unknown CircleCI project %q (add it to circleci.extra_projects)", slug)
+	}
+	return multi.Project(slug)
+}
+
+// requireCircleCI creates a CircleCI client and project slug for commands
+// that only CircleCI supports, failing when another provider is in use. The
+// project is the main one, or the configured project given with --project.
+func requireCircleCI(ctx *CommandContext, command, project string) (*circleci.HTTPClient, string, error) {
+	if provider := selectedCIProvider(ctx); provider != config.CIProviderCircleCI {
+		return nil, "", fmt.Errorf("%s is only supported for CircleCI (this repository uses %s)", command, provider)
+	}
+
+	client, projectSlugs, err := newCircleCIProjects(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	projectSlug, err := selectCircleCIProject(projectSlugs, project)
+	if err != nil {
+		return nil, "", err
+	}
+	return client, projectSlug, nil
+}
+
+// selectCircleCIProject returns project when it's one of projectSlugs, or the
+// main project, which comes first, when project is empty
+func selectCircleCIProject(projectSlugs []string, project string) (string, error) {
+	if project == "" {
+		return projectSlugs[0], nil
+	}
+	for _, slug := range projectSlugs {
+		if slug == project {
+			return slug, nil
+		}
+	}
+	if len(projectSlugs) == 1 {
+		return "", fmt.Errorf("unknown CircleCI project %q (add it to circleci.extra_projects)", project)
+	}
+	return "", fmt.Errorf("unknown CircleCI project %q (configured: %s)", project, strings.Join(projectSlugs, ", "))
+}
+
+// newCircleCIProjects creates a CircleCI client and resolves the main project
+// followed by circleci.extra_projects
+func newCircleCIProjects(ctx *CommandContext) (*circleci.HTTPClient, []string, error) {
+	client, projectSlug, err := newCircleCIProject(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	projectSlugs := []string{projectSlug}
+	for _, slug := range ctx.Config.CircleCI.ExtraProjects {
+		if err := circleci.ValidateProjectSlug(slug); err != nil {
+			return nil, nil, fmt.Errorf("circleci.extra_projects: %w", err)
+		}
+		projectSlugs = append(projectSlugs, slug)
+	}
+	return client, projectSlugs, nil
+}
+
+// newCircleCIProject creates a CircleCI client and resolves the project slug
+// from circleci.project_slug or the git remote
+func newCircleCIProject(ctx *CommandContext) (*circleci.HTTPClient, string, error) {
+	client, err := newCircleCIClient(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if projectSlug := ctx.Config.CircleCI.ProjectSlug; projectSlug != "" {
+		if err := circleci.ValidateProjectSlug(projectSlug); err != nil {
+			return nil, "", fmt.Errorf("circleci.project_slug: %w", err)
+		}
+		return client, projectSlug, nil
+	}
+
+	projectSlug, err := circleci.GetProjectSlug()
+	if err != nil {
+		return nil, "", fmt.Errorf("could not determine project from git remote: %w\nUse a GitHub or Bitbucket remote, or set circleci.project_slug in .vibe.yaml", err)
+	}
+	return client, projectSlug, nil
+}
+
+// selectedCIProvider returns ci.provider, or the detected provider when it isn't set
+func selectedCIProvider(ctx *CommandContext) string {
+	if ctx.Config.CI.Provider != "" {
+		return ctx.Config.CI.Provider
+	}
+	return detectCIProvider(ctx)
+}
+
+// detectCIProvider picks the CI provider from the origin remote and the CI
+// configuration in the repository. CircleCI wins when both .circleci/config.yml
+// and .github/workflows exist, and is the fallback when neither does.
+func detectCIProvider(ctx *CommandContext) string {
+	if ctx.GitLabClient != nil {
+		return config.CIProviderGitLab
+	}
+
+	root, err := ctx.GitRepo.GetRootPath()
+	if err != nil {
+		return config.CIProviderCircleCI
+	}
+
+	if _, err := os.Stat(filepath.Join(root, ".circleci", "config.yml")); err == nil {
+		return config.CIProviderCircleCI
+	}
+	if info, err := os.Stat(filepath.Join(root, ".github", "workflows")); err == nil && info.IsDir() {
+		return config.CIProviderGitHubActions
+	}
+	return config.CIProviderCircleCI
+}
+
+// getCircleCIToken resolves the CircleCI token from config or environment
+func getCircleCIToken(ctx *CommandContext) string {
+	token := ctx.Config.CircleCI.APIToken
+	if token == "" {
+		token = os.Getenv("CIRCLECI_TOKEN")
+		if token == "" {
+			token = os.Getenv("CIRCLE_TOKEN")
+		}
+	}
+	return token
+}
+
+// newCircleCIClient creates a CircleCI client using the configured token, retry budget, and cache
+func newCircleCIClient(ctx *CommandContext) (*circleci.HTTPClient, error) {
+	token := getCircleCIToken(ctx)
+	if token == "" {
+		return nil, fmt.Errorf("CircleCI API token not found.\nSet one of the following:\n  - Add circleci.api_token to your vibe config\n  - Set CIRCLECI_TOKEN environment variable\n  - Set CIRCLE_TOKEN environment variable")
+	}
+
+	return circleci.NewClient(token).
+		WithRetryPolicy(retryPolicy(ctx.Config.HTTP.CircleCI)).
+		WithCache(responseCache(ctx.Config, cacheNamespaceCircleCI, ctx.Config.Cache.CircleCI)), nil
+}
+
+// newActionsClient creates a GitHub Actions client for the origin repository.
+// The Actions API has no gh CLI equivalent, so in CLI mode the gh token is used.
+func newActionsClient(ctx *CommandContext) (*github.HTTPClient, error) {
+	token := ctx.Config.GitHub.Token
+	if token == "" {
+		token = os.Getenv("GITHUB_TOKEN")
+	}
+	if token == "" {
+		token, _ = github.GHAuthToken()
+	}
+	if token == "" {
+		return nil, fmt.Errorf("GitHub token not found for GitHub Actions.\nSet one of the following:\n  - Add github.token to your vibe config\n  - Set GITHUB_TOKEN environment variable\n  - Log in with the gh CLI: gh auth login")
+	}
+
+	owner, repo, err := getRepoFromGitRemote()
+	if err != nil {
+		owner, repo = ctx.Config.GitHub.Owner, ctx.Config.GitHub.Repo
+	}
+
+	return github.NewClient(token, owner, repo).
+		WithRetryPolicy(retryPolicy(ctx.Config.HTTP.GitHub)).
+		WithCache(responseCache(ctx.Config, cacheNamespaceGitHub, ctx.Config.Cache.GitHub)), nil
+}
+
+// maxCIFetches is how many CI API requests are made at once when walking pipeline history
+const maxCIFetches = 8
+
+// forEachConcurrently calls fn for 0..n-1 with at most limit calls at once,
+// returning the first error
+func forEachConcurrently(n, limit int, fn func(i int) error) error {
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+	sem := make(chan struct{}, limit)
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			if err := fn(i); err != nil {
+				once.Do(func() { firstErr = err })
+			}
+		}(i)
+	}
+
+	wg.Wait()
+	return firstErr
+}
@@ -0,0 +1,37 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectCircleCIProject(t *testing.T) {
+	projects := []string{"gh/acme/app", "gh/acme/deploy"}
+
+	tests := []struct {
+		name        string
+		projects    []string
+		project     string
+		expected    string
+		expectedErr string
+	}{
+		{name: "main project by default", projects: projects, expected: "gh/acme/app"},
+		{name: "main project by name", projects: projects, project: "gh/acme/app", expected: "gh/acme/app"},
+		{name: "extra project", projects: projects, project: "gh/acme/deploy", expected: "gh/acme/deploy"},
+		{name: "unknown project", projects: projects, project: "gh/acme/other", expectedErr: "configured: gh/acme/app, gh/acme/deploy"},
+		{name: "no extra projects", projects: projects[:1], project: "gh/acme/deploy", expectedErr: "add it to circleci.extra_projects"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := selectCircleCIProject(tt.projects, tt.project)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
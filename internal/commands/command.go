@@ -3,6 +3,7 @@ package commands
 import (
 	"context"
 	"fmt"
+	"os"
 	"os/exec"
 	"strings"
 	"time"
@@ -11,11 +12,17 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/rithyhuot/vibe/internal/config"
+	"github.com/rithyhuot/vibe/internal/output"
 	"github.com/rithyhuot/vibe/internal/services/claude"
 	"github.com/rithyhuot/vibe/internal/services/clickup"
 	"github.com/rithyhuot/vibe/internal/services/git"
 	"github.com/rithyhuot/vibe/internal/services/github"
+	"github.com/rithyhuot/vibe/internal/services/gitlab"
+	"github.com/rithyhuot/vibe/internal/services/jira"
+	"github.com/rithyhuot/vibe/internal/services/linear"
+	"github.com/rithyhuot/vibe/internal/services/tracker"
 	"github.com/rithyhuot/vibe/internal/ui"
+	"github.com/rithyhuot/vibe/internal/utils"
 )
 
 const (
@@ -28,6 +35,14 @@ const (
 	// gitStashTimeout is the maximum time to wait for git stash operation
 	// 30s should be sufficient for most repos; very large repos may need adjustment
 	gitStashTimeout = 30 * time.Second
+
+	// Disk cache namespaces, one per service
+	cacheNamespaceClickUp  = "clickup"
+	cacheNamespaceJira     = "jira"
+	cacheNamespaceGitHub   = "github"
+	cacheNamespaceGitLab   = "gitlab"
+	cacheNamespaceCircleCI = "circleci"
+	cacheNamespaceSprint   = "sprint"
 )
 
 // contextKey is a custom type for context keys to avoid collisions
@@ -39,6 +54,16 @@ const CommandContextKey contextKey = "commandContext"
 // For internal backwards compatibility
 const commandContextKey = CommandContextKey
 
+// ExitError ends vibe with an exit code after the command has reported its
+// outcome, without printing an error
+type ExitError struct {
+	Code int
+}
+
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("exit status %d", e.Code)
+}
+
 // getCommandContext retrieves the CommandContext from a cobra command's context
 func getCommandContext(cmd *cobra.Command, currentCtx *CommandContext) *CommandContext {
 	if ctxVal := cmd.Context().Value(commandContextKey); ctxVal != nil {
@@ -47,29 +72,74 @@ func getCommandContext(cmd *cobra.Command, currentCtx *CommandContext) *CommandC
 	return currentCtx
 }
 
+// The GitLab client serves the PR and issue commands for GitLab remotes
+var _ github.Client = (*gitlab.HTTPClient)(nil)
+
 // CommandContext holds shared dependencies for all commands
 type CommandContext struct {
 	Config        *config.Config
-	ClickUpClient clickup.Client
-	GitHubClient  github.Client
+	ClickUpClient clickup.Client  // Nil unless tracker.provider is clickup
+	Tracker       tracker.Tracker // Ticket tracker selected by tracker.provider
+	GitHubClient  github.Client   // Pull requests and issues; backed by GitLab when GitLabClient is set
+	GitLabClient  gitlab.Client   // Nil unless the origin remote is hosted on GitLab
 	GitRepo       git.Repository
 	ClaudeClient  claude.Client
+	Output        output.Format // Selected with the global --output flag
 }
 
 // NewCommandContext creates a new command context
 func NewCommandContext(cfg *config.Config) (*CommandContext, error) {
-	// Initialize ClickUp client
-	clickUpClient := clickup.NewClient(cfg.ClickUp.APIToken)
-
-	// Initialize GitHub client with mode support
-	githubClient, err := github.NewClientWithMode(
-		cfg.GitHub.Mode,
-		cfg.GitHub.Token,
-		cfg.GitHub.Owner,
-		cfg.GitHub.Repo,
-	)
-	if err != nil {
+	// Initialize the ticket tracker
+	var clickUpClient clickup.Client
+	var ticketTracker tracker.Tracker
+	switch cfg.Tracker.Provider {
+	case config.TrackerJira:
+		jiraClient := jira.NewClient(cfg.Jira.BaseURL, cfg.Jira.Email, cfg.Jira.APIToken, cfg.Jira.Deployment).
+			WithRetryPolicy(retryPolicy(cfg.HTTP.Jira)).
+			WithCache(responseCache(cfg, cacheNamespaceJira, cfg.Cache.Jira))
+		ticketTracker = tracker.NewJira(jiraClient, cfg.Jira.ProjectKey, cfg.Jira.IssueType)
+	case config.TrackerLinear:
+		// Linear's GraphQL API is POST-only, so its responses aren't cached
+		linearClient := linear.NewClient(cfg.Linear.APIKey).
+			WithRetryPolicy(retryPolicy(cfg.HTTP.Linear))
+		ticketTracker = tracker.NewLinear(linearClient, cfg.Linear.TeamKey)
+	default:
+		clickUpClient = clickup.NewClient(cfg.ClickUp.APIToken).
+			WithRetryPolicy(retryPolicy(cfg.HTTP.ClickUp)).
+			WithCache(responseCache(cfg, cacheNamespaceClickUp, cfg.Cache.ClickUp))
+		ticketTracker = tracker.NewClickUp(clickUpClient, cfg.ClickUp.TeamID, cfg.ClickUp.UserID)
+	}
+
+	// Use GitLab when the origin remote is hosted there
+	var githubClient github.Client
+	var gitlabClient gitlab.Client
+	if glClient, err := newGitLabClient(cfg); err != nil {
 		return nil, err
+	} else if glClient != nil {
+		githubClient = glClient
+		gitlabClient = glClient
+	} else {
+		// Initialize GitHub client with mode support
+		githubClient, err = github.NewClientWithMode(
+			cfg.GitHub.Mode,
+			cfg.GitHub.Token,
+			cfg.GitHub.Owner,
+			cfg.GitHub.Repo,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if httpClient, ok := githubClient.(*github.HTTPClient); ok {
+			httpClient.WithRetryPolicy(retryPolicy(cfg.HTTP.GitHub)).
+				WithCache(responseCache(cfg, cacheNamespaceGitHub, cfg.Cache.GitHub))
+		}
+	}
+
+	// Persist sprint lookups across invocations
+	if cfg.Cache.Enabled && !cfg.Cache.Refresh {
+		if cacheDir, err := config.GetCacheDir(); err == nil {
+			utils.GetSprintCache().Persist(utils.NewDiskCache(cacheDir), cacheNamespaceSprint)
+		}
 	}
 
 	// Initialize Git repository
@@ -82,17 +152,141 @@ func NewCommandContext(cfg *config.Config) (*CommandContext, error) {
 	var claudeClient claude.Client
 	if cfg.AI.Enabled {
 		claudeClient = claude.NewClientAuto(cfg.Claude.APIKey)
+		if httpClient, ok := claudeClient.(*claude.HTTPClient); ok {
+			httpClient.WithRetryPolicy(retryPolicy(cfg.HTTP.Claude))
+		}
 	}
 
 	return &CommandContext{
 		Config:        cfg,
 		ClickUpClient: clickUpClient,
+		Tracker:       ticketTracker,
 		GitHubClient:  githubClient,
+		GitLabClient:  gitlabClient,
 		GitRepo:       gitRepo,
 		ClaudeClient:  claudeClient,
 	}, nil
 }
 
+// newGitLabClient creates a GitLab client for the project of the origin
+// remote. Returns nil when origin isn't hosted on GitLab.
+func newGitLabClient(cfg *config.Config) (*gitlab.HTTPClient, error) {
+	remote, err := utils.GetOriginRemote()
+	if err != nil || !gitlab.IsGitLabHost(remote.Host, cfg.GitLab.Hosts) {
+		return nil, nil
+	}
+
+	token := cfg.GitLab.Token
+	if token == "" {
+		token = os.Getenv("GITLAB_TOKEN")
+	}
+	if token == "" {
+		return nil, fmt.Errorf("GitLab token not found for %s.\nSet one of the following:\n  - Add gitlab.token to your vibe config\n  - Set VIBE_GITLAB_TOKEN or GITLAB_TOKEN environment variable", remote.Host)
+	}
+
+	project := cfg.GitLab.Project
+	if project == "" {
+		project = remote.Path()
+	}
+
+	return gitlab.NewClient(remote.Host, token, project).
+		WithRetryPolicy(retryPolicy(cfg.HTTP.GitLab)).
+		WithCache(responseCache(cfg, cacheNamespaceGitLab, cfg.Cache.GitLab)), nil
+}
+
+// requireClickUp returns an error when a ClickUp-only feature is used with
+// another tracker
+func requireClickUp(ctx *CommandContext, feature string) error {
+	if ctx.ClickUpClient == nil {
+		return fmt.Errorf("%s requires ClickUp (tracker.provider is %s)", feature, ctx.Config.Tracker.Provider)
+	}
+	return nil
+}
+
+// ticketBranchName generates a branch name for a ticket in the configured
+// git.branch_style
+func ticketBranchName(ctx *CommandContext, prefix, ticketID, title string, username ...string) string {
+	return utils.GenerateBranchNameWithStyle(ctx.Config.Git.BranchStyle, prefix, ticketID, title, username...)
+}
+
+// branchTicketID extracts the ticket ID from a branch name. Lower-case
+// Linear-style keys are only recognized with Linear or git.branch_style linear,
+// and only for linear.team_key or jira.project_key when it's set.
+func branchTicketID(ctx *CommandContext, branch string) (string, error) {
+	if ctx.Config.Tracker.Provider == config.TrackerLinear || ctx.Config.Git.BranchStyle == utils.BranchStyleLinear {
+		return utils.ExtractLinearTicketID(branch, issueKeyPrefix(ctx))
+	}
+	return utils.ExtractTicketID(branch)
+}
+
+// issueKeyPrefix returns the project or team key of the configured tracker's
+// issue keys, or "" when there's none
+func issueKeyPrefix(ctx *CommandContext) string {
+	switch ctx.Config.Tracker.Provider {
+	case config.TrackerLinear:
+		return ctx.Config.Linear.TeamKey
+	case config.TrackerJira:
+		return ctx.Config.Jira.ProjectKey
+	}
+	return ""
+}
+
+// isTicketID checks if s looks like a ticket ID of the configured tracker: an
+// issue key like ABC-123 for Jira and Linear, and a ClickUp ID otherwise
+func isTicketID(ctx *CommandContext, s string) bool {
+	switch ctx.Config.Tracker.Provider {
+	case config.TrackerJira, config.TrackerLinear:
+		return utils.IsIssueKey(s)
+	}
+	return utils.IsClickUpID(s)
+}
+
+// ticketIDFormat describes the ticket IDs of the configured tracker, for errors
+func ticketIDFormat(ctx *CommandContext) string {
+	switch ctx.Config.Tracker.Provider {
+	case config.TrackerJira:
+		return "a Jira issue key like ABC-123"
+	case config.TrackerLinear:
+		return "a Linear issue key like ENG-123"
+	}
+	return "9 alphanumeric characters like abc123xyz"
+}
+
+// writeOutput renders a result in the structured format selected with --output
+func writeOutput(ctx *CommandContext, kind string, data any) error {
+	return output.Write(os.Stdout, ctx.Output, kind, data)
+}
+
+// retryPolicy converts a configured retry budget into an HTTP retry policy
+func retryPolicy(cfg config.RetryConfig) utils.RetryPolicy {
+	policy := utils.DefaultRetryPolicy()
+	policy.MaxRetries = cfg.MaxRetries
+	if cfg.MaxWait > 0 {
+		policy.MaxWait = cfg.MaxWait
+	}
+	return policy
+}
+
+// responseCache returns the disk cache settings for a service, or nil when
+// caching is disabled
+func responseCache(cfg *config.Config, namespace string, svc config.ServiceCacheConfig) *utils.ResponseCache {
+	if !cfg.Cache.Enabled {
+		return nil
+	}
+
+	cacheDir, err := config.GetCacheDir()
+	if err != nil {
+		return nil
+	}
+
+	return &utils.ResponseCache{
+		Store:     utils.NewDiskCache(cacheDir),
+		Namespace: namespace,
+		TTL:       svc.TTL,
+		Refresh:   cfg.Cache.Refresh,
+	}
+}
+
 // handleUncommittedChanges checks for uncommitted changes and prompts user to stash if needed
 // Returns nil if it's safe to proceed with checkout, error otherwise
 func handleUncommittedChanges(ctx *CommandContext) error {
@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rithyhuot/vibe/internal/config"
+)
+
+func TestIsTicketID(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider string
+		input    string
+		expected bool
+	}{
+		{name: "ClickUp ID", provider: config.TrackerClickUp, input: "abc123xyz", expected: true},
+		{name: "issue key with ClickUp", provider: config.TrackerClickUp, input: "ABC-123", expected: false},
+		{name: "Jira key", provider: config.TrackerJira, input: "ABC-123", expected: true},
+		{name: "ClickUp ID with Jira", provider: config.TrackerJira, input: "abc123xyz", expected: false},
+		{name: "Linear key", provider: config.TrackerLinear, input: "ENG-42", expected: true},
+		{name: "release branch", provider: config.TrackerJira, input: "release-2024", expected: false},
+		{name: "runtime version", provider: config.TrackerLinear, input: "node-18", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &CommandContext{Config: &config.Config{Tracker: config.TrackerConfig{Provider: tt.provider}}}
+			assert.Equal(t, tt.expected, isTicketID(ctx, tt.input))
+		})
+	}
+}
+
+func TestBranchTicketID(t *testing.T) {
+	linear := func(teamKey string) *CommandContext {
+		return &CommandContext{Config: &config.Config{
+			Tracker: config.TrackerConfig{Provider: config.TrackerLinear},
+			Linear:  config.LinearConfig{TeamKey: teamKey},
+		}}
+	}
+
+	tests := []struct {
+		name     string
+		ctx      *CommandContext
+		branch   string
+		expected string
+	}{
+		{name: "Linear-style key of the team", ctx: linear("ENG"), branch: "john/eng-482-fix-login", expected: "ENG-482"},
+		{name: "release branch with a team key", ctx: linear("ENG"), branch: "release-2024", expected: ""},
+		{name: "runtime version with a team key", ctx: linear("ENG"), branch: "node-18", expected: ""},
+		{name: "Linear-style key without a team key", ctx: linear(""), branch: "john/ops-12-rotate-keys", expected: "OPS-12"},
+		{name: "ClickUp ignores Linear-style keys", ctx: &CommandContext{Config: &config.Config{}}, branch: "node-18", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ticketID, err := branchTicketID(tt.ctx, tt.branch)
+			if tt.expected == "" {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, ticketID)
+		})
+	}
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/rithyhuot/vibe/internal/ui"
-	"github.com/rithyhuot/vibe/internal/utils"
 )
 
 // NewCommentCommand creates the comment command
@@ -81,7 +80,7 @@ func runComment(ctx *CommandContext, commentText string) error {
 	}
 
 	// Extract ticket ID from branch
-	ticketID, err := utils.ExtractTicketID(currentBranch)
+	ticketID, err := branchTicketID(ctx, currentBranch)
 	if err != nil {
 		return fmt.Errorf("could not extract ticket ID from branch '%s': %w", currentBranch, err)
 	}
@@ -93,7 +92,7 @@ func runComment(ctx *CommandContext, commentText string) error {
 	s.Start()
 
 	// Add comment
-	_, err = ctx.ClickUpClient.AddComment(cmdCtx, ticketID, commentText)
+	_, err = ctx.Tracker.AddComment(cmdCtx, ticketID, commentText)
 	s.Stop()
 
 	if err != nil {
@@ -2,6 +2,8 @@ package commands
 
 import (
 	"fmt"
+	"net/http"
+	"os"
 	"os/exec"
 	"strconv"
 	"strings"
@@ -29,8 +31,15 @@ func withRepoFallback[T any](
 		return result, nil
 	}
 
-	// Check if it's a "Could not resolve to a Repository" error
-	if !strings.Contains(err.Error(), "Could not resolve to a Repository") {
+	// GitLab projects always come from the git remote, so there's nothing to fall back to
+	if ctx.GitLabClient != nil {
+		var zero T
+		return zero, err
+	}
+
+	// Only retry when the repository or pull request could not be found, since a
+	// PR missing from the configured repo may live in the checked out repo
+	if !isRepoNotFoundError(err) && !isPRNotFoundError(err) {
 		var zero T
 		return zero, err
 	}
@@ -43,11 +52,21 @@ func withRepoFallback[T any](
 		return zero, fmt.Errorf("%w (also failed to detect repo from git remote: %v)", err, repoErr)
 	}
 
+	// Nothing to fall back to if the git remote is the configured repo
+	if strings.EqualFold(owner, ctx.Config.GitHub.Owner) && strings.EqualFold(repo, ctx.Config.GitHub.Repo) {
+		var zero T
+		return zero, err
+	}
+
 	// Inform user we're trying the git remote repo
 	dim := color.New(color.Faint)
-	s.Stop()
-	_, _ = dim.Printf("Repository not found in config, trying detected repo: %s/%s\n", owner, repo)
-	s.Start()
+	if s != nil {
+		s.Stop()
+	}
+	_, _ = dim.Fprintf(os.Stderr, "Repository not found in config, trying detected repo: %s/%s\n", owner, repo)
+	if s != nil {
+		s.Start()
+	}
 
 	// Create a new client with the detected repo
 	newClient, err := github.NewClientWithMode(
@@ -60,6 +79,10 @@ func withRepoFallback[T any](
 		var zero T
 		return zero, fmt.Errorf("failed to create client with detected repo: %w", err)
 	}
+	if httpClient, ok := newClient.(*github.HTTPClient); ok {
+		httpClient.WithRetryPolicy(retryPolicy(ctx.Config.HTTP.GitHub)).
+			WithCache(responseCache(ctx.Config, cacheNamespaceGitHub, ctx.Config.Cache.GitHub))
+	}
 
 	// Try again with the new client
 	result, err = operation(newClient)
@@ -71,6 +94,26 @@ func withRepoFallback[T any](
 	return result, nil
 }
 
+// isRepoNotFoundError reports whether err indicates the configured repository
+// does not exist or is not accessible. gh reports this as a GraphQL resolution
+// error and the REST API as a 404.
+func isRepoNotFoundError(err error) bool {
+	if strings.Contains(err.Error(), "Could not resolve to a Repository") {
+		return true
+	}
+	httpErr := utils.GetHTTPError(err)
+	return httpErr != nil && httpErr.StatusCode == http.StatusNotFound
+}
+
+// isPRNotFoundError reports whether err indicates the pull request does not exist
+func isPRNotFoundError(err error) bool {
+	if strings.Contains(err.Error(), "Could not resolve to a PullRequest") {
+		return true
+	}
+	httpErr := utils.GetHTTPError(err)
+	return httpErr != nil && httpErr.StatusCode == http.StatusNotFound
+}
+
 // getRepoFromGitRemote extracts owner and repo from git remote URL
 func getRepoFromGitRemote() (owner, repo string, err error) {
 	cmd := exec.Command("git", "remote", "get-url", "origin")
@@ -101,3 +144,17 @@ func parseAndValidateIssueNumber(issueNumberStr string) (int, error) {
 
 	return issueNumber, nil
 }
+
+// parseAndValidatePRNumber parses and validates a pull request number string
+func parseAndValidatePRNumber(prNumberStr string) (int, error) {
+	prNumber, err := strconv.Atoi(strings.TrimPrefix(prNumberStr, "#"))
+	if err != nil {
+		return 0, fmt.Errorf("invalid PR number '%s': must be a positive integer", prNumberStr)
+	}
+
+	if prNumber <= 0 {
+		return 0, fmt.Errorf("invalid PR number %d: must be positive", prNumber)
+	}
+
+	return prNumber, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/rithyhuot/vibe/internal/models"
+	"github.com/rithyhuot/vibe/internal/output"
 	"github.com/rithyhuot/vibe/internal/services/github"
 	"github.com/rithyhuot/vibe/internal/utils"
 )
@@ -39,7 +40,8 @@ Examples:
   vibe issue 123                 # View issue #123
   vibe issue                     # View issue from current branch name
   vibe issue 456 --comments      # View issue #456 with comments
-  vibe issue -c                  # View current issue with comments`,
+  vibe issue -c                  # View current issue with comments
+  vibe issue 123 -c -o json      # Output issue with comments as JSON`,
 		RunE: func(cobraCmd *cobra.Command, args []string) error {
 			// Get context from the command's context value (set by PreRunE)
 			ctx = getCommandContext(cobraCmd, ctx)
@@ -65,7 +67,7 @@ func runIssue(ctx *CommandContext, issueNumberArg string, opts *IssueCommandOpti
 
 	// Ask if user wants to include comments (if not explicitly set via flag)
 	includeComments := opts.Comments
-	if !opts.Comments {
+	if !opts.Comments && !ctx.Output.IsStructured() {
 		var wantComments bool
 		commentPrompt := &survey.Confirm{
 			Message: "Include comments?",
@@ -90,6 +92,10 @@ func runIssue(ctx *CommandContext, issueNumberArg string, opts *IssueCommandOpti
 
 	s.Stop()
 
+	if ctx.Output.IsStructured() {
+		return writeOutput(ctx, output.KindIssue, output.NewIssue(issue))
+	}
+
 	// Display issue
 	displayIssue(issue)
 
@@ -12,6 +12,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/rithyhuot/vibe/internal/models"
+	"github.com/rithyhuot/vibe/internal/output"
 	"github.com/rithyhuot/vibe/internal/services/github"
 )
 
@@ -43,7 +44,8 @@ Examples:
   vibe issues                    # List open issues
   vibe issues --state closed     # List closed issues
   vibe issues --state all        # List all issues
-  vibe issues --select           # List issues and select one to view details`,
+  vibe issues --select           # List issues and select one to view details
+  vibe issues -o json            # Output issues as JSON`,
 		RunE: func(cobraCmd *cobra.Command, _ []string) error {
 			// Get context from the command's context value (set by PreRunE)
 			ctx = getCommandContext(cobraCmd, ctx)
@@ -70,6 +72,10 @@ func runIssues(ctx *CommandContext, opts *IssuesCommandOptions) error {
 		return fmt.Errorf("limit must be positive, got %d", opts.Limit)
 	}
 
+	if opts.Select && ctx.Output.IsStructured() {
+		return fmt.Errorf("--select cannot be used with --output %s", ctx.Output)
+	}
+
 	// Fetch issues with fallback to git remote repo
 	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
 	s.Suffix = " Fetching issues..."
@@ -88,6 +94,10 @@ func runIssues(ctx *CommandContext, opts *IssuesCommandOptions) error {
 		issues = issues[:opts.Limit]
 	}
 
+	if ctx.Output.IsStructured() {
+		return writeOutput(ctx, output.KindIssueList, output.NewIssues(issues))
+	}
+
 	if len(issues) == 0 {
 		yellow := color.New(color.FgYellow)
 		_, _ = yellow.Printf("No %s issues found.\n", opts.State)
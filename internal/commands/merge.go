@@ -3,100 +3,136 @@ package commands
 import (
 	"context"
 	"fmt"
-	"strconv"
 	"time"
 
 	survey "github.com/AlecAivazis/survey/v2"
 	"github.com/briandowns/spinner"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
+
+	"github.com/rithyhuot/vibe/internal/config"
+	"github.com/rithyhuot/vibe/internal/services/github"
 )
 
+// MergeOptions holds flags for the merge command
+type MergeOptions struct {
+	Method string
+	Auto   bool
+}
+
 // NewMergeCommand creates the merge command
 func NewMergeCommand(ctx *CommandContext) *cobra.Command {
+	opts := &MergeOptions{}
+
 	cmd := &cobra.Command{
 		Use:   "merge [pr-number]",
-		Short: "Post /merge comment to trigger merge automation",
-		Long: `Posts a /merge comment on the PR to trigger merge automation. Checks PR status first. If no PR number is provided, uses the current branch's PR.
+		Short: "Merge a PR, or enable auto-merge",
+		Long: `Merges a pull request after checking its status. If no PR number is provided, uses the current branch's PR.
+
+A PR is ready when it meets the merge requirements of its base branch: the
+required checks passed and it has the required approving reviews. Without
+branch protection, it needs passing checks and one approval.
+
+--auto enables auto-merge, so GitHub merges the PR once it's ready. On a
+branch with a merge queue, the PR is added to the queue instead, right away
+or once it's ready.
+
+By default, and for GitLab merge requests, a /merge comment (merge.comment)
+is posted for merge automation instead. Set merge.strategy to "api" to merge
+through the GitHub API; --method and --auto always do.
 
 Examples:
   vibe merge                     # Merge PR for current branch
   vibe merge 123                 # Merge PR #123
-  vibe merge --force             # Force merge (if implemented)`,
+  vibe merge --method squash     # Squash and merge
+  vibe merge --auto              # Merge once checks and reviews pass`,
 		Args: cobra.MaximumNArgs(1),
-		RunE: func(_ *cobra.Command, args []string) error {
+		RunE: func(cobraCmd *cobra.Command, args []string) error {
+			ctx = getCommandContext(cobraCmd, ctx)
 			prNumberArg := ""
 			if len(args) > 0 {
 				prNumberArg = args[0]
 			}
-			return runMerge(ctx, prNumberArg)
+			return runMerge(ctx, opts, prNumberArg)
 		},
 	}
 
+	cmd.Flags().StringVar(&opts.Method, "method", "", "Merge method: merge, squash, or rebase (default: merge.method)")
+	cmd.Flags().BoolVar(&opts.Auto, "auto", false, "Enable auto-merge, or join the merge queue")
+
 	return cmd
 }
 
-func runMerge(ctx *CommandContext, prNumberArg string) error {
-	// Check if gh CLI is available
-	if !isGHAvailable() {
-		return fmt.Errorf("GitHub CLI (gh) is not available. Run: gh auth login")
+func runMerge(ctx *CommandContext, opts *MergeOptions, prNumberArg string) error {
+	method := opts.Method
+	if method == "" {
+		method = ctx.Config.Merge.Method
 	}
+	if !github.ValidMergeMethod(method) {
+		return fmt.Errorf("invalid merge method: %s (must be 'merge', 'squash', or 'rebase')", method)
+	}
+	explicit := opts.Method != "" || opts.Auto
 
 	// Get PR number
-	prNumber, err := getPRNumber(prNumberArg)
+	prNumber, err := getPRNumber(ctx, prNumberArg)
 	if err != nil {
 		return err
 	}
 
 	// Get PR status first
-	prInfo, statusInfo, err := fetchPRDetails(prNumber)
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	s.Suffix = " Checking status..."
+	s.Start()
+
+	details, err := fetchPRDetails(ctx, prNumber, s)
+	s.Stop()
 	if err != nil {
 		return err
 	}
 
 	// Display PR info
-	displayMergePRInfo(prNumber, prInfo, statusInfo)
+	displayMergePRInfo(details.Info, details.Status)
 
 	// Determine if ready
-	isReady := isPRReadyToMerge(statusInfo)
+	isReady := isPRReadyToMerge(details.Status)
 
-	return handleMergeAction(ctx, prNumber, isReady)
-}
-
-func getPRNumber(prNumberArg string) (string, error) {
-	if prNumberArg != "" {
-		return prNumberArg, nil
+	// GitLab merge requests keep the comment strategy
+	mergeClient, ok := details.Client.(github.MergeClient)
+	if !ok && explicit {
+		return fmt.Errorf("--method and --auto are only supported for GitHub pull requests")
+	}
+	if !ok || (ctx.Config.Merge.Strategy == config.MergeStrategyComment && !explicit) {
+		return handleMergeAction(details.Client, prNumber, isReady, ctx.Config.Merge.Comment)
 	}
 
-	// Get PR for current branch
-	pr, err := getPRForCurrentBranch()
-	if err != nil || pr == nil {
-		return "", fmt.Errorf("no PR found for this branch")
+	// Branches with a merge queue only merge through the queue
+	mergeQueue := details.Status.Protection != nil && details.Status.Protection.MergeQueue
+	if opts.Auto || mergeQueue {
+		return handleAutoMerge(mergeClient, prNumber, method, mergeQueue)
 	}
-	return fmt.Sprintf("%d", pr.Number), nil
+	protected := details.Status.Protection != nil && details.Status.Protection.Protected
+	return handleAPIMerge(mergeClient, prNumber, method, isReady, protected)
 }
 
-func fetchPRDetails(prNumber string) (*PRInfo, *PRStatusInfo, error) {
-	// Use empty string for repo to query the current repository
-	prInfo, err := getPRInfo(prNumber, "")
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to fetch PR: %w", err)
+func getPRNumber(ctx *CommandContext, prNumberArg string) (int, error) {
+	if prNumberArg != "" {
+		return parseAndValidatePRNumber(prNumberArg)
 	}
 
-	statusInfo, err := getPRStatusInfo(prNumber, "")
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to fetch PR status: %w", err)
+	// Get PR for current branch
+	pr, err := getPRForCurrentBranch(ctx)
+	if err != nil || pr == nil {
+		return 0, fmt.Errorf("no PR found for this branch")
 	}
-
-	return prInfo, statusInfo, nil
+	return pr.Number, nil
 }
 
-func displayMergePRInfo(prNumber string, prInfo *PRInfo, statusInfo *PRStatusInfo) {
+func displayMergePRInfo(prInfo *PRInfo, statusInfo *PRStatusInfo) {
 	bold := color.New(color.Bold)
 	dim := color.New(color.Faint)
 
 	fmt.Println()
-	_, _ = bold.Printf("PR #%s: %s\n", prNumber, prInfo.Title)
+	_, _ = bold.Printf("PR #%d: %s\n", prInfo.Number, prInfo.Title)
 	_, _ = dim.Println(prInfo.URL)
 	fmt.Println()
 
@@ -104,24 +140,133 @@ func displayMergePRInfo(prNumber string, prInfo *PRInfo, statusInfo *PRStatusInf
 	fmt.Println()
 }
 
+// isPRReadyToMerge reports whether a PR meets the merge requirements of its
+// base branch. When they're unknown, it needs passing checks and an approval,
+// and when only the required approvals are unknown, at least one approval.
 func isPRReadyToMerge(statusInfo *PRStatusInfo) bool {
+	if len(statusInfo.ChangesRequested) > 0 {
+		return false
+	}
+
+	if protection := statusInfo.Protection; protection != nil {
+		return len(statusInfo.MissingChecks) == 0 &&
+			len(statusInfo.Approvals) >= protection.MinApprovals()
+	}
+
 	return statusInfo.CIPassed &&
 		statusInfo.CIPending == 0 &&
-		len(statusInfo.Approvals) > 0 &&
-		len(statusInfo.ChangesRequested) == 0
+		len(statusInfo.Approvals) > 0
+}
+
+// handleAPIMerge merges a PR right away, confirming first. On a protected
+// branch, GitHub refuses to merge a PR that isn't ready unless the user can
+// bypass the rules, and its reason is returned.
+func handleAPIMerge(client github.MergeClient, prNumber int, method string, isReady, protected bool) error {
+	yellow := color.New(color.FgYellow)
+
+	message := fmt.Sprintf("Merge PR #%d (%s)?", prNumber, method)
+	if !isReady {
+		_, _ = yellow.Println("PR is not ready to merge.")
+		if protected {
+			_, _ = yellow.Println("The base branch is protected, so GitHub only merges it if you can bypass its rules.")
+		}
+		fmt.Println()
+		message = yellow.Sprint("Merge anyway? (not recommended)")
+	}
+
+	var shouldMerge bool
+	prompt := &survey.Confirm{
+		Message: message,
+		Default: isReady,
+	}
+	if err := survey.AskOne(prompt, &shouldMerge); err != nil {
+		return err
+	}
+
+	if !shouldMerge {
+		return nil
+	}
+
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	s.Suffix = " Merging..."
+	s.Start()
+	err := client.MergePR(context.Background(), prNumber, method)
+	s.Stop()
+	if err != nil {
+		return err
+	}
+
+	green := color.New(color.FgGreen)
+	_, _ = green.Printf("✓ Merged PR #%d (%s)\n", prNumber, method)
+	return nil
+}
+
+// handleAutoMerge enables auto-merge, or adds a PR to the merge queue
+func handleAutoMerge(client github.MergeClient, prNumber int, method string, mergeQueue bool) error {
+	message := fmt.Sprintf("Enable auto-merge for PR #%d (%s)?", prNumber, method)
+	if mergeQueue {
+		message = fmt.Sprintf("Add PR #%d to the merge queue?", prNumber)
+	}
+
+	var confirmed bool
+	prompt := &survey.Confirm{
+		Message: message,
+		Default: true,
+	}
+	if err := survey.AskOne(prompt, &confirmed); err != nil {
+		return err
+	}
+
+	if !confirmed {
+		return nil
+	}
+
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	s.Suffix = " Enabling auto-merge..."
+	s.Start()
+	result, err := client.EnableAutoMerge(context.Background(), prNumber, method)
+	s.Stop()
+	if err != nil {
+		return err
+	}
+
+	displayAutoMergeResult(prNumber, method, result)
+	return nil
+}
+
+// displayAutoMergeResult reports what enabling auto-merge did with a PR
+func displayAutoMergeResult(prNumber int, method string, result *github.AutoMergeResult) {
+	green := color.New(color.FgGreen)
+	dim := color.New(color.Faint)
+
+	switch {
+	case result.Merged:
+		_, _ = green.Printf("✓ Merged PR #%d (%s)\n", prNumber, method)
+		_, _ = dim.Println("  It already met the merge requirements.")
+	case result.Queued && result.QueuePosition > 0:
+		_, _ = green.Printf("✓ Added PR #%d to the merge queue (position %d)\n", prNumber, result.QueuePosition)
+	case result.Queued:
+		_, _ = green.Printf("✓ PR #%d is in the merge queue\n", prNumber)
+	case result.MergeQueue:
+		_, _ = green.Printf("✓ Auto-merge enabled for PR #%d\n", prNumber)
+		_, _ = dim.Println("  It joins the merge queue once checks and reviews pass.")
+	default:
+		_, _ = green.Printf("✓ Auto-merge enabled for PR #%d (%s)\n", prNumber, method)
+		_, _ = dim.Println("  GitHub merges it once checks and reviews pass.")
+	}
 }
 
-func handleMergeAction(ctx *CommandContext, prNumber string, isReady bool) error {
+func handleMergeAction(client github.Client, prNumber int, isReady bool, comment string) error {
 	if isReady {
-		return handleReadyMerge(ctx, prNumber)
+		return handleReadyMerge(client, prNumber, comment)
 	}
-	return handleForcedMerge(ctx, prNumber)
+	return handleForcedMerge(client, prNumber, comment)
 }
 
-func handleReadyMerge(ctx *CommandContext, prNumber string) error {
+func handleReadyMerge(client github.Client, prNumber int, comment string) error {
 	var shouldMerge bool
 	prompt := &survey.Confirm{
-		Message: "Post /merge comment?",
+		Message: fmt.Sprintf("Post %s comment?", comment),
 		Default: true,
 	}
 	if err := survey.AskOne(prompt, &shouldMerge); err != nil {
@@ -132,18 +277,18 @@ func handleReadyMerge(ctx *CommandContext, prNumber string) error {
 		return nil
 	}
 
-	if err := postMergeComment(ctx, prNumber); err != nil {
+	if err := postMergeComment(client, prNumber, comment); err != nil {
 		return err
 	}
 
 	green := color.New(color.FgGreen)
 	dim := color.New(color.Faint)
-	_, _ = green.Println("✓ Posted /merge comment")
+	_, _ = green.Printf("✓ Posted %s comment\n", comment)
 	_, _ = dim.Println("  Merge automation will process shortly.")
 	return nil
 }
 
-func handleForcedMerge(ctx *CommandContext, prNumber string) error {
+func handleForcedMerge(client github.Client, prNumber int, comment string) error {
 	yellow := color.New(color.FgYellow)
 	dim := color.New(color.Faint)
 
@@ -152,7 +297,7 @@ func handleForcedMerge(ctx *CommandContext, prNumber string) error {
 
 	var forceAnyway bool
 	prompt := &survey.Confirm{
-		Message: yellow.Sprint("Post /merge anyway? (not recommended)"),
+		Message: yellow.Sprintf("Post %s anyway? (not recommended)", comment),
 		Default: false,
 	}
 	if err := survey.AskOne(prompt, &forceAnyway); err != nil {
@@ -163,24 +308,23 @@ func handleForcedMerge(ctx *CommandContext, prNumber string) error {
 		return nil
 	}
 
-	if err := postMergeComment(ctx, prNumber); err != nil {
+	if err := postMergeComment(client, prNumber, comment); err != nil {
 		return err
 	}
 
-	_, _ = yellow.Println("⚠ Posted /merge comment (forced)")
+	_, _ = yellow.Printf("⚠ Posted %s comment (forced)\n", comment)
 	_, _ = dim.Println("  The merge may fail if requirements are not met.")
 	return nil
 }
 
-func postMergeComment(ctx *CommandContext, prNumber string) error {
+func postMergeComment(client github.Client, prNumber int, comment string) error {
 	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
-	s.Suffix = " Posting /merge comment..."
+	s.Suffix = fmt.Sprintf(" Posting %s comment...", comment)
 	s.Start()
 	defer s.Stop()
 
-	prNum, _ := strconv.Atoi(prNumber)
 	cmdCtx := context.Background()
-	err := ctx.GitHubClient.AddComment(cmdCtx, prNum, "/merge")
+	err := client.AddComment(cmdCtx, prNumber, comment)
 	if err != nil {
 		return fmt.Errorf("failed to post comment: %w", err)
 	}
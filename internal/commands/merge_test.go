@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rithyhuot/vibe/internal/services/github"
+)
+
+func TestIsPRReadyToMerge(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   *PRStatusInfo
+		expected bool
+	}{
+		{
+			name:     "unknown protection needs passing checks and an approval",
+			status:   &PRStatusInfo{CIPassed: true, Approvals: []string{"alice"}},
+			expected: true,
+		},
+		{
+			name:     "unknown protection without approvals",
+			status:   &PRStatusInfo{CIPassed: true},
+			expected: false,
+		},
+		{
+			name: "known requirements met",
+			status: &PRStatusInfo{
+				Protection: &github.BranchProtection{Protected: true, RequiredApprovals: 2, ReviewsKnown: true},
+				Approvals:  []string{"alice", "bob"},
+			},
+			expected: true,
+		},
+		{
+			name: "no approvals required",
+			status: &PRStatusInfo{
+				Protection: &github.BranchProtection{ReviewsKnown: true},
+			},
+			expected: true,
+		},
+		{
+			name: "unreadable review settings need an approval",
+			status: &PRStatusInfo{
+				Protection: &github.BranchProtection{Protected: true},
+			},
+			expected: false,
+		},
+		{
+			name: "unreadable review settings with an approval",
+			status: &PRStatusInfo{
+				Protection: &github.BranchProtection{Protected: true},
+				Approvals:  []string{"alice"},
+			},
+			expected: true,
+		},
+		{
+			name: "missing required checks",
+			status: &PRStatusInfo{
+				Protection:    &github.BranchProtection{Protected: true, ReviewsKnown: true},
+				MissingChecks: []string{"test"},
+			},
+			expected: false,
+		},
+		{
+			name: "changes requested",
+			status: &PRStatusInfo{
+				Protection:       &github.BranchProtection{ReviewsKnown: true},
+				Approvals:        []string{"alice"},
+				ChangesRequested: []string{"bob"},
+			},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isPRReadyToMerge(tt.status))
+		})
+	}
+}
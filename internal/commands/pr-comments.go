@@ -0,0 +1,399 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/rithyhuot/vibe/internal/models"
+	"github.com/rithyhuot/vibe/internal/output"
+	"github.com/rithyhuot/vibe/internal/services/github"
+)
+
+// diffHunkContext is how many lines of a thread's diff hunk are shown, ending
+// at the commented line
+const diffHunkContext = 4
+
+// PRCommentsOptions holds flags for the pr comments command
+type PRCommentsOptions struct {
+	Unresolved bool
+}
+
+// PRThreadOptions holds flags for the pr reply and pr resolve commands
+type PRThreadOptions struct {
+	PR      string
+	Resolve bool
+}
+
+// newPRCommentsCommand creates the pr comments command
+func newPRCommentsCommand(ctx *CommandContext) *cobra.Command {
+	opts := &PRCommentsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "comments [pr-number]",
+		Short: "Show the review threads of a PR",
+		Long: `Shows the review threads of a pull request, grouped by file, with the commented lines, the diff hunk, and each reply. If no PR number is provided, uses the current branch's PR.
+
+Threads are numbered; pass the number to 'vibe pr reply' and 'vibe pr resolve'.
+
+Examples:
+  vibe pr comments                 # Review threads of the current branch's PR
+  vibe pr comments 123             # Review threads of PR #123
+  vibe pr comments --unresolved    # Only threads that still need work
+  vibe pr comments -o json         # Output as JSON`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cobraCmd *cobra.Command, args []string) error {
+			ctx = getCommandContext(cobraCmd, ctx)
+			prNumber := ""
+			if len(args) > 0 {
+				prNumber = args[0]
+			}
+			return runPRComments(ctx, opts, prNumber)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Unresolved, "unresolved", false, "Only show unresolved threads")
+
+	return cmd
+}
+
+// newPRReplyCommand creates the pr reply command
+func newPRReplyCommand(ctx *CommandContext) *cobra.Command {
+	opts := &PRThreadOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "reply <thread> <text>",
+		Short: "Reply to a review thread",
+		Long: `Replies to a review thread, given as its number from 'vibe pr comments' or its ID. Thread numbers refer to the current branch's PR unless --pr is set.
+
+Examples:
+  vibe pr reply 3 "Fixed in the latest commit"
+  vibe pr reply 3 "Done" --resolve          # Reply and resolve the thread
+  vibe pr reply 2 "Good catch" --pr 123     # Thread #2 of PR #123`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cobraCmd *cobra.Command, args []string) error {
+			ctx = getCommandContext(cobraCmd, ctx)
+			return runPRReply(ctx, opts, args[0], args[1])
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.PR, "pr", "", "PR whose thread numbers to use (default: current branch's PR)")
+	cmd.Flags().BoolVar(&opts.Resolve, "resolve", false, "Resolve the thread after replying")
+
+	return cmd
+}
+
+// newPRResolveCommand creates the pr resolve command
+func newPRResolveCommand(ctx *CommandContext) *cobra.Command {
+	opts := &PRThreadOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "resolve <thread>...",
+		Short: "Resolve review threads",
+		Long: `Resolves review threads, given as their numbers from 'vibe pr comments' or their IDs. Thread numbers refer to the current branch's PR unless --pr is set.
+
+Examples:
+  vibe pr resolve 3
+  vibe pr resolve 1 4 5
+  vibe pr resolve 2 --pr 123`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cobraCmd *cobra.Command, args []string) error {
+			ctx = getCommandContext(cobraCmd, ctx)
+			return runPRResolve(ctx, opts, args)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.PR, "pr", "", "PR whose thread numbers to use (default: current branch's PR)")
+
+	return cmd
+}
+
+func runPRComments(ctx *CommandContext, opts *PRCommentsOptions, prNumberArg string) error {
+	prNumber, err := resolvePRNumber(ctx, prNumberArg)
+	if err != nil {
+		return err
+	}
+
+	threads, err := fetchReviewThreads(ctx, prNumber)
+	if err != nil {
+		return err
+	}
+
+	if opts.Unresolved {
+		var unresolved []*models.ReviewThread
+		for _, thread := range threads {
+			if !thread.IsResolved {
+				unresolved = append(unresolved, thread)
+			}
+		}
+		threads = unresolved
+	}
+
+	if ctx.Output.IsStructured() {
+		return writeOutput(ctx, output.KindReviewThreads, output.NewReviewThreadList(prNumber, threads))
+	}
+
+	displayReviewThreads(prNumber, threads, opts.Unresolved)
+	return nil
+}
+
+func runPRReply(ctx *CommandContext, opts *PRThreadOptions, threadArg, text string) error {
+	client, err := reviewThreadClient(ctx)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(text) == "" {
+		return fmt.Errorf("reply text cannot be empty")
+	}
+
+	threads, err := findReviewThreads(ctx, opts.PR, []string{threadArg})
+	if err != nil {
+		return err
+	}
+	thread := threads[0]
+
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	s.Suffix = " Replying..."
+	s.Start()
+
+	cmdCtx := context.Background()
+	comment, err := client.ReplyToReviewThread(cmdCtx, thread.ID, text)
+	if err == nil && opts.Resolve {
+		err = client.ResolveReviewThread(cmdCtx, thread.ID)
+	}
+	s.Stop()
+	if err != nil {
+		return err
+	}
+
+	green := color.New(color.FgGreen)
+	dim := color.New(color.Faint)
+	_, _ = green.Printf("✓ Replied to %s\n", describeReviewThread(thread))
+	if opts.Resolve {
+		_, _ = green.Printf("✓ Resolved %s\n", describeReviewThread(thread))
+	}
+	if comment.URL != "" {
+		_, _ = dim.Printf("  %s\n", comment.URL)
+	}
+	return nil
+}
+
+func runPRResolve(ctx *CommandContext, opts *PRThreadOptions, threadArgs []string) error {
+	client, err := reviewThreadClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	threads, err := findReviewThreads(ctx, opts.PR, threadArgs)
+	if err != nil {
+		return err
+	}
+
+	green := color.New(color.FgGreen)
+	dim := color.New(color.Faint)
+	cmdCtx := context.Background()
+	for _, thread := range threads {
+		if thread.IsResolved {
+			_, _ = dim.Printf("%s is already resolved\n", describeReviewThread(thread))
+			continue
+		}
+		if err := client.ResolveReviewThread(cmdCtx, thread.ID); err != nil {
+			return err
+		}
+		_, _ = green.Printf("✓ Resolved %s\n", describeReviewThread(thread))
+	}
+	return nil
+}
+
+// reviewThreadClient returns the configured client when it supports review threads
+func reviewThreadClient(ctx *CommandContext) (github.ReviewThreadClient, error) {
+	return asReviewThreadClient(ctx.GitHubClient)
+}
+
+// asReviewThreadClient returns client when it supports review threads
+func asReviewThreadClient(client github.Client) (github.ReviewThreadClient, error) {
+	threadClient, ok := client.(github.ReviewThreadClient)
+	if !ok {
+		return nil, fmt.Errorf("review threads are only supported for GitHub pull requests")
+	}
+	return threadClient, nil
+}
+
+// fetchReviewThreads retrieves the review threads of a PR, falling back to
+// the repository of the git remote
+func fetchReviewThreads(ctx *CommandContext, prNumber int) ([]*models.ReviewThread, error) {
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	s.Suffix = " Fetching review threads..."
+	s.Start()
+	defer s.Stop()
+
+	cmdCtx := context.Background()
+	return withRepoFallback(ctx, s, func(client github.Client) ([]*models.ReviewThread, error) {
+		threadClient, err := asReviewThreadClient(client)
+		if err != nil {
+			return nil, err
+		}
+		return threadClient.GetReviewThreads(cmdCtx, prNumber)
+	})
+}
+
+// findReviewThreads looks up threads given as numbers from pr comments or as
+// node IDs. Node IDs are global, so they don't need the PR.
+func findReviewThreads(ctx *CommandContext, prNumberArg string, threadArgs []string) ([]*models.ReviewThread, error) {
+	var prThreads []*models.ReviewThread
+	var prNumber int
+
+	threads := make([]*models.ReviewThread, len(threadArgs))
+	for i, arg := range threadArgs {
+		number, err := strconv.Atoi(strings.TrimPrefix(arg, "#"))
+		if err != nil {
+			threads[i] = &models.ReviewThread{ID: arg}
+			continue
+		}
+
+		if prThreads == nil {
+			if prNumber, err = resolvePRNumber(ctx, prNumberArg); err != nil {
+				return nil, err
+			}
+			if prThreads, err = fetchReviewThreads(ctx, prNumber); err != nil {
+				return nil, err
+			}
+		}
+
+		if number < 1 || number > len(prThreads) {
+			return nil, fmt.Errorf("PR #%d has no review thread #%d (see 'vibe pr comments %d')", prNumber, number, prNumber)
+		}
+		threads[i] = prThreads[number-1]
+	}
+
+	return threads, nil
+}
+
+// describeReviewThread names a thread by its number and location when known
+func describeReviewThread(thread *models.ReviewThread) string {
+	if thread.Number == 0 {
+		return "review thread " + thread.ID
+	}
+	return fmt.Sprintf("thread #%d (%s)", thread.Number, threadLocation(thread))
+}
+
+// threadLocation returns the file and lines a thread comments on
+func threadLocation(thread *models.ReviewThread) string {
+	switch {
+	case thread.Line == 0:
+		return thread.Path
+	case thread.StartLine > 0 && thread.StartLine != thread.Line:
+		return fmt.Sprintf("%s:%d-%d", thread.Path, thread.StartLine, thread.Line)
+	default:
+		return fmt.Sprintf("%s:%d", thread.Path, thread.Line)
+	}
+}
+
+// threadLines returns the lines a thread comments on, or "file" for comments on a whole file
+func threadLines(thread *models.ReviewThread) string {
+	switch {
+	case thread.Line == 0:
+		return "file"
+	case thread.StartLine > 0 && thread.StartLine != thread.Line:
+		return fmt.Sprintf("lines %d-%d", thread.StartLine, thread.Line)
+	default:
+		return fmt.Sprintf("line %d", thread.Line)
+	}
+}
+
+// displayReviewThreads shows review threads grouped by file, in line order
+func displayReviewThreads(prNumber int, threads []*models.ReviewThread, unresolvedOnly bool) {
+	bold := color.New(color.Bold)
+	dim := color.New(color.Faint)
+	green := color.New(color.FgGreen)
+
+	fmt.Println()
+	if len(threads) == 0 {
+		if unresolvedOnly {
+			_, _ = green.Printf("✓ No unresolved review threads on PR #%d\n", prNumber)
+		} else {
+			_, _ = dim.Printf("No review threads on PR #%d\n", prNumber)
+		}
+		return
+	}
+
+	unresolved := 0
+	for _, thread := range threads {
+		if !thread.IsResolved {
+			unresolved++
+		}
+	}
+	_, _ = bold.Printf("PR #%d review threads: ", prNumber)
+	fmt.Printf("%d unresolved, %d resolved\n", unresolved, len(threads)-unresolved)
+
+	sorted := make([]*models.ReviewThread, len(threads))
+	copy(sorted, threads)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Path != sorted[j].Path {
+			return sorted[i].Path < sorted[j].Path
+		}
+		return sorted[i].Line < sorted[j].Line
+	})
+
+	for i, thread := range sorted {
+		if i == 0 || thread.Path != sorted[i-1].Path {
+			fmt.Println()
+			_, _ = bold.Println(thread.Path)
+		}
+		displayReviewThread(thread)
+	}
+	fmt.Println()
+}
+
+// displayReviewThread shows a thread's state, diff hunk, and comments
+func displayReviewThread(thread *models.ReviewThread) {
+	cyan := color.New(color.FgCyan)
+	dim := color.New(color.Faint)
+	green := color.New(color.FgGreen)
+	yellow := color.New(color.FgYellow)
+
+	fmt.Println()
+	_, _ = cyan.Printf("  #%d ", thread.Number)
+	fmt.Print(threadLines(thread), " ")
+	switch {
+	case thread.IsResolved && thread.ResolvedBy != "":
+		_, _ = green.Printf("✓ resolved by @%s", thread.ResolvedBy)
+	case thread.IsResolved:
+		_, _ = green.Print("✓ resolved")
+	default:
+		_, _ = yellow.Print("● unresolved")
+	}
+	if thread.IsOutdated {
+		_, _ = dim.Print(" (outdated)")
+	}
+	fmt.Println()
+
+	hunk := strings.Split(strings.TrimRight(thread.DiffHunk, "\n"), "\n")
+	if len(hunk) > diffHunkContext {
+		hunk = hunk[len(hunk)-diffHunkContext:]
+	}
+	for _, line := range hunk {
+		if line != "" {
+			_, _ = dim.Printf("    │ %s\n", line)
+		}
+	}
+
+	for _, comment := range thread.Comments {
+		author := comment.Author
+		if author == "" {
+			author = "ghost"
+		}
+		_, _ = yellow.Printf("    @%s", author)
+		_, _ = dim.Printf(" · %s\n", comment.CreatedAt.Local().Format("2006-01-02 15:04"))
+		for _, line := range strings.Split(strings.TrimSpace(comment.Body), "\n") {
+			fmt.Printf("      %s\n", line)
+		}
+	}
+}
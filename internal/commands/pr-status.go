@@ -1,8 +1,8 @@
 package commands
 
 import (
+	"context"
 	"fmt"
-	"os/exec"
 	"strings"
 	"time"
 
@@ -10,7 +10,9 @@ import (
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 
-	"github.com/rithyhuot/vibe/internal/utils"
+	"github.com/rithyhuot/vibe/internal/models"
+	"github.com/rithyhuot/vibe/internal/output"
+	"github.com/rithyhuot/vibe/internal/services/github"
 )
 
 // NewPRStatusCommand creates the pr-status command
@@ -23,7 +25,7 @@ func NewPRStatusCommand(ctx *CommandContext) *cobra.Command {
 Examples:
   vibe pr-status                 # Check status for current branch's PR
   vibe pr-status 123             # Check status for PR #123
-  vibe pr-status --json          # Output as JSON (if implemented)`,
+  vibe pr-status -o json         # Output as JSON`,
 		RunE: func(_ *cobra.Command, args []string) error {
 			prNumber := ""
 			if len(args) > 0 {
@@ -37,13 +39,8 @@ Examples:
 }
 
 func runPRStatus(ctx *CommandContext, prNumberArg string) error {
-	// Check if gh CLI is available
-	if !isGHAvailable() {
-		return fmt.Errorf("GitHub CLI (gh) is not available. Run: gh auth login")
-	}
-
 	// Get PR number
-	prNumber, err := resolvePRNumberForStatus(prNumberArg)
+	prNumber, err := resolvePRNumberForStatus(ctx, prNumberArg)
 	if err != nil {
 		return err
 	}
@@ -53,112 +50,85 @@ func runPRStatus(ctx *CommandContext, prNumberArg string) error {
 	s.Suffix = " Checking status..."
 	s.Start()
 
-	prInfo, err := fetchPRInfoWithFallback(ctx, prNumber, s)
+	details, err := fetchPRDetails(ctx, prNumber, s)
+	s.Stop()
 	if err != nil {
-		s.Stop()
 		return err
 	}
 
-	// Use detected repo from prInfo for status query
-	statusRepo := prInfo.Repo
-	// Skip if repo has placeholder values
-	if strings.Contains(statusRepo, "org-name") || strings.Contains(statusRepo, "repo-name") ||
-		strings.Contains(statusRepo, "your-") {
-		statusRepo = ""
-	}
-
-	status, err := getPRStatusInfo(prNumber, statusRepo)
-	if err != nil {
-		s.Stop()
-		return fmt.Errorf("failed to fetch PR status: %w", err)
+	if ctx.Output.IsStructured() {
+		result := output.NewPRStatus(details.PR)
+		result.ReadyToMerge = isPRReadyToMerge(details.Status)
+		return writeOutput(ctx, output.KindPRStatus, result)
 	}
 
-	s.Stop()
-
-	displayPRStatusAndReadiness(prNumber, prInfo, status)
+	displayPRStatusAndReadiness(details.Info, details.Status)
 	return nil
 }
 
-func resolvePRNumberForStatus(prNumberArg string) (string, error) {
+func resolvePRNumberForStatus(ctx *CommandContext, prNumberArg string) (int, error) {
 	if prNumberArg != "" {
-		return prNumberArg, nil
+		return parseAndValidatePRNumber(prNumberArg)
 	}
 
 	// Get PR for current branch
-	pr, err := getPRForCurrentBranch()
+	pr, err := getPRForCurrentBranch(ctx)
 	if err != nil || pr == nil {
-		yellow := color.New(color.FgYellow)
-		_, _ = yellow.Println("No PR found for this branch.")
-		return "", fmt.Errorf("no PR found for current branch")
-	}
-
-	return fmt.Sprintf("%d", pr.Number), nil
-}
-
-func fetchPRInfoWithFallback(ctx *CommandContext, prNumber string, s *spinner.Spinner) (*PRInfo, error) {
-	// Try with config repo first
-	var configRepo string
-	if ctx != nil && ctx.Config != nil {
-		configRepo = fmt.Sprintf("%s/%s", ctx.Config.GitHub.Owner, ctx.Config.GitHub.Repo)
-		// Skip if config has placeholder values
-		if strings.Contains(configRepo, "org-name") || strings.Contains(configRepo, "repo-name") ||
-			strings.Contains(configRepo, "your-") {
-			configRepo = ""
+		if !ctx.Output.IsStructured() {
+			yellow := color.New(color.FgYellow)
+			_, _ = yellow.Println("No PR found for this branch.")
 		}
+		return 0, fmt.Errorf("no PR found for current branch")
 	}
 
-	prInfo, err := getPRInfo(prNumber, configRepo)
+	return pr.Number, nil
+}
 
-	// If not found in config repo, try with git remote repo
-	if err != nil && (strings.Contains(err.Error(), "Could not resolve to a PullRequest") ||
-		strings.Contains(err.Error(), "Could not resolve to a Repository")) {
-		owner, repo, repoErr := getRepoFromGitRemote()
-		gitRemoteRepo := fmt.Sprintf("%s/%s", owner, repo)
-		if repoErr == nil && gitRemoteRepo != "" && gitRemoteRepo != configRepo {
-			dim := color.New(color.Faint)
-			s.Stop()
-			_, _ = dim.Printf("PR not found in configured repo, trying %s...\n", gitRemoteRepo)
-			s.Start()
+// fetchPRDetails fetches a pull request and its review and check status,
+// falling back to the git remote repo if it is not found in the configured repo
+func fetchPRDetails(ctx *CommandContext, prNumber int, s *spinner.Spinner) (*prDetails, error) {
+	cmdCtx := context.Background()
 
-			prInfo, err = getPRInfo(prNumber, gitRemoteRepo)
+	details, err := withRepoFallback(ctx, s, func(client github.Client) (*prDetails, error) {
+		status, err := client.GetPRStatus(cmdCtx, prNumber)
+		if err != nil {
+			return nil, err
 		}
-	}
-
+		return &prDetails{
+			Client: client,
+			PR:     status,
+			Info:   newPRInfo(status),
+			Status: newPRStatusInfo(status, fetchBranchProtection(client, status.BaseBranch)),
+		}, nil
+	})
 	if err != nil {
-		// Check if it's a "not found" error
-		if strings.Contains(err.Error(), "Could not resolve to a PullRequest") {
-			return nil, fmt.Errorf("PR #%s not found in this repository", prNumber)
+		if isPRNotFoundError(err) {
+			return nil, fmt.Errorf("PR #%d not found in this repository", prNumber)
 		}
-		if strings.Contains(err.Error(), "Could not resolve to a Repository") {
+		if isRepoNotFoundError(err) {
 			return nil, fmt.Errorf("repository not found - check your config at ~/.config/vibe/config.yaml")
 		}
 		return nil, fmt.Errorf("failed to fetch PR: %w", err)
 	}
 
-	return prInfo, nil
+	return details, nil
 }
 
-func displayPRStatusAndReadiness(prNumber string, prInfo *PRInfo, status *PRStatusInfo) {
+func displayPRStatusAndReadiness(prInfo *PRInfo, status *PRStatusInfo) {
 	// Display PR info
 	bold := color.New(color.Bold)
 	dim := color.New(color.Faint)
 
 	fmt.Println()
-	_, _ = bold.Printf("PR #%s: %s\n", prNumber, prInfo.Title)
+	_, _ = bold.Printf("PR #%d: %s\n", prInfo.Number, prInfo.Title)
 	_, _ = dim.Println(prInfo.URL)
 	fmt.Println()
 
 	// Display status
 	displayPRStatus(status)
 
-	// Determine readiness
-	isReady := status.CIPassed &&
-		status.CIPending == 0 &&
-		len(status.Approvals) > 0 &&
-		len(status.ChangesRequested) == 0
-
 	fmt.Println()
-	if isReady {
+	if isPRReadyToMerge(status) {
 		green := color.New(color.FgGreen, color.Bold)
 		_, _ = green.Println("Status: READY TO MERGE ✓")
 	} else {
@@ -173,7 +143,6 @@ type PRInfo struct {
 	Title  string
 	URL    string
 	State  string
-	Repo   string // owner/repo format
 }
 
 // PRStatusInfo represents the status of a pull request
@@ -183,116 +152,88 @@ type PRStatusInfo struct {
 	CIFailed         []string
 	Approvals        []string
 	ChangesRequested []string
+	Protection       *github.BranchProtection // Merge requirements of the base branch; nil when unknown
+	MissingChecks    []string                 // Required checks that haven't passed
 }
 
-func getPRInfo(prNumber, repo string) (*PRInfo, error) {
-	args := []string{"pr", "view", prNumber, "--json", "number,title,url,state"}
-	if repo != "" {
-		args = append(args, "-R", repo)
-	}
-
-	cmd := exec.Command("gh", args...)
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		return nil, fmt.Errorf("%w: %s", err, string(output))
-	}
-
-	var pr struct {
-		Number int    `json:"number"`
-		Title  string `json:"title"`
-		URL    string `json:"url"`
-		State  string `json:"state"`
-	}
-
-	if err := utils.ParseJSON(output, &pr); err != nil {
-		return nil, err
-	}
-
-	return &PRInfo{
-		Number: pr.Number,
-		Title:  pr.Title,
-		URL:    pr.URL,
-		State:  pr.State,
-		Repo:   repo,
-	}, nil
+// prDetails holds a pull request's status and the client that fetched it,
+// so follow-up actions target the same repository
+type prDetails struct {
+	Client github.Client
+	PR     *models.PRStatus
+	Info   *PRInfo
+	Status *PRStatusInfo
 }
 
-func getPRStatusInfo(prNumber, repo string) (*PRStatusInfo, error) {
-	args := []string{"pr", "view", prNumber, "--json", "reviewDecision,reviews,statusCheckRollup"}
-	if repo != "" {
-		args = append(args, "-R", repo)
-	}
-
-	cmd := exec.Command("gh", args...)
-	output, err := cmd.Output()
-	if err != nil {
-		return nil, err
+// newPRInfo extracts basic pull request information from a PR status
+func newPRInfo(status *models.PRStatus) *PRInfo {
+	return &PRInfo{
+		Number: status.Number,
+		Title:  status.Title,
+		URL:    status.URL,
+		State:  status.State,
 	}
+}
 
-	var data struct {
-		ReviewDecision string `json:"reviewDecision"`
-		Reviews        []struct {
-			Author struct {
-				Login string `json:"login"`
-			} `json:"author"`
-			State string `json:"state"`
-		} `json:"reviews"`
-		StatusCheckRollup []struct {
-			Name       string  `json:"name"`
-			Status     string  `json:"status"`
-			Conclusion *string `json:"conclusion"`
-		} `json:"statusCheckRollup"`
+// fetchBranchProtection returns the merge requirements of a base branch, or
+// nil when the client can't read them or the branch isn't protected
+func fetchBranchProtection(client github.Client, branch string) *github.BranchProtection {
+	mergeClient, ok := client.(github.MergeClient)
+	if !ok || branch == "" {
+		return nil
 	}
 
-	if err := utils.ParseJSON(output, &data); err != nil {
-		return nil, err
+	protection, err := mergeClient.GetBranchProtection(context.Background(), branch)
+	if err != nil || !protection.Protected {
+		return nil
 	}
+	return protection
+}
 
-	status := &PRStatusInfo{}
-
-	// Parse reviews
-	approvals := make(map[string]bool)
-	changesRequested := make(map[string]bool)
+// newPRStatusInfo summarizes the per-reviewer and per-check state of a PR
+// and, when protection is known, the required checks that haven't passed
+func newPRStatusInfo(status *models.PRStatus, protection *github.BranchProtection) *PRStatusInfo {
+	info := &PRStatusInfo{Protection: protection}
 
-	for _, review := range data.Reviews {
+	for _, review := range status.ReviewStatus.Reviews {
 		switch review.State {
 		case "APPROVED":
-			approvals[review.Author.Login] = true
-			delete(changesRequested, review.Author.Login)
+			info.Approvals = append(info.Approvals, review.Reviewer)
 		case "CHANGES_REQUESTED":
-			changesRequested[review.Author.Login] = true
-			delete(approvals, review.Author.Login)
+			info.ChangesRequested = append(info.ChangesRequested, review.Reviewer)
 		}
 	}
 
-	for login := range approvals {
-		status.Approvals = append(status.Approvals, login)
-	}
-	for login := range changesRequested {
-		status.ChangesRequested = append(status.ChangesRequested, login)
+	for _, check := range status.CheckStatus.Checks {
+		switch check.Status {
+		case "success":
+			// passed
+		case "failure":
+			info.CIFailed = append(info.CIFailed, check.Name)
+		default:
+			info.CIPending++
+		}
 	}
 
-	// Parse CI checks
-	ciPassed := true
-	for _, check := range data.StatusCheckRollup {
-		if check.Conclusion != nil {
-			switch *check.Conclusion {
-			case "success":
-				// passed
-			case "failure", "timed_out", "action_required":
-				ciPassed = false
-				status.CIFailed = append(status.CIFailed, check.Name)
+	// Checks that could not be fetched are not treated as passing
+	info.CIPassed = status.CheckStatus.OverallStatus != "unknown" &&
+		len(info.CIFailed) == 0 && info.CIPending == 0
+
+	if protection != nil {
+		passed := make(map[string]bool)
+		for _, check := range status.CheckStatus.Checks {
+			if check.Status == "success" {
+				passed[check.Name] = true
+			}
+		}
+		for _, name := range protection.RequiredChecks {
+			if !passed[name] {
+				info.MissingChecks = append(info.MissingChecks, name)
 			}
-		} else {
-			// No conclusion yet means pending
-			status.CIPending++
-			ciPassed = false
 		}
 	}
 
-	status.CIPassed = ciPassed && len(status.CIFailed) == 0 && status.CIPending == 0
-
-	return status, nil
+	return info
 }
 
 func displayPRStatus(status *PRStatusInfo) {
@@ -314,12 +255,23 @@ func displayPRStatus(status *PRStatusInfo) {
 	} else {
 		fmt.Printf("  %s No CI checks\n", dim.Sprint("—"))
 	}
+	if len(status.MissingChecks) > 0 {
+		fmt.Printf("  %s Required checks not passed: %s\n", yellow.Sprint("⋯"), strings.Join(status.MissingChecks, ", "))
+	}
 
-	// Display review status
+	// Display review status, with the approvals the base branch requires
+	required := ""
+	approved := green.Sprint("✓")
+	if status.Protection != nil && status.Protection.RequiredApprovals > 0 {
+		required = dim.Sprintf(" (%d of %d required)", len(status.Approvals), status.Protection.RequiredApprovals)
+		if len(status.Approvals) < status.Protection.RequiredApprovals {
+			approved = yellow.Sprint("⋯")
+		}
+	}
 	if len(status.Approvals) > 0 {
-		fmt.Printf("  %s Approved by: %s\n", green.Sprint("✓"), strings.Join(status.Approvals, ", "))
+		fmt.Printf("  %s Approved by: %s%s\n", approved, strings.Join(status.Approvals, ", "), required)
 	} else {
-		fmt.Printf("  %s No approvals\n", dim.Sprint("—"))
+		fmt.Printf("  %s No approvals%s\n", dim.Sprint("—"), required)
 	}
 
 	if len(status.ChangesRequested) > 0 {
@@ -1,8 +1,8 @@
 package commands
 
 import (
+	"context"
 	"fmt"
-	"os/exec"
 	"strings"
 	"time"
 
@@ -10,7 +10,8 @@ import (
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 
-	"github.com/rithyhuot/vibe/internal/utils"
+	"github.com/rithyhuot/vibe/internal/models"
+	"github.com/rithyhuot/vibe/internal/services/github"
 )
 
 // PRUpdateOptions holds flags for the pr-update command
@@ -19,6 +20,8 @@ type PRUpdateOptions struct {
 	Summary     string
 	Description string
 	Testing     string
+	Yes         bool
+	PRMetadataOptions
 }
 
 // NewPRUpdateCommand creates the pr-update command
@@ -34,8 +37,11 @@ Examples:
   vibe pr-update --title "New title"                    # Update PR title
   vibe pr-update --summary "Updated implementation"     # Update summary section
   vibe pr-update 123 --description "New description"    # Update PR #123 description
-  vibe pr-update --testing "Run tests with 'make test'" # Update testing section`,
-		RunE: func(_ *cobra.Command, args []string) error {
+  vibe pr-update --testing "Run tests with 'make test'" # Update testing section
+  vibe pr-update --reviewer alice --label needs-qa      # Request a review and add a label
+  vibe pr-update --suggest-reviewers --yes              # Request reviews from code owners`,
+		RunE: func(cobraCmd *cobra.Command, args []string) error {
+			ctx = getCommandContext(cobraCmd, ctx)
 			prNumber := ""
 			if len(args) > 0 {
 				prNumber = args[0]
@@ -48,85 +54,129 @@ Examples:
 	cmd.Flags().StringVar(&opts.Summary, "summary", "", "Update summary section")
 	cmd.Flags().StringVar(&opts.Description, "description", "", "Update description section")
 	cmd.Flags().StringVar(&opts.Testing, "testing", "", "Update testing section")
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Request reviews from all suggested code owners without prompting")
+	addPRMetadataFlags(cmd, &opts.PRMetadataOptions)
 
 	return cmd
 }
 
-func runPRUpdate(_ *CommandContext, opts *PRUpdateOptions, prNumberArg string) error {
-	// Check if gh CLI is available
-	if !isGHAvailable() {
-		return fmt.Errorf("GitHub CLI (gh) is not available. Run: gh auth login")
-	}
-
+func runPRUpdate(ctx *CommandContext, opts *PRUpdateOptions, prNumberArg string) error {
 	// Get PR number
-	prNumber, err := resolvePRNumber(prNumberArg)
+	prNumber, err := resolvePRNumber(ctx, prNumberArg)
 	if err != nil {
 		return err
 	}
 
 	// Check if any updates were provided
 	if !hasUpdates(opts) {
-		return fmt.Errorf("no updates provided. Use --title, --summary, --description, or --testing flags")
+		return fmt.Errorf("no updates provided. Use --title, --summary, --description, --testing, --reviewer, --team-reviewer, --label, --assignee, or --suggest-reviewers flags")
+	}
+
+	metadata, err := resolveUpdateMetadata(ctx, prNumber, opts)
+	if err != nil {
+		return err
 	}
 
 	// Update PR
-	if err := updatePR(prNumber, opts); err != nil {
+	if err := updatePR(ctx, prNumber, opts, metadata); err != nil {
 		return err
 	}
 
 	// Show success message
-	displayUpdateSuccess(prNumber, opts)
+	displayUpdateSuccess(prNumber, opts, metadata)
 	return nil
 }
 
-func resolvePRNumber(prNumberArg string) (string, error) {
+// resolveUpdateMetadata returns the reviewers, labels, and assignees to add.
+// Code owners are suggested from the PR's diff against its base branch on
+// origin, leaving out the PR's author.
+func resolveUpdateMetadata(ctx *CommandContext, prNumber int, opts *PRUpdateOptions) (*models.PRMetadata, error) {
+	if !opts.SuggestReviewers {
+		return opts.metadata(), nil
+	}
+
+	cmdCtx := context.Background()
+	pr, err := withRepoFallback(ctx, nil, func(client github.Client) (*models.PullRequest, error) {
+		return client.GetPR(cmdCtx, prNumber)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PR: %w", err)
+	}
+
+	author := pr.User.Login
+	if author == "" {
+		author = ctx.Config.GitHub.Username
+	}
+
+	return resolvePRMetadata(ctx, &opts.PRMetadataOptions, "origin/"+pr.Base.Ref, "origin/"+pr.Head.Ref, author, opts.Yes)
+}
+
+func resolvePRNumber(ctx *CommandContext, prNumberArg string) (int, error) {
 	if prNumberArg != "" {
-		return prNumberArg, nil
+		return parseAndValidatePRNumber(prNumberArg)
 	}
 
 	// Get PR for current branch
-	pr, err := getPRForCurrentBranch()
+	pr, err := getPRForCurrentBranch(ctx)
 	if err != nil || pr == nil {
-		return "", fmt.Errorf("no PR found for this branch")
+		return 0, fmt.Errorf("no PR found for this branch")
 	}
-	return fmt.Sprintf("%d", pr.Number), nil
+	return pr.Number, nil
 }
 
 func hasUpdates(opts *PRUpdateOptions) bool {
-	return opts.Title != "" || opts.Summary != "" || opts.Description != "" || opts.Testing != ""
+	return opts.Title != "" || opts.Summary != "" || opts.Description != "" || opts.Testing != "" || opts.hasMetadata()
 }
 
-func updatePR(prNumber string, opts *PRUpdateOptions) error {
+func updatePR(ctx *CommandContext, prNumber int, opts *PRUpdateOptions, metadata *models.PRMetadata) error {
 	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
 	s.Suffix = " Updating PR..."
 	s.Start()
 	defer s.Stop()
 
-	// Get updated body if needed
-	body, err := buildUpdatedBody(prNumber, opts)
-	if err != nil {
-		return err
-	}
+	cmdCtx := context.Background()
 
-	// Build and execute update command
-	args := buildUpdateArgs(prNumber, opts.Title, body)
-	cmd := exec.Command("gh", args...)
-	output, err := cmd.CombinedOutput()
+	_, err := withRepoFallback(ctx, s, func(client github.Client) (*models.PullRequest, error) {
+		// Get updated body if needed
+		body, err := buildUpdatedBody(cmdCtx, client, prNumber, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		var title *string
+		if opts.Title != "" {
+			title = &opts.Title
+		}
+
+		var pr *models.PullRequest
+		if title != nil || body != nil {
+			if pr, err = client.UpdatePR(cmdCtx, prNumber, &models.PRUpdateRequest{Title: title, Body: body}); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := client.AddPRMetadata(cmdCtx, prNumber, metadata); err != nil {
+			return nil, err
+		}
+		return pr, nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to update PR: %w\nOutput: %s", err, string(output))
+		return fmt.Errorf("failed to update PR: %w", err)
 	}
 
 	return nil
 }
 
-func buildUpdatedBody(prNumber string, opts *PRUpdateOptions) (string, error) {
+// buildUpdatedBody returns the PR body with the requested sections replaced,
+// or nil if no section updates were requested
+func buildUpdatedBody(cmdCtx context.Context, client github.Client, prNumber int, opts *PRUpdateOptions) (*string, error) {
 	if opts.Summary == "" && opts.Description == "" && opts.Testing == "" {
-		return "", nil
+		return nil, nil
 	}
 
-	body, err := getPRBody(prNumber)
+	body, err := client.GetPRBody(cmdCtx, prNumber)
 	if err != nil {
-		return "", fmt.Errorf("failed to get PR body: %w", err)
+		return nil, fmt.Errorf("failed to get PR body: %w", err)
 	}
 
 	if opts.Summary != "" {
@@ -139,23 +189,12 @@ func buildUpdatedBody(prNumber string, opts *PRUpdateOptions) (string, error) {
 		body = updatePRSection(body, "How to Test", opts.Testing)
 	}
 
-	return body, nil
-}
-
-func buildUpdateArgs(prNumber, title, body string) []string {
-	args := []string{"pr", "edit", prNumber}
-	if title != "" {
-		args = append(args, "--title", title)
-	}
-	if body != "" {
-		args = append(args, "--body", body)
-	}
-	return args
+	return &body, nil
 }
 
-func displayUpdateSuccess(prNumber string, opts *PRUpdateOptions) {
+func displayUpdateSuccess(prNumber int, opts *PRUpdateOptions, metadata *models.PRMetadata) {
 	green := color.New(color.FgGreen)
-	_, _ = green.Printf("✓ Updated PR #%s\n", prNumber)
+	_, _ = green.Printf("✓ Updated PR #%d\n", prNumber)
 
 	if opts.Title != "" {
 		fmt.Printf("  • Title updated\n")
@@ -169,24 +208,16 @@ func displayUpdateSuccess(prNumber string, opts *PRUpdateOptions) {
 	if opts.Testing != "" {
 		fmt.Printf("  • Testing section updated\n")
 	}
-}
-
-func getPRBody(prNumber string) (string, error) {
-	cmd := exec.Command("gh", "pr", "view", prNumber, "--json", "body")
-	output, err := cmd.Output()
-	if err != nil {
-		return "", err
+	if len(metadata.Reviewers) > 0 || len(metadata.TeamReviewers) > 0 {
+		reviewers := append(mentions(metadata.Reviewers), mentions(metadata.TeamReviewers)...)
+		fmt.Printf("  • Requested reviews from %s\n", strings.Join(reviewers, ", "))
 	}
-
-	var data struct {
-		Body string `json:"body"`
+	if len(metadata.Labels) > 0 {
+		fmt.Printf("  • Added labels %s\n", strings.Join(metadata.Labels, ", "))
 	}
-
-	if err := utils.ParseJSON(output, &data); err != nil {
-		return "", err
+	if len(metadata.Assignees) > 0 {
+		fmt.Printf("  • Assigned %s\n", strings.Join(mentions(metadata.Assignees), ", "))
 	}
-
-	return data.Body, nil
 }
 
 func updatePRSection(body, section, content string) string {
@@ -15,6 +15,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/rithyhuot/vibe/internal/models"
+	"github.com/rithyhuot/vibe/internal/services/github"
 	"github.com/rithyhuot/vibe/internal/utils"
 )
 
@@ -29,6 +30,7 @@ type PRCommandOptions struct {
 	BodyFile    string
 	Yes         bool
 	AI          bool
+	PRMetadataOptions
 }
 
 // NewPRCommand creates the pr command
@@ -44,7 +46,17 @@ For long PR descriptions, use --body-file to read from a file:
   vibe pr --yes --title "My PR" --body-file pr_body.md
 
 Or pass individual sections:
-  vibe pr --yes --title "My PR" --summary "..." --description "..." --testing "..."`,
+  vibe pr --yes --title "My PR" --summary "..." --description "..." --testing "..."
+
+Request reviewers, add labels, and assign users as the PR is created:
+  vibe pr --reviewer alice,bob --team-reviewer backend --label bug --assignee alice
+
+--suggest-reviewers requests reviews from the code owners (CODEOWNERS) of
+the changed files, leaving you out. You pick from them unless --yes is set.
+
+Work through review feedback with the comments, reply, and resolve subcommands:
+  vibe pr comments --unresolved
+  vibe pr reply 3 "Fixed" --resolve`,
 		RunE: func(cobraCmd *cobra.Command, _ []string) error {
 			// Get context from the command's context value (set by PreRunE)
 			ctx = getCommandContext(cobraCmd, ctx)
@@ -57,10 +69,17 @@ Or pass individual sections:
 	cmd.Flags().StringVar(&opts.Summary, "summary", "", "PR summary")
 	cmd.Flags().StringVar(&opts.Description, "description", "", "PR description")
 	cmd.Flags().StringVar(&opts.Testing, "testing", "", "How to test")
-	cmd.Flags().StringVar(&opts.Base, "base", "", "Base branch (default: from config)")
+	cmd.Flags().StringVar(&opts.Base, "base", "", "Base branch (default: the stack parent, or from config)")
 	cmd.Flags().StringVar(&opts.BodyFile, "body-file", "", "Read PR body from file")
 	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Skip confirmation prompts")
 	cmd.Flags().BoolVar(&opts.AI, "ai", false, "Use AI to generate PR description from git diff")
+	addPRMetadataFlags(cmd, &opts.PRMetadataOptions)
+
+	cmd.AddCommand(
+		newPRCommentsCommand(ctx),
+		newPRReplyCommand(ctx),
+		newPRResolveCommand(ctx),
+	)
 
 	return cmd
 }
@@ -77,13 +96,8 @@ func runPR(ctx *CommandContext, opts *PRCommandOptions) error {
 		return fmt.Errorf("safety check: cannot create PR from %s branch. Create a feature branch first", currentBranch)
 	}
 
-	// Check if gh CLI is available
-	if !isGHAvailable() {
-		return fmt.Errorf("GitHub CLI (gh) is not available or not authenticated. Run: gh auth login")
-	}
-
 	// Check for existing PR
-	existingPR, _ := getPRForCurrentBranch()
+	existingPR, _ := getPRForCurrentBranch(ctx)
 	if existingPR != nil {
 		yellow := color.New(color.FgYellow)
 		_, _ = yellow.Printf("PR already exists: %s\n", existingPR.URL)
@@ -128,8 +142,11 @@ func hasNonInteractiveOptions(opts *PRCommandOptions) bool {
 
 //nolint:gocyclo // Complex user interaction flow
 func createPRInteractive(ctx *CommandContext, opts *PRCommandOptions, branch string) error {
-	// Determine base branch
+	// Determine base branch, which is the parent of stacked branches
 	baseBranch := opts.Base
+	if baseBranch == "" {
+		baseBranch = stackParent(ctx, branch)
+	}
 	if baseBranch == "" {
 		exists, _ := ctx.GitRepo.BranchExists(ctx.Config.Git.BaseBranch)
 		if exists {
@@ -205,7 +222,7 @@ func createPRInteractive(ctx *CommandContext, opts *PRCommandOptions, branch str
 	}
 
 	// Extract ticket ID and fetch details
-	ticketID, _ := utils.ExtractTicketID(branch)
+	ticketID, _ := branchTicketID(ctx, branch)
 	var ticketName string
 
 	if ticketID != "" {
@@ -214,7 +231,7 @@ func createPRInteractive(ctx *CommandContext, opts *PRCommandOptions, branch str
 		s2.Start()
 
 		cmdCtx := context.Background()
-		task, err := ctx.ClickUpClient.GetTask(cmdCtx, ticketID)
+		task, err := ctx.Tracker.GetTicket(cmdCtx, ticketID)
 		if err == nil && task != nil {
 			ticketName = task.Name
 			s2.Stop()
@@ -333,6 +350,11 @@ func createPRInteractive(ctx *CommandContext, opts *PRCommandOptions, branch str
 	// Build PR body
 	prBody := buildPRBody(template, ticketID, summary, description, testing)
 
+	metadata, err := resolvePRMetadata(ctx, &opts.PRMetadataOptions, baseBranch, branch, ctx.Config.GitHub.Username, false)
+	if err != nil {
+		return err
+	}
+
 	// Preview
 	fmt.Println()
 	_, _ = bold.Println("━━━ PR Preview ━━━")
@@ -340,6 +362,10 @@ func createPRInteractive(ctx *CommandContext, opts *PRCommandOptions, branch str
 	_, _ = yellow.Printf("Title: %s\n\n", prTitle)
 	fmt.Println(prBody)
 	fmt.Println()
+	if !metadata.IsEmpty() {
+		displayPRMetadata(metadata)
+		fmt.Println()
+	}
 	_, _ = bold.Println("━━━━━━━━━━━━━━━━━━")
 	fmt.Println()
 
@@ -364,7 +390,7 @@ func createPRInteractive(ctx *CommandContext, opts *PRCommandOptions, branch str
 	s3.Suffix = " Creating PR..."
 	s3.Start()
 
-	pr, err := createPRWithGH(ctx, prTitle, prBody, baseBranch, branch, draft)
+	pr, err := createPRWithGH(ctx, prTitle, prBody, baseBranch, branch, draft, metadata)
 	s3.Stop()
 
 	if err != nil {
@@ -375,13 +401,18 @@ func createPRInteractive(ctx *CommandContext, opts *PRCommandOptions, branch str
 	fmt.Println()
 	blue := color.New(color.FgBlue)
 	fmt.Printf("  %s\n", blue.Sprint(pr.URL))
+	displayPRMetadata(metadata)
 
 	return nil
 }
 
 func createPRNonInteractive(ctx *CommandContext, opts *PRCommandOptions, branch string) error {
-	// Determine base branch
-	baseBranch := determineBaseBranch(ctx, opts.Base)
+	// Determine base branch, which is the parent of stacked branches
+	baseBranch := opts.Base
+	if baseBranch == "" {
+		baseBranch = stackParent(ctx, branch)
+	}
+	baseBranch = determineBaseBranch(ctx, baseBranch)
 
 	// Show push summary
 	showPushSummary(branch, baseBranch)
@@ -401,7 +432,7 @@ func createPRNonInteractive(ctx *CommandContext, opts *PRCommandOptions, branch
 	}
 
 	// Extract ticket ID
-	ticketID := extractTicketIDIfNeeded(branch, opts)
+	ticketID := extractTicketIDIfNeeded(ctx, branch, opts)
 
 	// Build PR body
 	prBody, err := buildPRBodyFromOptions(ctx, opts, branch, ticketID)
@@ -412,13 +443,19 @@ func createPRNonInteractive(ctx *CommandContext, opts *PRCommandOptions, branch
 	// Determine title
 	prTitle := determinePRTitle(ctx, opts, branch, ticketID)
 
+	metadata, err := resolvePRMetadata(ctx, &opts.PRMetadataOptions, baseBranch, branch, ctx.Config.GitHub.Username, true)
+	if err != nil {
+		return err
+	}
+
 	// Create PR
-	pr, err := createPRWithGH(ctx, prTitle, prBody, baseBranch, branch, opts.Draft)
+	pr, err := createPRWithGH(ctx, prTitle, prBody, baseBranch, branch, opts.Draft, metadata)
 	if err != nil {
 		return fmt.Errorf("failed to create PR: %w", err)
 	}
 
 	showPRCreated(pr)
+	displayPRMetadata(metadata)
 	return nil
 }
 
@@ -431,7 +468,7 @@ func buildPRBody(template, ticketID, summary, description, testing string) strin
 			body = strings.Replace(body, "## Summary", fmt.Sprintf("## Summary\n\n%s", summary), 1)
 		}
 		if ticketID != "" {
-			body = strings.Replace(body, "CU-", fmt.Sprintf("CU-%s", ticketID), 1)
+			body = strings.Replace(body, "CU-", utils.TicketReference(ticketID), 1)
 		}
 		if description != "" {
 			body = strings.Replace(body, "### Description", fmt.Sprintf("### Description\n\n%s", description), 1)
@@ -459,7 +496,7 @@ func buildPRBody(template, ticketID, summary, description, testing string) strin
 
 %s
 
-Ticket: CU-%s
+Ticket: %s
 
 ### Description
 
@@ -468,7 +505,7 @@ Ticket: CU-%s
 ### How to Test
 
 %s
-`, summary, ticketID, description, testing)
+`, summary, utils.TicketReference(ticketID), description, testing)
 		}
 	}
 
@@ -477,36 +514,17 @@ Ticket: CU-%s
 
 // Helper functions
 
-func isGHAvailable() bool {
-	cmd := exec.Command("gh", "auth", "status")
-	return cmd.Run() == nil
-}
-
-func getPRForCurrentBranch() (*models.PullRequest, error) {
-	cmd := exec.Command("gh", "pr", "view", "--json", "number,title,url,state")
-	output, err := cmd.Output()
+// getPRForCurrentBranch returns the open PR for the current branch, or nil if there is none
+func getPRForCurrentBranch(ctx *CommandContext) (*models.PullRequest, error) {
+	branch, err := ctx.GitRepo.CurrentBranch()
 	if err != nil {
-		return nil, err
-	}
-
-	// Parse JSON output
-	var pr struct {
-		Number int    `json:"number"`
-		Title  string `json:"title"`
-		URL    string `json:"url"`
-		State  string `json:"state"`
+		return nil, fmt.Errorf("failed to get current branch: %w", err)
 	}
 
-	if err := utils.ParseJSON(output, &pr); err != nil {
-		return nil, err
-	}
-
-	return &models.PullRequest{
-		Number: pr.Number,
-		Title:  pr.Title,
-		URL:    pr.URL,
-		State:  pr.State,
-	}, nil
+	cmdCtx := context.Background()
+	return withRepoFallback(ctx, nil, func(client github.Client) (*models.PullRequest, error) {
+		return client.GetPRForBranch(cmdCtx, branch)
+	})
 }
 
 func pushBranch() error {
@@ -569,13 +587,14 @@ func pushBranchIfNeeded(branch string) (bool, error) {
 	return false, pushBranch()
 }
 
-func createPRWithGH(ctx *CommandContext, title, body, base, head string, draft bool) (*models.PullRequest, error) {
+func createPRWithGH(ctx *CommandContext, title, body, base, head string, draft bool, metadata *models.PRMetadata) (*models.PullRequest, error) {
 	req := &models.PRCreateRequest{
-		Title: title,
-		Body:  body,
-		Head:  head,
-		Base:  base,
-		Draft: draft,
+		Title:      title,
+		Body:       body,
+		Head:       head,
+		Base:       base,
+		Draft:      draft,
+		PRMetadata: *metadata,
 	}
 
 	cmdCtx := context.Background()
@@ -631,9 +650,9 @@ func showPushSummary(branch, baseBranch string) {
 	fmt.Printf("Branch: %s → %s\n", cyan.Sprint(branch), dim.Sprint(baseBranch))
 }
 
-func extractTicketIDIfNeeded(branch string, opts *PRCommandOptions) string {
+func extractTicketIDIfNeeded(ctx *CommandContext, branch string, opts *PRCommandOptions) string {
 	if opts.Summary != "" || opts.Description != "" || opts.BodyFile == "" {
-		ticketID, _ := utils.ExtractTicketID(branch)
+		ticketID, _ := branchTicketID(ctx, branch)
 		return ticketID
 	}
 	return ""
@@ -681,7 +700,7 @@ func determinePRTitle(ctx *CommandContext, opts *PRCommandOptions, branch, ticke
 	// Try to get from ticket
 	if ticketID != "" {
 		cmdCtx := context.Background()
-		task, err := ctx.ClickUpClient.GetTask(cmdCtx, ticketID)
+		task, err := ctx.Tracker.GetTicket(cmdCtx, ticketID)
 		if err == nil && task != nil {
 			return task.Name
 		}
@@ -0,0 +1,183 @@
+package commands
+
+import (
+	"fmt"
+	"os/exec"
+	"slices"
+	"strings"
+
+	survey "github.com/AlecAivazis/survey/v2"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/rithyhuot/vibe/internal/models"
+	"github.com/rithyhuot/vibe/internal/services/github"
+)
+
+// PRMetadataOptions holds the reviewer, label, and assignee flags shared by
+// the pr and pr-update commands
+type PRMetadataOptions struct {
+	Reviewers        []string
+	TeamReviewers    []string
+	Labels           []string
+	Assignees        []string
+	SuggestReviewers bool
+}
+
+// addPRMetadataFlags registers the reviewer, label, and assignee flags
+func addPRMetadataFlags(cmd *cobra.Command, opts *PRMetadataOptions) {
+	cmd.Flags().StringSliceVar(&opts.Reviewers, "reviewer", nil, "Request reviews from users (repeatable or comma-separated)")
+	cmd.Flags().StringSliceVar(&opts.TeamReviewers, "team-reviewer", nil, "Request reviews from teams, as slug or org/slug")
+	cmd.Flags().StringSliceVar(&opts.Labels, "label", nil, "Add labels")
+	cmd.Flags().StringSliceVar(&opts.Assignees, "assignee", nil, "Assign users")
+	cmd.Flags().BoolVar(&opts.SuggestReviewers, "suggest-reviewers", false, "Request reviews from the CODEOWNERS of the changed files")
+}
+
+// hasMetadata reports whether any reviewer, label, or assignee flag was given
+func (o *PRMetadataOptions) hasMetadata() bool {
+	return o.SuggestReviewers || !o.metadata().IsEmpty()
+}
+
+// metadata returns the metadata given by flags, without leading @s
+func (o *PRMetadataOptions) metadata() *models.PRMetadata {
+	return &models.PRMetadata{
+		Reviewers:     trimMentions(o.Reviewers),
+		TeamReviewers: trimMentions(o.TeamReviewers),
+		Labels:        o.Labels,
+		Assignees:     trimMentions(o.Assignees),
+	}
+}
+
+// resolvePRMetadata returns the metadata given by flags, adding the code
+// owners of the files changed between base and head when --suggest-reviewers
+// is set. Suggestions are confirmed with a prompt unless skipPrompt is set.
+func resolvePRMetadata(ctx *CommandContext, opts *PRMetadataOptions, base, head, author string, skipPrompt bool) (*models.PRMetadata, error) {
+	metadata := opts.metadata()
+	if !opts.SuggestReviewers {
+		return metadata, nil
+	}
+
+	users, teams, err := suggestReviewers(ctx, base, head, author)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep reviewers that were already given out of the suggestions
+	users = slices.DeleteFunc(users, func(user string) bool { return containsFold(metadata.Reviewers, user) })
+	teams = slices.DeleteFunc(teams, func(team string) bool { return containsFold(metadata.TeamReviewers, team) })
+
+	dim := color.New(color.Faint)
+	if len(users) == 0 && len(teams) == 0 {
+		_, _ = dim.Println("No code owners to suggest as reviewers")
+		return metadata, nil
+	}
+
+	suggestions := make([]string, 0, len(users)+len(teams))
+	for _, reviewer := range append(users, teams...) {
+		suggestions = append(suggestions, "@"+reviewer)
+	}
+
+	selected := suggestions
+	if skipPrompt {
+		_, _ = dim.Printf("Suggested reviewers: %s\n", strings.Join(suggestions, ", "))
+	} else {
+		prompt := &survey.MultiSelect{
+			Message: "Request reviews from code owners:",
+			Options: suggestions,
+			Default: suggestions,
+		}
+		if err := survey.AskOne(prompt, &selected); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, reviewer := range trimMentions(selected) {
+		if slices.Contains(teams, reviewer) {
+			metadata.TeamReviewers = append(metadata.TeamReviewers, reviewer)
+		} else {
+			metadata.Reviewers = append(metadata.Reviewers, reviewer)
+		}
+	}
+
+	return metadata, nil
+}
+
+// suggestReviewers returns the code owners of the files changed between base
+// and head, leaving out author. GitLab groups can't review merge requests, so
+// teams are only suggested for GitHub.
+func suggestReviewers(ctx *CommandContext, base, head, author string) (users, teams []string, err error) {
+	root, err := ctx.GitRepo.GetRootPath()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get repository root: %w", err)
+	}
+
+	codeowners, err := github.LoadCodeowners(root)
+	if err != nil {
+		return nil, nil, err
+	}
+	if codeowners == nil {
+		return nil, nil, fmt.Errorf("no CODEOWNERS file found (looked in %s)", strings.Join(github.CodeownersPaths, ", "))
+	}
+
+	files, err := getChangedFiles(base, head)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	users, teams = codeowners.SuggestReviewers(files, author)
+	if ctx.GitLabClient != nil {
+		teams = nil
+	}
+	return users, teams, nil
+}
+
+// getChangedFiles lists the files changed between the merge base of base and head, and head
+func getChangedFiles(base, head string) ([]string, error) {
+	cmd := exec.Command("git", "diff", "--name-only", fmt.Sprintf("%s...%s", base, head))
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list changed files: %w", err)
+	}
+	return strings.Fields(string(output)), nil
+}
+
+// displayPRMetadata lists the reviewers, labels, and assignees added to a PR
+func displayPRMetadata(metadata *models.PRMetadata) {
+	dim := color.New(color.Faint)
+
+	reviewers := append(mentions(metadata.Reviewers), mentions(metadata.TeamReviewers)...)
+	if len(reviewers) > 0 {
+		_, _ = dim.Printf("  Reviewers: %s\n", strings.Join(reviewers, ", "))
+	}
+	if len(metadata.Labels) > 0 {
+		_, _ = dim.Printf("  Labels:    %s\n", strings.Join(metadata.Labels, ", "))
+	}
+	if len(metadata.Assignees) > 0 {
+		_, _ = dim.Printf("  Assignees: %s\n", strings.Join(mentions(metadata.Assignees), ", "))
+	}
+}
+
+// trimMentions strips the leading @ from usernames and team slugs
+func trimMentions(names []string) []string {
+	var trimmed []string
+	for _, name := range names {
+		if name = strings.TrimPrefix(strings.TrimSpace(name), "@"); name != "" {
+			trimmed = append(trimmed, name)
+		}
+	}
+	return trimmed
+}
+
+// mentions prefixes usernames and team slugs with @
+func mentions(names []string) []string {
+	result := make([]string, len(names))
+	for i, name := range names {
+		result[i] = "@" + name
+	}
+	return result
+}
+
+// containsFold reports whether names contains name, ignoring case
+func containsFold(names []string, name string) bool {
+	return slices.ContainsFunc(names, func(n string) bool { return strings.EqualFold(n, name) })
+}
@@ -0,0 +1,682 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	survey "github.com/AlecAivazis/survey/v2"
+	"github.com/briandowns/spinner"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/rithyhuot/vibe/internal/models"
+	"github.com/rithyhuot/vibe/internal/services/github"
+)
+
+// Review walkthrough actions
+const (
+	reviewActionComment = "Comment on a line"
+	reviewActionNext    = "Next file"
+	reviewActionFinish  = "Finish review"
+)
+
+// Seeded comment actions
+const (
+	draftActionKeep    = "Keep"
+	draftActionEdit    = "Edit"
+	draftActionDiscard = "Discard"
+)
+
+// reviewEventLabels describe review events in prompts and messages
+var reviewEventLabels = map[string]string{
+	github.ReviewEventComment:        "comment",
+	github.ReviewEventApprove:        "approve",
+	github.ReviewEventRequestChanges: "request changes",
+}
+
+// ReviewOptions holds flags for the review command
+type ReviewOptions struct {
+	Seed           string
+	Approve        bool
+	RequestChanges bool
+	Comment        bool
+	Body           string
+	Yes            bool
+}
+
+// reviewTarget is the PR under review, with the client that found it
+type reviewTarget struct {
+	Client github.ReviewClient
+	PR     *models.PullRequest
+	Diff   string
+}
+
+// NewReviewCommand creates the review command
+func NewReviewCommand(ctx *CommandContext) *cobra.Command {
+	opts := &ReviewOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "review [pr-number]",
+		Short: "Review a PR with inline comments",
+		Long: `Walks through the diff of a pull request file by file, collecting comments on
+specific lines, then submits them as a single review that approves, requests
+changes, or comments. If no PR number is provided, uses the current branch's PR.
+
+Lines are given as they're numbered in the diff: 42 for line 42 of the new
+version, 40-42 for a range, and old:17 for a deleted line. Comments can only
+go on lines shown in the diff.
+
+--seed loads draft comments from the findings of the vibe-code-review skill,
+saved to a file. Each one can be kept, edited, or discarded when its file
+comes up. Findings on lines outside the diff go into the review body.
+
+Examples:
+  vibe review                          # Review the current branch's PR
+  vibe review 123                      # Review PR #123
+  vibe review 123 --seed review.md     # Start from vibe-code-review findings
+  vibe review 123 --approve            # Approve after the walkthrough
+  vibe review 123 --seed review.md --request-changes --body "See comments" --yes`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cobraCmd *cobra.Command, args []string) error {
+			ctx = getCommandContext(cobraCmd, ctx)
+			prNumberArg := ""
+			if len(args) > 0 {
+				prNumberArg = args[0]
+			}
+			return runReview(ctx, opts, prNumberArg)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Seed, "seed", "", "Load draft comments from vibe-code-review findings in a file")
+	cmd.Flags().BoolVar(&opts.Approve, "approve", false, "Approve the PR")
+	cmd.Flags().BoolVar(&opts.RequestChanges, "request-changes", false, "Request changes")
+	cmd.Flags().BoolVar(&opts.Comment, "comment", false, "Comment without approving")
+	cmd.Flags().StringVar(&opts.Body, "body", "", "Review summary")
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Submit the seeded comments without the walkthrough or prompts")
+
+	return cmd
+}
+
+func runReview(ctx *CommandContext, opts *ReviewOptions, prNumberArg string) error {
+	event, err := opts.event()
+	if err != nil {
+		return err
+	}
+	if opts.Yes && event == "" {
+		return fmt.Errorf("--yes needs --approve, --request-changes, or --comment")
+	}
+
+	var seeded []models.DraftReviewComment
+	if opts.Seed != "" {
+		data, err := os.ReadFile(opts.Seed)
+		if err != nil {
+			return fmt.Errorf("failed to read seed file: %w", err)
+		}
+		if seeded = github.ParseReviewFindings(string(data)); len(seeded) == 0 {
+			return fmt.Errorf("no findings in %s (expected \"**File: path:line**\" entries from vibe-code-review)", opts.Seed)
+		}
+	}
+
+	prNumber, err := resolvePRNumber(ctx, prNumberArg)
+	if err != nil {
+		return err
+	}
+
+	target, err := fetchReviewTarget(ctx, prNumber)
+	if err != nil {
+		return err
+	}
+
+	files := github.ParseDiff(target.Diff)
+	if len(files) == 0 {
+		return fmt.Errorf("PR #%d has no changes to review", prNumber)
+	}
+
+	inline, general := splitSeededComments(files, seeded)
+	displayReviewHeader(target.PR, files, len(inline), len(general))
+
+	review := &models.PRReview{Event: event, Body: opts.Body, CommitID: target.PR.Head.SHA}
+	if opts.Yes {
+		review.Comments = inline
+	} else if review.Comments, err = walkReviewFiles(files, inline); err != nil {
+		return err
+	}
+
+	if err := completeReview(review, general, opts.Yes); err != nil {
+		return err
+	}
+
+	return submitReview(target.Client, prNumber, review, opts.Yes)
+}
+
+// event returns the review event given by flags, or empty when none was given
+func (o *ReviewOptions) event() (string, error) {
+	var events []string
+	if o.Approve {
+		events = append(events, github.ReviewEventApprove)
+	}
+	if o.RequestChanges {
+		events = append(events, github.ReviewEventRequestChanges)
+	}
+	if o.Comment {
+		events = append(events, github.ReviewEventComment)
+	}
+
+	switch len(events) {
+	case 0:
+		return "", nil
+	case 1:
+		return events[0], nil
+	default:
+		return "", fmt.Errorf("only one of --approve, --request-changes, and --comment can be used")
+	}
+}
+
+// reviewClient returns client when it supports reviews
+func reviewClient(client github.Client) (github.ReviewClient, error) {
+	reviewer, ok := client.(github.ReviewClient)
+	if !ok {
+		return nil, fmt.Errorf("reviews are only supported for GitHub pull requests")
+	}
+	return reviewer, nil
+}
+
+// fetchReviewTarget retrieves a PR and its diff, falling back to the
+// repository of the git remote
+func fetchReviewTarget(ctx *CommandContext, prNumber int) (*reviewTarget, error) {
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	s.Suffix = " Fetching PR diff..."
+	s.Start()
+	defer s.Stop()
+
+	cmdCtx := context.Background()
+	return withRepoFallback(ctx, s, func(client github.Client) (*reviewTarget, error) {
+		reviewer, err := reviewClient(client)
+		if err != nil {
+			return nil, err
+		}
+
+		pr, err := client.GetPR(cmdCtx, prNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		diff, err := reviewer.GetPRDiff(cmdCtx, prNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		return &reviewTarget{Client: reviewer, PR: pr, Diff: diff}, nil
+	})
+}
+
+// splitSeededComments separates seeded comments that can go on the diff from
+// those that can't, which only fit in the review body
+func splitSeededComments(files []*github.DiffFile, seeded []models.DraftReviewComment) (inline, general []models.DraftReviewComment) {
+	for _, comment := range seeded {
+		if canCommentOnDiff(files, &comment) {
+			inline = append(inline, comment)
+			continue
+		}
+
+		// A range that leaves the diff still fits on its last line
+		lastLine := comment
+		lastLine.StartLine = 0
+		if comment.StartLine > 0 && canCommentOnDiff(files, &lastLine) {
+			inline = append(inline, lastLine)
+		} else {
+			general = append(general, comment)
+		}
+	}
+	return inline, general
+}
+
+// canCommentOnDiff reports whether a comment can be placed on any file of a diff
+func canCommentOnDiff(files []*github.DiffFile, comment *models.DraftReviewComment) bool {
+	for _, file := range files {
+		if file.CanComment(comment) {
+			return true
+		}
+	}
+	return false
+}
+
+// walkReviewFiles shows each file's diff, going through its seeded comments
+// and collecting new ones, until every file was shown or the review is finished
+func walkReviewFiles(files []*github.DiffFile, seeded []models.DraftReviewComment) ([]models.DraftReviewComment, error) {
+	var comments []models.DraftReviewComment
+
+	for i, file := range files {
+		displayDiffFile(file, i+1, len(files))
+		if file.Binary || len(file.Hunks) == 0 {
+			continue
+		}
+
+		for _, draft := range seeded {
+			if draft.Path != file.Path {
+				continue
+			}
+			kept, err := reviewSeededComment(draft)
+			if err != nil {
+				return nil, err
+			}
+			if kept != nil {
+				comments = append(comments, *kept)
+			}
+		}
+
+		finished, err := collectFileComments(file, &comments, i == len(files)-1)
+		if err != nil {
+			return nil, err
+		}
+		if finished {
+			remaining, err := confirmRemainingSeededComments(files[i+1:], seeded)
+			if err != nil {
+				return nil, err
+			}
+			comments = append(comments, remaining...)
+			break
+		}
+	}
+
+	return comments, nil
+}
+
+// confirmRemainingSeededComments asks whether to add the seeded comments on
+// files the reviewer finished before reaching, rather than drop them
+func confirmRemainingSeededComments(files []*github.DiffFile, seeded []models.DraftReviewComment) ([]models.DraftReviewComment, error) {
+	remaining := seededCommentsForFiles(files, seeded)
+	if len(remaining) == 0 {
+		return nil, nil
+	}
+
+	add := true
+	prompt := &survey.Confirm{
+		Message: fmt.Sprintf("Add the %d %s on files you haven't reviewed?", len(remaining), pluralize("seeded comment", len(remaining))),
+		Default: true,
+	}
+	if err := survey.AskOne(prompt, &add); err != nil {
+		return nil, err
+	}
+	if !add {
+		return nil, nil
+	}
+	return remaining, nil
+}
+
+// seededCommentsForFiles returns the seeded comments on files that can take
+// inline comments
+func seededCommentsForFiles(files []*github.DiffFile, seeded []models.DraftReviewComment) []models.DraftReviewComment {
+	var comments []models.DraftReviewComment
+	for _, file := range files {
+		if file.Binary || len(file.Hunks) == 0 {
+			continue
+		}
+		for _, draft := range seeded {
+			if draft.Path == file.Path {
+				comments = append(comments, draft)
+			}
+		}
+	}
+	return comments
+}
+
+// reviewSeededComment asks whether to keep, edit, or discard a seeded
+// comment, returning nil when it's discarded
+func reviewSeededComment(draft models.DraftReviewComment) (*models.DraftReviewComment, error) {
+	displayDraftComment(&draft, "Seeded comment")
+
+	var action string
+	prompt := &survey.Select{
+		Message: "Seeded comment:",
+		Options: []string{draftActionKeep, draftActionEdit, draftActionDiscard},
+	}
+	if err := survey.AskOne(prompt, &action); err != nil {
+		return nil, err
+	}
+
+	switch action {
+	case draftActionDiscard:
+		return nil, nil
+	case draftActionEdit:
+		editor := &survey.Editor{
+			Message:       "Edit comment",
+			Default:       draft.Body,
+			AppendDefault: true,
+			FileName:      "*.md",
+		}
+		if err := survey.AskOne(editor, &draft.Body); err != nil {
+			return nil, err
+		}
+		if strings.TrimSpace(draft.Body) == "" {
+			return nil, nil
+		}
+	}
+
+	return &draft, nil
+}
+
+// collectFileComments prompts for comments on a file's lines until the
+// reviewer moves on, reporting whether they finished the review
+func collectFileComments(file *github.DiffFile, comments *[]models.DraftReviewComment, last bool) (bool, error) {
+	options := []string{reviewActionComment, reviewActionNext, reviewActionFinish}
+	if last {
+		options = []string{reviewActionComment, reviewActionFinish}
+	}
+
+	for {
+		var action string
+		prompt := &survey.Select{
+			Message: file.Path + ":",
+			Options: options,
+			Default: options[1],
+		}
+		if err := survey.AskOne(prompt, &action); err != nil {
+			return false, err
+		}
+
+		switch action {
+		case reviewActionNext:
+			return false, nil
+		case reviewActionFinish:
+			return true, nil
+		}
+
+		comment, err := promptLineComment(file)
+		if err != nil {
+			return false, err
+		}
+		if comment != nil {
+			*comments = append(*comments, *comment)
+			displayDraftComment(comment, "Added comment")
+		}
+	}
+}
+
+// promptLineComment asks for the lines and text of a comment on a file,
+// returning nil when the text is left empty
+func promptLineComment(file *github.DiffFile) (*models.DraftReviewComment, error) {
+	var lines string
+	linesPrompt := &survey.Input{
+		Message: "Line (42, 40-42, or old:17 for deleted lines):",
+	}
+	validator := func(answer interface{}) error {
+		comment, err := parseReviewLines(file.Path, answer.(string))
+		if err != nil {
+			return err
+		}
+		if !file.CanComment(comment) {
+			return fmt.Errorf("comments must be on lines shown in the diff, within one hunk")
+		}
+		return nil
+	}
+	if err := survey.AskOne(linesPrompt, &lines, survey.WithValidator(validator)); err != nil {
+		return nil, err
+	}
+
+	comment, err := parseReviewLines(file.Path, lines)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyPrompt := &survey.Multiline{
+		Message: "Comment:",
+	}
+	if err := survey.AskOne(bodyPrompt, &comment.Body); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(comment.Body) == "" {
+		return nil, nil
+	}
+
+	return comment, nil
+}
+
+// parseReviewLines parses the lines a comment goes on: "42", "40-42", or
+// with an "old:" prefix for lines of the base version
+func parseReviewLines(path, input string) (*models.DraftReviewComment, error) {
+	comment := &models.DraftReviewComment{Path: path, Side: github.DiffSideRight}
+
+	input = strings.TrimSpace(input)
+	if rest, ok := strings.CutPrefix(input, "old:"); ok {
+		comment.Side = github.DiffSideLeft
+		input = rest
+	}
+
+	start, end, isRange := strings.Cut(input, "-")
+	line, err := strconv.Atoi(strings.TrimSpace(start))
+	if err != nil || line < 1 {
+		return nil, fmt.Errorf("invalid line: %s", input)
+	}
+	comment.Line = line
+
+	if isRange {
+		last, err := strconv.Atoi(strings.TrimSpace(end))
+		if err != nil || last < line {
+			return nil, fmt.Errorf("invalid line range: %s", input)
+		}
+		if last > line {
+			comment.StartLine, comment.Line = line, last
+		}
+	}
+
+	return comment, nil
+}
+
+// completeReview picks the review event and summary that weren't given by
+// flags, adding the comments that couldn't go on the diff to the summary
+func completeReview(review *models.PRReview, general []models.DraftReviewComment, skipPrompt bool) error {
+	if review.Event == "" {
+		events := []string{github.ReviewEventComment, github.ReviewEventApprove, github.ReviewEventRequestChanges}
+		var index int
+		prompt := &survey.Select{
+			Message: "Submit review as:",
+			Options: []string{"Comment", "Approve", "Request changes"},
+		}
+		if err := survey.AskOne(prompt, &index); err != nil {
+			return err
+		}
+		review.Event = events[index]
+	}
+
+	// Requesting changes needs a summary, unless findings outside the diff make one
+	summaryRequired := review.Event == github.ReviewEventRequestChanges && len(general) == 0
+	if review.Body == "" && !skipPrompt {
+		message := "Review summary (optional):"
+		var opts []survey.AskOpt
+		if summaryRequired {
+			message = "Review summary:"
+			opts = append(opts, survey.WithValidator(survey.Required))
+		}
+		if err := survey.AskOne(&survey.Multiline{Message: message}, &review.Body, opts...); err != nil {
+			return err
+		}
+	}
+
+	if len(general) > 0 {
+		review.Body = appendGeneralComments(review.Body, general)
+	}
+
+	empty := strings.TrimSpace(review.Body) == ""
+	switch {
+	case summaryRequired && empty:
+		return fmt.Errorf("requesting changes needs a review summary (--body)")
+	case review.Event == github.ReviewEventComment && empty && len(review.Comments) == 0:
+		return fmt.Errorf("nothing to submit: add comments or a review summary")
+	}
+
+	return nil
+}
+
+// appendGeneralComments adds comments that aren't on the diff to a review summary
+func appendGeneralComments(body string, comments []models.DraftReviewComment) string {
+	var sb strings.Builder
+	if body = strings.TrimSpace(body); body != "" {
+		sb.WriteString(body)
+		sb.WriteString("\n\n")
+	}
+
+	for i, comment := range comments {
+		if i > 0 {
+			sb.WriteString("\n\n")
+		}
+		fmt.Fprintf(&sb, "`%s`\n\n%s", draftLocation(&comment), comment.Body)
+	}
+
+	return sb.String()
+}
+
+// submitReview confirms and submits a review
+func submitReview(client github.ReviewClient, prNumber int, review *models.PRReview, skipPrompt bool) error {
+	summary := fmt.Sprintf("%s with %d inline %s", reviewEventLabels[review.Event],
+		len(review.Comments), pluralize("comment", len(review.Comments)))
+
+	if !skipPrompt {
+		var confirmed bool
+		prompt := &survey.Confirm{
+			Message: fmt.Sprintf("Submit review on PR #%d (%s)?", prNumber, summary),
+			Default: true,
+		}
+		if err := survey.AskOne(prompt, &confirmed); err != nil {
+			return err
+		}
+		if !confirmed {
+			return nil
+		}
+	}
+
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	s.Suffix = " Submitting review..."
+	s.Start()
+	resp, err := client.SubmitReview(context.Background(), prNumber, review)
+	s.Stop()
+	if err != nil {
+		return err
+	}
+
+	green := color.New(color.FgGreen)
+	dim := color.New(color.Faint)
+	_, _ = green.Printf("✓ Submitted review on PR #%d (%s)\n", prNumber, summary)
+	if resp.HTMLURL != "" {
+		_, _ = dim.Printf("  %s\n", resp.HTMLURL)
+	}
+	return nil
+}
+
+// draftLocation returns the file and lines a draft comment goes on
+func draftLocation(comment *models.DraftReviewComment) string {
+	location := comment.Path
+	if comment.Side == github.DiffSideLeft {
+		location += ":old"
+	}
+	switch {
+	case comment.Line == 0:
+		return comment.Path
+	case comment.StartLine > 0:
+		return fmt.Sprintf("%s:%d-%d", location, comment.StartLine, comment.Line)
+	default:
+		return fmt.Sprintf("%s:%d", location, comment.Line)
+	}
+}
+
+// pluralize adds an s to word unless count is one
+func pluralize(word string, count int) string {
+	if count == 1 {
+		return word
+	}
+	return word + "s"
+}
+
+// displayReviewHeader shows the PR under review and how its diff and seeded
+// comments break down
+func displayReviewHeader(pr *models.PullRequest, files []*github.DiffFile, inline, general int) {
+	bold := color.New(color.Bold)
+	dim := color.New(color.Faint)
+	yellow := color.New(color.FgYellow)
+
+	additions, deletions := 0, 0
+	for _, file := range files {
+		a, d := file.Stats()
+		additions += a
+		deletions += d
+	}
+
+	fmt.Println()
+	_, _ = bold.Printf("PR #%d: %s\n", pr.Number, pr.Title)
+	_, _ = dim.Println(pr.URL)
+	fmt.Printf("%d %s changed, ", len(files), pluralize("file", len(files)))
+	_, _ = color.New(color.FgGreen).Printf("+%d ", additions)
+	_, _ = color.New(color.FgRed).Printf("-%d\n", deletions)
+
+	if inline+general > 0 {
+		_, _ = dim.Printf("%d seeded %s", inline+general, pluralize("comment", inline+general))
+		if general > 0 {
+			_, _ = yellow.Printf(" (%d outside the diff will go in the review summary)", general)
+		}
+		fmt.Println()
+	}
+}
+
+// displayDiffFile shows a file's diff with the line numbers of both versions
+func displayDiffFile(file *github.DiffFile, index, total int) {
+	bold := color.New(color.Bold)
+	cyan := color.New(color.FgCyan)
+	dim := color.New(color.Faint)
+	green := color.New(color.FgGreen)
+	red := color.New(color.FgRed)
+
+	fmt.Println()
+	_, _ = bold.Printf("[%d/%d] %s", index, total, file.Path)
+	if file.OldPath != "" && file.OldPath != file.Path {
+		_, _ = dim.Printf(" (renamed from %s)", file.OldPath)
+	}
+	fmt.Println()
+
+	switch {
+	case file.Binary:
+		_, _ = dim.Println("  Binary file, nothing to comment on")
+		return
+	case len(file.Hunks) == 0:
+		_, _ = dim.Println("  No line changes")
+		return
+	}
+
+	for _, hunk := range file.Hunks {
+		_, _ = cyan.Println(hunk.Header)
+		for _, line := range hunk.Lines {
+			_, _ = dim.Printf("%5s %5s ", lineNumber(line.OldLine), lineNumber(line.NewLine))
+			switch line.Kind {
+			case '+':
+				_, _ = green.Printf("+%s\n", line.Content)
+			case '-':
+				_, _ = red.Printf("-%s\n", line.Content)
+			default:
+				fmt.Printf(" %s\n", line.Content)
+			}
+		}
+	}
+}
+
+// lineNumber formats a diff line number, blank for lines missing from a version
+func lineNumber(line int) string {
+	if line == 0 {
+		return ""
+	}
+	return strconv.Itoa(line)
+}
+
+// displayDraftComment shows a draft comment and where it goes
+func displayDraftComment(comment *models.DraftReviewComment, label string) {
+	yellow := color.New(color.FgYellow)
+
+	fmt.Println()
+	_, _ = yellow.Printf("  %s on %s\n", label, draftLocation(comment))
+	for _, line := range strings.Split(strings.TrimSpace(comment.Body), "\n") {
+		fmt.Printf("    %s\n", line)
+	}
+	fmt.Println()
+}
@@ -0,0 +1,33 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rithyhuot/vibe/internal/models"
+	"github.com/rithyhuot/vibe/internal/services/github"
+)
+
+func TestSeededCommentsForFiles(t *testing.T) {
+	files := []*github.DiffFile{
+		{Path: "api/handler.go", Hunks: []github.DiffHunk{{}}},
+		{Path: "assets/logo.png", Binary: true},
+		{Path: "docs/renamed.md"},
+	}
+	seeded := []models.DraftReviewComment{
+		{Path: "main.go", Line: 3, Body: "already reviewed"},
+		{Path: "api/handler.go", Line: 10, Body: "first"},
+		{Path: "assets/logo.png", Body: "binary"},
+		{Path: "api/handler.go", Line: 20, Body: "second"},
+		{Path: "docs/renamed.md", Body: "no hunks"},
+	}
+
+	expected := []models.DraftReviewComment{
+		{Path: "api/handler.go", Line: 10, Body: "first"},
+		{Path: "api/handler.go", Line: 20, Body: "second"},
+	}
+	assert.Equal(t, expected, seededCommentsForFiles(files, seeded))
+
+	assert.Empty(t, seededCommentsForFiles(nil, seeded))
+}
@@ -0,0 +1,253 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/rithyhuot/vibe/internal/config"
+	"github.com/rithyhuot/vibe/internal/models"
+	"github.com/rithyhuot/vibe/internal/output"
+	"github.com/rithyhuot/vibe/internal/utils"
+)
+
+// SprintOptions holds flags for the sprint command
+type SprintOptions struct {
+	Next     bool
+	Previous bool
+}
+
+// sprintStatusGroup holds the tasks that share a status
+type sprintStatusGroup struct {
+	Status models.Status
+	Tasks  []*models.Task
+}
+
+// NewSprintCommand creates the sprint command
+func NewSprintCommand(ctx *CommandContext) *cobra.Command {
+	opts := &SprintOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "sprint",
+		Short: "Show the current sprint board",
+		Long: `Show the sprint board for each configured workspace.
+
+Finds the current sprint in each workspace's sprint folder using the configured
+sprint_patterns, lists its tasks grouped by status, and highlights the tasks
+assigned to you (clickup.user_id).
+
+Examples:
+  vibe sprint                    # Show the current sprint
+  vibe sprint --next             # Show the next sprint
+  vibe sprint --previous         # Show the previous sprint
+  vibe sprint -o json            # Output the sprint board as JSON`,
+		Args: cobra.NoArgs,
+		RunE: func(cobraCmd *cobra.Command, _ []string) error {
+			ctx = getCommandContext(cobraCmd, ctx)
+			return runSprint(ctx, opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Next, "next", false, "Show the next sprint")
+	cmd.Flags().BoolVar(&opts.Previous, "previous", false, "Show the previous sprint")
+	cmd.MarkFlagsMutuallyExclusive("next", "previous")
+
+	return cmd
+}
+
+func runSprint(ctx *CommandContext, opts *SprintOptions) error {
+	if err := requireClickUp(ctx, "vibe sprint"); err != nil {
+		return err
+	}
+
+	offset := 0
+	if opts.Next {
+		offset = 1
+	} else if opts.Previous {
+		offset = -1
+	}
+
+	cmdCtx := context.Background()
+	boards := []output.SprintBoard{}
+
+	for _, workspace := range ctx.Config.Workspaces {
+		sprint, tasks, err := fetchWorkspaceSprint(cmdCtx, ctx, workspace, offset)
+		if err != nil {
+			return err
+		}
+
+		if ctx.Output.IsStructured() {
+			if sprint != nil {
+				boards = append(boards, output.SprintBoard{
+					Workspace: workspace.Name,
+					SprintID:  sprint.ID,
+					Sprint:    sprint.Name,
+					Tasks:     output.NewTasks(tasks),
+				})
+			}
+			continue
+		}
+
+		if sprint == nil {
+			yellow := color.New(color.FgYellow)
+			_, _ = yellow.Printf("\n⚠ No %s found in workspace %s\n", sprintLabel(offset), workspace.Name)
+			_, _ = color.New(color.Faint).Printf("  Check folder_id and sprint_patterns in your config\n")
+			continue
+		}
+
+		displaySprintBoard(workspace.Name, sprint, tasks, ctx.Config.ClickUp.UserID)
+	}
+
+	if ctx.Output.IsStructured() {
+		return writeOutput(ctx, output.KindSprintBoard, boards)
+	}
+
+	return nil
+}
+
+// fetchWorkspaceSprint finds the sprint for a workspace and fetches its tasks.
+// Returns a nil sprint if no matching sprint exists.
+func fetchWorkspaceSprint(cmdCtx context.Context, ctx *CommandContext, workspace config.WorkspaceConfig, offset int) (*models.Folder, []*models.Task, error) {
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	s.Suffix = fmt.Sprintf(" Finding sprint for %s...", workspace.Name)
+	s.Start()
+	defer s.Stop()
+
+	lists, err := ctx.ClickUpClient.GetLists(cmdCtx, workspace.FolderID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch sprints for workspace %s: %w", workspace.Name, err)
+	}
+
+	sprint := utils.FindAdjacentSprint(workspace.FolderID, lists, workspace.SprintPatterns, offset)
+	if sprint == nil {
+		return nil, nil, nil
+	}
+
+	s.Suffix = fmt.Sprintf(" Fetching tasks for %s...", sprint.Name)
+
+	tasks, err := ctx.ClickUpClient.ListTasks(cmdCtx, sprint.ID, map[string]string{
+		"include_closed": "true",
+		"subtasks":       "true",
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch tasks for %s: %w", sprint.Name, err)
+	}
+
+	return sprint, tasks, nil
+}
+
+// sprintLabel describes the sprint selected by the offset
+func sprintLabel(offset int) string {
+	switch {
+	case offset > 0:
+		return "next sprint"
+	case offset < 0:
+		return "previous sprint"
+	default:
+		return "current sprint"
+	}
+}
+
+// displaySprintBoard renders the sprint tasks grouped by status
+func displaySprintBoard(workspaceName string, sprint *models.Folder, tasks []*models.Task, userID string) {
+	bold := color.New(color.Bold)
+	dim := color.New(color.Faint)
+	mine := color.New(color.FgCyan, color.Bold)
+
+	fmt.Println()
+	_, _ = bold.Printf("🏃 %s — %s\n", workspaceName, sprint.Name)
+
+	myCount := 0
+	for _, task := range tasks {
+		if isAssignedTo(task, userID) {
+			myCount++
+		}
+	}
+	_, _ = dim.Printf("%d tasks, %d assigned to you\n", len(tasks), myCount)
+
+	if len(tasks) == 0 {
+		fmt.Println()
+		_, _ = dim.Println("No tasks in this sprint.")
+		return
+	}
+
+	for _, group := range groupTasksByStatus(tasks) {
+		fmt.Println()
+		statusColor := getStatusColor(group.Status.Status)
+		_, _ = statusColor.Add(color.Bold).Printf("%s (%d)\n", strings.ToUpper(group.Status.Status), len(group.Tasks))
+
+		for _, task := range group.Tasks {
+			name := task.Name
+			nameRunes := []rune(name)
+			if len(nameRunes) > maxTitleDisplayLength-3 {
+				name = string(nameRunes[:maxTitleDisplayLength-3]) + "..."
+			}
+
+			assignees := make([]string, len(task.Assignees))
+			for i, assignee := range task.Assignees {
+				assignees[i] = "@" + assignee.Username
+			}
+			assigneeStr := dim.Sprint("-")
+			if len(assignees) > 0 {
+				assigneeStr = strings.Join(assignees, ", ")
+			}
+
+			if isAssignedTo(task, userID) {
+				_, _ = mine.Printf("  ★ %-10s %-50s %s\n", task.ID, name, assigneeStr)
+			} else {
+				fmt.Printf("    %-10s %-50s %s\n", task.ID, name, assigneeStr)
+			}
+		}
+	}
+
+	fmt.Println()
+}
+
+// groupTasksByStatus groups tasks by status, ordering groups by status type
+// (open, custom, done, closed) and then by first appearance
+func groupTasksByStatus(tasks []*models.Task) []*sprintStatusGroup {
+	var groups []*sprintStatusGroup
+	index := make(map[string]*sprintStatusGroup)
+
+	for _, task := range tasks {
+		key := strings.ToLower(task.Status.Status)
+		group, ok := index[key]
+		if !ok {
+			group = &sprintStatusGroup{Status: task.Status}
+			index[key] = group
+			groups = append(groups, group)
+		}
+		group.Tasks = append(group.Tasks, task)
+	}
+
+	typeOrder := map[string]int{"open": 0, "custom": 1, "unstarted": 1, "active": 1, "done": 2, "closed": 3}
+	rank := func(g *sprintStatusGroup) int {
+		if r, ok := typeOrder[g.Status.Type]; ok {
+			return r
+		}
+		return 1
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		return rank(groups[i]) < rank(groups[j])
+	})
+
+	return groups
+}
+
+// isAssignedTo reports whether the task is assigned to the given ClickUp user ID
+func isAssignedTo(task *models.Task, userID string) bool {
+	for _, assignee := range task.Assignees {
+		if strconv.Itoa(assignee.ID) == userID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,357 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	survey "github.com/AlecAivazis/survey/v2"
+	"github.com/briandowns/spinner"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/rithyhuot/vibe/internal/services/git"
+	"github.com/rithyhuot/vibe/internal/services/github"
+)
+
+// StackRestackOptions holds flags for the stack restack command
+type StackRestackOptions struct {
+	Push bool
+}
+
+// StackLandOptions holds flags for the stack land command
+type StackLandOptions struct {
+	Method string
+}
+
+// newStackRestackCommand creates the stack restack command
+func newStackRestackCommand(ctx *CommandContext) *cobra.Command {
+	opts := &StackRestackOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "restack",
+		Short: "Rebase the stack onto its updated or merged parents",
+		Long: `Fetches origin and rebases each branch of the current stack onto its parent, from the bottom up. The bottom of the stack, like main, is taken from origin.
+
+Branches whose PR was merged leave the stack: their children move onto their
+parent, and the children's PRs are retargeted to it. Only the commits of each
+branch are replayed, so commits of a squash-merged parent aren't.
+
+When a rebase stops on conflicts, resolve them, run 'git rebase --continue',
+and run restack again.
+
+Examples:
+  vibe stack restack           # Rebase locally
+  vibe stack restack --push    # Then force-push the rebased branches and update the PRs`,
+		Args: cobra.NoArgs,
+		RunE: func(cobraCmd *cobra.Command, _ []string) error {
+			ctx = getCommandContext(cobraCmd, ctx)
+			return runStackRestack(ctx, opts.Push)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Push, "push", false, "Force-push the rebased branches (with lease) and update the stack tables")
+
+	return cmd
+}
+
+// newStackLandCommand creates the stack land command
+func newStackLandCommand(ctx *CommandContext) *cobra.Command {
+	opts := &StackLandOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "land",
+		Short: "Merge the bottom PR of the stack and restack the rest",
+		Long: `Merges the PR at the bottom of the current branch's stack through the GitHub API after checking its status, then restacks the branches above it onto the base branch, retargets their PRs, and pushes them.
+
+When the bottom PR or merge request was already merged, only the restack is left.
+
+Examples:
+  vibe stack land                   # Merge with merge.method
+  vibe stack land --method squash   # Squash and merge`,
+		Args: cobra.NoArgs,
+		RunE: func(cobraCmd *cobra.Command, _ []string) error {
+			ctx = getCommandContext(cobraCmd, ctx)
+			return runStackLand(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Method, "method", "", "Merge method: merge, squash, or rebase (default: merge.method)")
+
+	return cmd
+}
+
+func runStackRestack(ctx *CommandContext, push bool) error {
+	current, err := ctx.GitRepo.CurrentBranch()
+	if err != nil {
+		return fmt.Errorf("failed to get current branch: %w", err)
+	}
+	if err := ensureCleanWorktree(ctx); err != nil {
+		return err
+	}
+
+	stack, err := ctx.GitRepo.LoadStack()
+	if err != nil {
+		return err
+	}
+	if len(stack.Branches(current)) == 0 {
+		return fmt.Errorf("%s isn't part of a stack (use 'vibe stack create' or 'vibe stack track')", current)
+	}
+
+	return restackStack(ctx, stack, current, push)
+}
+
+func runStackLand(ctx *CommandContext, opts *StackLandOptions) error {
+	method := opts.Method
+	if method == "" {
+		method = ctx.Config.Merge.Method
+	}
+	if !github.ValidMergeMethod(method) {
+		return fmt.Errorf("invalid merge method: %s (must be 'merge', 'squash', or 'rebase')", method)
+	}
+
+	current, err := ctx.GitRepo.CurrentBranch()
+	if err != nil {
+		return fmt.Errorf("failed to get current branch: %w", err)
+	}
+	if err := ensureCleanWorktree(ctx); err != nil {
+		return err
+	}
+
+	stack, err := ctx.GitRepo.LoadStack()
+	if err != nil {
+		return err
+	}
+	lineage := stack.Lineage(current)
+	if len(lineage) == 0 {
+		return fmt.Errorf("%s isn't a stacked branch (use 'vibe stack create' or 'vibe stack track')", current)
+	}
+
+	lowest := lineage[0]
+	if lowest.PR == 0 {
+		return fmt.Errorf("%s has no PR yet (use 'vibe stack submit')", lowest.Name)
+	}
+
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	s.Suffix = " Checking status..."
+	s.Start()
+	details, err := fetchPRDetails(ctx, lowest.PR, s)
+	s.Stop()
+	if err != nil {
+		return err
+	}
+
+	displayMergePRInfo(details.Info, details.Status)
+	if isPRMerged(details.PR.Merged, details.PR.State) {
+		// Merged elsewhere, so there's only the restack left
+		return restackStack(ctx, stack, current, true)
+	}
+
+	mergeClient, ok := ctx.GitHubClient.(github.MergeClient)
+	if !ok {
+		return fmt.Errorf("landing is only supported for GitHub pull requests; merge the bottom merge request, then run 'vibe stack land' again")
+	}
+
+	isReady := isPRReadyToMerge(details.Status)
+	message := fmt.Sprintf("Merge PR #%d (%s) into %s and restack?", lowest.PR, method, lowest.Parent)
+	if !isReady {
+		yellow := color.New(color.FgYellow)
+		_, _ = yellow.Println("PR is not ready to merge.")
+		fmt.Println()
+		message = yellow.Sprint("Merge anyway and restack? (not recommended)")
+	}
+
+	var confirmed bool
+	prompt := &survey.Confirm{
+		Message: message,
+		Default: isReady,
+	}
+	if err := survey.AskOne(prompt, &confirmed); err != nil {
+		return err
+	}
+	if !confirmed {
+		return nil
+	}
+
+	s = spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	s.Suffix = " Merging..."
+	s.Start()
+	err = mergeClient.MergePR(context.Background(), lowest.PR, method)
+	s.Stop()
+	if err != nil {
+		return err
+	}
+
+	green := color.New(color.FgGreen)
+	_, _ = green.Printf("✓ Merged PR #%d (%s)\n", lowest.PR, method)
+
+	return restackStack(ctx, stack, current, true)
+}
+
+// restackStack drops merged branches from the stack of a branch and rebases
+// the rest onto their parents, then returns to the branch. With push, it
+// pushes the rebased branches and updates the stack tables of their PRs.
+func restackStack(ctx *CommandContext, stack git.Stack, current string, push bool) error {
+	bottom := stack.Bottom(current)
+
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	s.Suffix = " Fetching origin..."
+	s.Start()
+	_, err := runGit("fetch", "origin")
+	s.Stop()
+	if err != nil {
+		return err
+	}
+
+	dropped, err := dropMergedBranches(ctx, stack, bottom)
+	if err != nil {
+		return err
+	}
+
+	for _, branch := range stack.Branches(bottom) {
+		if err := rebaseStackBranch(ctx, stack, branch); err != nil {
+			return err
+		}
+	}
+
+	if _, err := runGit("checkout", current); err != nil {
+		return err
+	}
+
+	if push {
+		for _, branch := range stack.Branches(bottom) {
+			if branch.PR > 0 || refExists("origin/"+branch.Name) {
+				if err := pushStackBranch(branch.Name); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if push || dropped {
+		if err := updateStackNavigation(ctx, stack, bottom); err != nil {
+			return err
+		}
+	}
+
+	green := color.New(color.FgGreen)
+	_, _ = green.Println("✓ Stack is up to date")
+	if stack[current] == nil {
+		// The current branch was merged
+		current = bottom
+	}
+	displayStack(ctx, stack, current)
+	return nil
+}
+
+// dropMergedBranches removes the branches whose PR was merged from the stack
+// on bottom. Their children move onto their parent, keeping the commit they
+// were based on so the merged commits aren't replayed, and their PRs are
+// retargeted to the new parent.
+func dropMergedBranches(ctx *CommandContext, stack git.Stack, bottom string) (bool, error) {
+	green := color.New(color.FgGreen)
+	dim := color.New(color.Faint)
+	cmdCtx := context.Background()
+	dropped := false
+
+	for _, branch := range stack.Branches(bottom) {
+		if branch.PR == 0 {
+			continue
+		}
+		pr, err := ctx.GitHubClient.GetPR(cmdCtx, branch.PR)
+		if err != nil {
+			return dropped, err
+		}
+		if !isPRMerged(pr.Merged, pr.State) {
+			continue
+		}
+
+		for _, child := range stack.Children(branch.Name) {
+			if child.Base == "" {
+				if child.Base, err = revParse(branch.Name); err != nil {
+					return dropped, err
+				}
+			}
+			child.Parent = branch.Parent
+			if err := ctx.GitRepo.SaveStackBranch(child); err != nil {
+				return dropped, err
+			}
+			if child.PR > 0 {
+				if err := retargetStackPR(ctx, child.PR, child.Parent); err != nil {
+					return dropped, err
+				}
+				_, _ = green.Printf("✓ Retargeted %s for %s to %s\n", prReference(ctx, child.PR), child.Name, child.Parent)
+			}
+		}
+
+		if err := ctx.GitRepo.RemoveStackBranch(branch.Name); err != nil {
+			return dropped, err
+		}
+		delete(stack, branch.Name)
+		dropped = true
+
+		_, _ = green.Printf("✓ %s for %s was merged and left the stack\n", prReference(ctx, branch.PR), branch.Name)
+		_, _ = dim.Printf("  Delete it with: git branch -D %s\n", branch.Name)
+	}
+
+	return dropped, nil
+}
+
+// rebaseStackBranch rebases a branch onto its parent, replaying the commits
+// since the parent commit it was last rebased onto
+func rebaseStackBranch(ctx *CommandContext, stack git.Stack, branch *git.StackBranch) error {
+	onto := parentRef(stack, branch.Parent)
+	target, err := revParse(onto)
+	if err != nil {
+		return err
+	}
+
+	// Up to date, including after a rebase that was continued by hand
+	if isAncestor(target, branch.Name) {
+		if branch.Base != target {
+			branch.Base = target
+			return ctx.GitRepo.SaveStackBranch(branch)
+		}
+		return nil
+	}
+
+	upstream := branch.Base
+	if upstream == "" || !isAncestor(upstream, branch.Name) {
+		if upstream, err = runGit("merge-base", onto, branch.Name); err != nil {
+			return err
+		}
+	}
+
+	if _, err := runGit("rebase", "--onto", target, upstream, branch.Name); err != nil {
+		return fmt.Errorf("rebasing %s onto %s stopped. Resolve the conflicts, run 'git rebase --continue', then 'vibe stack restack' again: %w", branch.Name, onto, err)
+	}
+
+	branch.Base = target
+	if err := ctx.GitRepo.SaveStackBranch(branch); err != nil {
+		return err
+	}
+
+	green := color.New(color.FgGreen)
+	_, _ = green.Printf("✓ Rebased %s onto %s\n", branch.Name, onto)
+	return nil
+}
+
+// ensureCleanWorktree fails when tracked files have uncommitted changes,
+// which a rebase would refuse to run with
+func ensureCleanWorktree(ctx *CommandContext) error {
+	status, err := ctx.GitRepo.Status()
+	if err != nil {
+		return fmt.Errorf("failed to check git status: %w", err)
+	}
+	for _, fileStatus := range status {
+		if fileStatus != "untracked" {
+			return fmt.Errorf("you have uncommitted changes; commit or stash them first")
+		}
+	}
+	return nil
+}
+
+// isPRMerged reports whether a PR or merge request was merged, from its merged
+// flag or its state, since gh reports merged PRs through their state
+func isPRMerged(merged bool, state string) bool {
+	return merged || state == "merged"
+}
@@ -0,0 +1,301 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	survey "github.com/AlecAivazis/survey/v2"
+	"github.com/briandowns/spinner"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/rithyhuot/vibe/internal/models"
+	"github.com/rithyhuot/vibe/internal/services/git"
+)
+
+// Markers around the stack navigation table in PR bodies
+const (
+	stackSectionStart = "<!-- vibe-stack -->"
+	stackSectionEnd   = "<!-- /vibe-stack -->"
+)
+
+// StackSubmitOptions holds flags for the stack submit command
+type StackSubmitOptions struct {
+	Draft bool
+	Yes   bool
+}
+
+// newStackSubmitCommand creates the stack submit command
+func newStackSubmitCommand(ctx *CommandContext) *cobra.Command {
+	opts := &StackSubmitOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "submit",
+		Short: "Push the stack and open a PR for each branch",
+		Long: `Pushes every branch of the current stack and opens a PR for each branch that doesn't have one, against its parent. PRs whose base isn't their parent are retargeted.
+
+New PRs are titled after their first commit. Every PR body gets a table linking
+the PRs of the stack, which is kept up to date by submit, restack, and land.
+Use 'vibe pr-update' to fill in descriptions.
+
+Examples:
+  vibe stack submit            # Confirm, then push and open PRs
+  vibe stack submit --draft    # Open new PRs as drafts
+  vibe stack submit --yes      # Skip the confirmation`,
+		Args: cobra.NoArgs,
+		RunE: func(cobraCmd *cobra.Command, _ []string) error {
+			ctx = getCommandContext(cobraCmd, ctx)
+			return runStackSubmit(ctx, opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Draft, "draft", false, "Open new PRs as drafts")
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Skip confirmation prompts")
+
+	return cmd
+}
+
+func runStackSubmit(ctx *CommandContext, opts *StackSubmitOptions) error {
+	current, err := ctx.GitRepo.CurrentBranch()
+	if err != nil {
+		return fmt.Errorf("failed to get current branch: %w", err)
+	}
+
+	stack, err := ctx.GitRepo.LoadStack()
+	if err != nil {
+		return err
+	}
+	branches := stack.Branches(current)
+	if len(branches) == 0 {
+		return fmt.Errorf("%s isn't part of a stack (use 'vibe stack create' or 'vibe stack track')", current)
+	}
+
+	displayStack(ctx, stack, current)
+	for _, branch := range branches {
+		if !isAncestor(parentRef(stack, branch.Parent), branch.Name) {
+			yellow := color.New(color.FgYellow)
+			_, _ = yellow.Printf("%s is behind %s. Consider 'vibe stack restack' first.\n\n", branch.Name, branch.Parent)
+			break
+		}
+	}
+
+	if !opts.Yes {
+		message := fmt.Sprintf("Push %d branches and open missing PRs?", len(branches))
+		if len(branches) == 1 {
+			message = fmt.Sprintf("Push %s and open its PR?", branches[0].Name)
+		}
+
+		var confirmed bool
+		prompt := &survey.Confirm{
+			Message: message,
+			Default: true,
+		}
+		if err := survey.AskOne(prompt, &confirmed); err != nil {
+			return err
+		}
+		if !confirmed {
+			return nil
+		}
+	}
+
+	for _, branch := range branches {
+		if err := submitStackBranch(ctx, branch, opts.Draft); err != nil {
+			return err
+		}
+	}
+
+	return updateStackNavigation(ctx, stack, current)
+}
+
+// submitStackBranch pushes a branch and opens its PR against its parent, or
+// retargets its PR when the base isn't the parent
+func submitStackBranch(ctx *CommandContext, branch *git.StackBranch, draft bool) error {
+	green := color.New(color.FgGreen)
+	dim := color.New(color.Faint)
+
+	if err := pushStackBranch(branch.Name); err != nil {
+		return err
+	}
+
+	cmdCtx := context.Background()
+	var pr *models.PullRequest
+	var err error
+	if branch.PR > 0 {
+		pr, err = ctx.GitHubClient.GetPR(cmdCtx, branch.PR)
+	} else {
+		pr, err = ctx.GitHubClient.GetPRForBranch(cmdCtx, branch.Name)
+	}
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case pr != nil && isPRMerged(pr.Merged, pr.State):
+		_, _ = dim.Printf("  %s for %s was merged. Run 'vibe stack restack' to drop it from the stack.\n", prReference(ctx, pr.Number), branch.Name)
+
+	case pr == nil || pr.State != "open":
+		s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+		s.Suffix = fmt.Sprintf(" Opening PR for %s...", branch.Name)
+		s.Start()
+		pr, err = ctx.GitHubClient.CreatePR(cmdCtx, &models.PRCreateRequest{
+			Title: stackPRTitle(branch),
+			Head:  branch.Name,
+			Base:  branch.Parent,
+			Draft: draft,
+		})
+		s.Stop()
+		if err != nil {
+			return fmt.Errorf("failed to open PR for %s: %w", branch.Name, err)
+		}
+		_, _ = green.Printf("✓ Opened %s for %s → %s\n", prReference(ctx, pr.Number), branch.Name, branch.Parent)
+		_, _ = dim.Printf("  %s\n", pr.URL)
+
+	case pr.Base.Ref != branch.Parent:
+		if err := retargetStackPR(ctx, pr.Number, branch.Parent); err != nil {
+			return err
+		}
+		_, _ = green.Printf("✓ Retargeted %s for %s to %s\n", prReference(ctx, pr.Number), branch.Name, branch.Parent)
+
+	default:
+		_, _ = dim.Printf("  %s for %s is up to date\n", prReference(ctx, pr.Number), branch.Name)
+	}
+
+	if branch.PR != pr.Number {
+		branch.PR = pr.Number
+		return ctx.GitRepo.SaveStackBranch(branch)
+	}
+	return nil
+}
+
+// pushStackBranch pushes a branch when origin's copy differs. Restacking
+// rewrites branches, so the push is forced, but only over the commit vibe
+// last saw on origin.
+func pushStackBranch(branch string) error {
+	local, err := revParse(branch)
+	if err != nil {
+		return err
+	}
+	if remote, err := revParse("origin/" + branch); err == nil && remote == local {
+		return nil
+	}
+
+	if _, err := runGit("push", "--force-with-lease", "-u", "origin", branch); err != nil {
+		return fmt.Errorf("failed to push %s: %w", branch, err)
+	}
+
+	green := color.New(color.FgGreen)
+	_, _ = green.Printf("✓ Pushed %s\n", branch)
+	return nil
+}
+
+// retargetStackPR changes the base branch of a PR
+func retargetStackPR(ctx *CommandContext, prNumber int, base string) error {
+	_, err := ctx.GitHubClient.UpdatePR(context.Background(), prNumber, &models.PRUpdateRequest{Base: &base})
+	if err != nil {
+		return fmt.Errorf("failed to retarget %s to %s: %w", prReference(ctx, prNumber), base, err)
+	}
+	return nil
+}
+
+// stackPRTitle titles a new PR after the first commit of its branch
+func stackPRTitle(branch *git.StackBranch) string {
+	subjects, err := runGit("log", "--reverse", "--format=%s", branch.Parent+".."+branch.Name)
+	if title, _, _ := strings.Cut(subjects, "\n"); err == nil && title != "" {
+		return title
+	}
+	return branch.Name
+}
+
+// updateStackNavigation writes the navigation table of a stack into the body
+// of each of its PRs. Stacks with a single PR have nothing to navigate, so
+// their table is removed.
+func updateStackNavigation(ctx *CommandContext, stack git.Stack, branch string) error {
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	s.Suffix = " Updating stack tables..."
+	s.Start()
+	defer s.Stop()
+
+	cmdCtx := context.Background()
+	var prs []*models.PullRequest
+	for _, stacked := range stack.Branches(branch) {
+		if stacked.PR == 0 {
+			continue
+		}
+		pr, err := ctx.GitHubClient.GetPR(cmdCtx, stacked.PR)
+		if err != nil {
+			return err
+		}
+		prs = append(prs, pr)
+	}
+
+	for _, pr := range prs {
+		section := ""
+		if len(prs) > 1 {
+			section = stackNavigationTable(ctx, stack.Bottom(branch), prs, pr.Number)
+		}
+
+		body := replaceStackSection(pr.Body, section)
+		if body == pr.Body {
+			continue
+		}
+		if _, err := ctx.GitHubClient.UpdatePR(cmdCtx, pr.Number, &models.PRUpdateRequest{Body: &body}); err != nil {
+			return fmt.Errorf("failed to update the stack table of %s: %w", prReference(ctx, pr.Number), err)
+		}
+	}
+
+	return nil
+}
+
+// stackNavigationTable renders the PRs of a stack in merge order, pointing
+// at the PR the table is for
+func stackNavigationTable(ctx *CommandContext, bottom string, prs []*models.PullRequest, current int) string {
+	var sb strings.Builder
+
+	sb.WriteString(stackSectionStart + "\n")
+	sb.WriteString("### Stack\n\n")
+	fmt.Fprintf(&sb, "Merge from the top, into `%s`.\n\n", bottom)
+	sb.WriteString("| | PR | Title |\n")
+	sb.WriteString("|---|---|---|\n")
+	for _, pr := range prs {
+		pointer := ""
+		if pr.Number == current {
+			pointer = "👉"
+		}
+		title := strings.ReplaceAll(pr.Title, "|", `\|`)
+		if pr.Merged {
+			title += " (merged)"
+		}
+		fmt.Fprintf(&sb, "| %s | %s | %s |\n", pointer, prReference(ctx, pr.Number), title)
+	}
+	sb.WriteString("\n_Managed by `vibe stack`_\n")
+	sb.WriteString(stackSectionEnd)
+
+	return sb.String()
+}
+
+// replaceStackSection replaces the stack section of a PR body, appending it
+// when the body has none and removing it when section is empty
+func replaceStackSection(body, section string) string {
+	start := strings.Index(body, stackSectionStart)
+	end := strings.Index(body, stackSectionEnd)
+
+	if start >= 0 && end > start {
+		before := strings.TrimRight(body[:start], "\n")
+		after := strings.TrimLeft(body[end+len(stackSectionEnd):], "\n")
+		body = before
+		if after != "" {
+			body = strings.TrimRight(body+"\n\n"+after, "\n")
+		}
+	}
+
+	switch {
+	case section == "":
+		return body
+	case strings.TrimSpace(body) == "":
+		return section
+	default:
+		return strings.TrimRight(body, "\n") + "\n\n" + section
+	}
+}
@@ -0,0 +1,295 @@
+package commands
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/rithyhuot/vibe/internal/services/git"
+	"github.com/rithyhuot/vibe/internal/utils"
+)
+
+// StackTrackOptions holds flags for the stack track command
+type StackTrackOptions struct {
+	Parent string
+}
+
+// NewStackCommand creates the stack command, grouping the stacked PR workflow
+func NewStackCommand(ctx *CommandContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stack",
+		Short: "Work with stacks of dependent branches and PRs",
+		Long: `Splits work into dependent branches, each with a PR against the branch below it.
+
+The parent of each branch is recorded in the local git config. Without a
+subcommand, shows the stack of the current branch.
+
+Examples:
+  vibe stack create feat/api-client   # Branch off the current branch
+  vibe stack track --parent feat/api  # Stack an existing branch
+  vibe stack submit                   # Push and open a PR for each branch
+  vibe stack restack --push           # Rebase the stack after a parent changed or merged
+  vibe stack land                     # Merge the bottom PR and restack the rest`,
+		Args: cobra.NoArgs,
+		RunE: func(cobraCmd *cobra.Command, _ []string) error {
+			ctx = getCommandContext(cobraCmd, ctx)
+			return runStackShow(ctx)
+		},
+	}
+
+	cmd.AddCommand(
+		newStackCreateCommand(ctx),
+		newStackTrackCommand(ctx),
+		newStackSubmitCommand(ctx),
+		newStackRestackCommand(ctx),
+		newStackLandCommand(ctx),
+	)
+
+	return cmd
+}
+
+// newStackCreateCommand creates the stack create command
+func newStackCreateCommand(ctx *CommandContext) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create <branch>",
+		Short: "Create a branch stacked on the current branch",
+		Long: `Creates a branch from the current branch, checks it out, and records the current branch as its parent. Uncommitted changes come along.
+
+Examples:
+  vibe stack create feat/api-client`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cobraCmd *cobra.Command, args []string) error {
+			ctx = getCommandContext(cobraCmd, ctx)
+			return runStackCreate(ctx, args[0])
+		},
+	}
+}
+
+// newStackTrackCommand creates the stack track command
+func newStackTrackCommand(ctx *CommandContext) *cobra.Command {
+	opts := &StackTrackOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "track",
+		Short: "Stack the current branch on a parent branch",
+		Long: `Records the parent of an existing branch, adding it to a stack or moving it to another parent. The next restack rebases it onto the parent.
+
+Examples:
+  vibe stack track                     # Stack on the base branch (git.base_branch)
+  vibe stack track --parent feat/api   # Stack on feat/api`,
+		Args: cobra.NoArgs,
+		RunE: func(cobraCmd *cobra.Command, _ []string) error {
+			ctx = getCommandContext(cobraCmd, ctx)
+			return runStackTrack(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Parent, "parent", "", "Parent branch (default: git.base_branch)")
+
+	return cmd
+}
+
+func runStackShow(ctx *CommandContext) error {
+	current, err := ctx.GitRepo.CurrentBranch()
+	if err != nil {
+		return fmt.Errorf("failed to get current branch: %w", err)
+	}
+
+	stack, err := ctx.GitRepo.LoadStack()
+	if err != nil {
+		return err
+	}
+
+	if stack[current] == nil && len(stack.Children(current)) == 0 {
+		dim := color.New(color.Faint)
+		_, _ = dim.Printf("%s isn't part of a stack. Use 'vibe stack create <branch>' to start one.\n", current)
+		return nil
+	}
+
+	displayStack(ctx, stack, current)
+	return nil
+}
+
+func runStackCreate(ctx *CommandContext, name string) error {
+	if err := utils.ValidateBranchName(name); err != nil {
+		return err
+	}
+
+	parent, err := ctx.GitRepo.CurrentBranch()
+	if err != nil {
+		return fmt.Errorf("failed to get current branch: %w", err)
+	}
+
+	exists, err := ctx.GitRepo.BranchExists(name)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return fmt.Errorf("branch %s already exists (use 'vibe stack track' to stack it)", name)
+	}
+
+	base, err := revParse(parent)
+	if err != nil {
+		return err
+	}
+
+	// git checkout carries uncommitted changes over to the new branch
+	if _, err := runGit("checkout", "-b", name); err != nil {
+		return err
+	}
+
+	if err := ctx.GitRepo.SaveStackBranch(&git.StackBranch{Name: name, Parent: parent, Base: base}); err != nil {
+		return err
+	}
+
+	green := color.New(color.FgGreen)
+	_, _ = green.Printf("✓ Created %s on top of %s\n", name, parent)
+	return nil
+}
+
+func runStackTrack(ctx *CommandContext, opts *StackTrackOptions) error {
+	branch, err := ctx.GitRepo.CurrentBranch()
+	if err != nil {
+		return fmt.Errorf("failed to get current branch: %w", err)
+	}
+
+	parent := determineBaseBranch(ctx, opts.Parent)
+	if parent == branch {
+		return fmt.Errorf("%s can't be stacked on itself", branch)
+	}
+
+	stack, err := ctx.GitRepo.LoadStack()
+	if err != nil {
+		return err
+	}
+
+	// Stacking a branch on one of its descendants would make a cycle
+	if stack.IsStackedOn(parent, branch) {
+		return fmt.Errorf("%s is stacked on %s, so %s can't be stacked on it", parent, branch, branch)
+	}
+
+	base, err := runGit("merge-base", parent, branch)
+	if err != nil {
+		return fmt.Errorf("%s and %s have no common history: %w", parent, branch, err)
+	}
+
+	tracked := &git.StackBranch{Name: branch, Parent: parent, Base: base}
+	if existing := stack[branch]; existing != nil {
+		tracked.PR = existing.PR
+	}
+	if tracked.PR == 0 {
+		if pr, _ := getPRForCurrentBranch(ctx); pr != nil {
+			tracked.PR = pr.Number
+		}
+	}
+
+	if err := ctx.GitRepo.SaveStackBranch(tracked); err != nil {
+		return err
+	}
+
+	green := color.New(color.FgGreen)
+	_, _ = green.Printf("✓ Stacked %s on %s\n", branch, parent)
+	if !isAncestor(parent, branch) {
+		dim := color.New(color.Faint)
+		_, _ = dim.Println("  Run 'vibe stack restack' to rebase it onto its parent")
+	}
+	return nil
+}
+
+// stackParent returns the parent of a stacked branch, or empty when it isn't stacked
+func stackParent(ctx *CommandContext, branch string) string {
+	stack, err := ctx.GitRepo.LoadStack()
+	if err != nil || stack[branch] == nil {
+		return ""
+	}
+	return stack[branch].Parent
+}
+
+// parentRef returns the ref a stacked branch is rebased onto: its parent, or
+// origin's copy of the bottom branch, which is where stacked PRs are merged
+func parentRef(stack git.Stack, parent string) string {
+	if stack[parent] == nil && refExists("origin/"+parent) {
+		return "origin/" + parent
+	}
+	return parent
+}
+
+// prReference formats a PR number the way the forge links it: #12 on GitHub
+// and !12 for GitLab merge requests
+func prReference(ctx *CommandContext, number int) string {
+	if ctx.GitLabClient != nil {
+		return fmt.Sprintf("!%d", number)
+	}
+	return fmt.Sprintf("#%d", number)
+}
+
+// displayStack shows the stack a branch is in as a tree, marking the branch
+// and the branches that need a restack
+func displayStack(ctx *CommandContext, stack git.Stack, current string) {
+	bold := color.New(color.Bold)
+	cyan := color.New(color.FgCyan)
+	dim := color.New(color.Faint)
+	yellow := color.New(color.FgYellow)
+
+	bottom := stack.Bottom(current)
+
+	fmt.Println()
+	_, _ = dim.Println(bottom)
+
+	var walk func(parent string, depth int)
+	walk = func(parent string, depth int) {
+		for _, branch := range stack.Children(parent) {
+			indent := strings.Repeat("  ", depth)
+			marker := "○"
+			if branch.Name == current {
+				marker = "●"
+			}
+
+			fmt.Printf("%s└─ %s ", indent, marker)
+			if branch.Name == current {
+				_, _ = bold.Print(branch.Name)
+			} else {
+				fmt.Print(branch.Name)
+			}
+			if branch.PR > 0 {
+				_, _ = cyan.Printf(" %s", prReference(ctx, branch.PR))
+			}
+			if !isAncestor(parentRef(stack, branch.Parent), branch.Name) {
+				_, _ = yellow.Print(" (needs restack)")
+			}
+			fmt.Println()
+
+			walk(branch.Name, depth+1)
+		}
+	}
+	walk(bottom, 0)
+	fmt.Println()
+}
+
+// runGit runs a git command, returning its trimmed output
+func runGit(args ...string) (string, error) {
+	output, err := exec.Command("git", args...).CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("git %s failed: %w\nOutput: %s", strings.Join(args, " "), err, strings.TrimSpace(string(output)))
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+// revParse returns the commit a ref points to
+func revParse(ref string) (string, error) {
+	return runGit("rev-parse", "--verify", ref+"^{commit}")
+}
+
+// refExists reports whether a ref points to a commit
+func refExists(ref string) bool {
+	_, err := revParse(ref)
+	return err == nil
+}
+
+// isAncestor reports whether ancestor is reachable from ref
+func isAncestor(ancestor, ref string) bool {
+	return exec.Command("git", "merge-base", "--is-ancestor", ancestor, ref).Run() == nil
+}
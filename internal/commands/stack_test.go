@@ -0,0 +1,47 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPRMerged(t *testing.T) {
+	assert.True(t, isPRMerged(true, "closed"))
+	assert.True(t, isPRMerged(false, "merged"))
+	assert.False(t, isPRMerged(false, "closed"))
+	assert.False(t, isPRMerged(false, "open"))
+}
+
+func TestReplaceStackSection(t *testing.T) {
+	section := stackSectionStart + "\n### Stack\n" + stackSectionEnd
+
+	tests := []struct {
+		name     string
+		body     string
+		section  string
+		expected string
+	}{
+		{name: "empty body", body: "", section: section, expected: section},
+		{name: "appended", body: "## Summary\n\nFixes it.\n", section: section, expected: "## Summary\n\nFixes it.\n\n" + section},
+		{
+			name:     "replaced",
+			body:     "Intro\n\n" + stackSectionStart + "\nold\n" + stackSectionEnd + "\n\nOutro",
+			section:  section,
+			expected: "Intro\n\nOutro\n\n" + section,
+		},
+		{
+			name:     "removed",
+			body:     "Intro\n\n" + stackSectionStart + "\nold\n" + stackSectionEnd,
+			section:  "",
+			expected: "Intro",
+		},
+		{name: "nothing to remove", body: "Intro", section: "", expected: "Intro"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, replaceStackSection(tt.body, tt.section))
+		})
+	}
+}
@@ -39,8 +39,8 @@ Examples:
 
 func runStart(ctx *CommandContext, ticketIDArg string) error {
 	// If ticket ID provided, go straight to existing flow
-	if ticketIDArg != "" && utils.IsTicketID(ticketIDArg) {
-		return startFromExisting(ctx, ticketIDArg)
+	if ticketID := utils.NormalizeTicketID(ticketIDArg); ticketID != "" && isTicketID(ctx, ticketID) {
+		return startFromExisting(ctx, ticketID)
 	}
 
 	// Safety check: warn if not on base branch
@@ -85,9 +85,10 @@ func runStart(ctx *CommandContext, ticketIDArg string) error {
 		return fmt.Errorf("ticket ID or search term required")
 	}
 
-	if utils.IsTicketID(userInput) {
+	// Lower-case keys like node-18 are searched for rather than taken as a key
+	if isTicketID(ctx, userInput) {
 		// Direct ticket ID
-		return startFromExisting(ctx, strings.ToLower(userInput))
+		return startFromExisting(ctx, utils.NormalizeTicketID(userInput))
 	}
 
 	// Search term
@@ -99,9 +100,7 @@ func handleSearchAndSelect(ctx *CommandContext, searchTerm string) error {
 	s.Start()
 
 	cmdCtx := context.Background()
-	// Use team ID from config for searching
-	teamID := ctx.Config.ClickUp.TeamID
-	tasks, err := ctx.ClickUpClient.SearchTeamTasks(cmdCtx, teamID, searchTerm)
+	tasks, err := ctx.Tracker.SearchTickets(cmdCtx, searchTerm)
 	if err != nil {
 		s.Stop()
 		return fmt.Errorf("failed to search tasks: %w", err)
@@ -130,13 +129,7 @@ func handleSearchAndSelect(ctx *CommandContext, searchTerm string) error {
 			title = string(titleRunes[:maxTitleLength-3]) + "..."
 		}
 
-		// Extract short ticket ID from full ID
-		ticketID := task.ID
-		if len(ticketID) > 9 {
-			ticketID = ticketID[len(ticketID)-9:]
-		}
-
-		option := fmt.Sprintf("%s - %s [%s]", ticketID, title, task.Status.Status)
+		option := fmt.Sprintf("%s - %s [%s]", shortTicketID(task.ID), title, task.Status.Status)
 		options[i] = option
 		taskMap[option] = task.ID
 	}
@@ -158,14 +151,17 @@ func handleSearchAndSelect(ctx *CommandContext, searchTerm string) error {
 		return fmt.Errorf("failed to find selected task")
 	}
 
-	// Extract short ticket ID for startFromExisting
-	shortTicketID := selectedTaskID
-	if len(selectedTaskID) > 9 {
-		shortTicketID = selectedTaskID[len(selectedTaskID)-9:]
-	}
-
 	fmt.Println()
-	return startFromExisting(ctx, strings.ToLower(shortTicketID))
+	return startFromExisting(ctx, utils.NormalizeTicketID(shortTicketID(selectedTaskID)))
+}
+
+// shortTicketID returns the short form of a ticket ID: the last 9 characters
+// of a ClickUp ID. Issue keys are already short.
+func shortTicketID(ticketID string) string {
+	if utils.IsIssueKey(ticketID) || len(ticketID) <= 9 {
+		return ticketID
+	}
+	return ticketID[len(ticketID)-9:]
 }
 
 func startFromExisting(ctx *CommandContext, ticketID string) error {
@@ -173,7 +169,7 @@ func startFromExisting(ctx *CommandContext, ticketID string) error {
 	s.Start()
 
 	cmdCtx := context.Background()
-	task, err := ctx.ClickUpClient.GetTask(cmdCtx, ticketID)
+	task, err := ctx.Tracker.GetTicket(cmdCtx, ticketID)
 	if err != nil {
 		s.Stop()
 		return fmt.Errorf("failed to fetch ticket: %w", err)
@@ -189,9 +185,9 @@ func startFromExisting(ctx *CommandContext, ticketID string) error {
 	}
 	var branchName string
 	if username != "" {
-		branchName = utils.GenerateBranchName(prefix, ticketID, task.Name, username)
+		branchName = ticketBranchName(ctx, prefix, ticketID, task.Name, username)
 	} else {
-		branchName = utils.GenerateBranchName(prefix, ticketID, task.Name)
+		branchName = ticketBranchName(ctx, prefix, ticketID, task.Name)
 	}
 
 	// Get current branch
@@ -0,0 +1,233 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	survey "github.com/AlecAivazis/survey/v2"
+	"github.com/briandowns/spinner"
+	"github.com/fatih/color"
+
+	"github.com/rithyhuot/vibe/internal/config"
+	"github.com/rithyhuot/vibe/internal/services/tracker"
+)
+
+// runTrackerTicketCreate creates a ticket through the tracker interface, for
+// trackers other than ClickUp
+func runTrackerTicketCreate(ctx *CommandContext, opts *TicketCreateOptions) error {
+	if opts.ListID != "" || opts.Workspace != "" || opts.Sprint != sprintCurrent || len(opts.Fields) > 0 {
+		return fmt.Errorf("--list, --sprint, --workspace and --field are only supported with ClickUp")
+	}
+
+	var req *tracker.CreateRequest
+	var err error
+	if opts.Yes || opts.Name != "" {
+		req, err = trackerCreateRequestFromFlags(ctx, opts)
+	} else {
+		req, err = promptTrackerCreateRequest(ctx, opts)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !opts.Yes {
+		displayTrackerTicketPreview(ctx, req)
+
+		var shouldCreate bool
+		if err := survey.AskOne(&survey.Confirm{Message: "Create this ticket?", Default: true}, &shouldCreate); err != nil {
+			return err
+		}
+		if !shouldCreate {
+			yellow := color.New(color.FgYellow)
+			_, _ = yellow.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	s.Suffix = " Creating ticket..."
+	s.Start()
+
+	task, err := ctx.Tracker.CreateTicket(context.Background(), req)
+	s.Stop()
+	if err != nil {
+		return fmt.Errorf("failed to create ticket: %w", err)
+	}
+
+	return showCreatedTicket(ctx, task, opts)
+}
+
+// trackerCreateRequestFromFlags builds a create request from flags
+func trackerCreateRequestFromFlags(ctx *CommandContext, opts *TicketCreateOptions) (*tracker.CreateRequest, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf("--name is required in non-interactive mode")
+	}
+
+	// Resolve description from file, flag, or AI
+	description := opts.Description
+	if opts.DescriptionFile != "" {
+		content, err := os.ReadFile(opts.DescriptionFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read description file: %w", err)
+		}
+		description = string(content)
+	}
+	if description == "" && opts.AI {
+		description = generateTicketDescription(context.Background(), ctx, opts.Name)
+	}
+
+	dueDate, err := parseTrackerDueDate(opts.DueDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tracker.CreateRequest{
+		Container:   opts.Project,
+		Name:        opts.Name,
+		Description: strings.TrimSpace(description),
+		Labels:      opts.Tags,
+		Priority:    opts.Priority,
+		Assignees:   opts.Assignees,
+		DueDate:     dueDate,
+	}, nil
+}
+
+// promptTrackerCreateRequest prompts for the fields of a new ticket
+func promptTrackerCreateRequest(ctx *CommandContext, opts *TicketCreateOptions) (*tracker.CreateRequest, error) {
+	bold := color.New(color.Bold)
+
+	fmt.Println()
+	_, _ = bold.Printf("Create New %s Ticket\n", ctx.Tracker.Name())
+	fmt.Println()
+
+	req := &tracker.CreateRequest{Container: opts.Project}
+
+	// Prompt for title
+	if err := survey.AskOne(&survey.Input{Message: "Ticket title:"}, &req.Name, survey.WithValidator(survey.Required)); err != nil {
+		return nil, err
+	}
+
+	// Prompt for description, optionally drafted by AI
+	draft := ""
+	if ctx.ClaudeClient != nil {
+		useAI := opts.AI || ctx.Config.AI.GenerateDescriptions
+		if !opts.AI {
+			if err := survey.AskOne(&survey.Confirm{Message: "Draft the description with AI?", Default: useAI}, &useAI); err != nil {
+				return nil, err
+			}
+		}
+		if useAI {
+			draft = generateTicketDescription(context.Background(), ctx, req.Name)
+		}
+	}
+
+	var description string
+	descriptionPrompt := &survey.Multiline{
+		Message: "Description (press Ctrl+D or Ctrl+Z when done):",
+		Default: draft,
+	}
+	if err := survey.AskOne(descriptionPrompt, &description); err != nil {
+		return nil, err
+	}
+	req.Description = strings.TrimSpace(description)
+
+	// Prompt for assignee
+	var assignMe bool
+	if err := survey.AskOne(&survey.Confirm{Message: "Assign to yourself?", Default: true}, &assignMe); err != nil {
+		return nil, err
+	}
+	if assignMe {
+		req.Assignees = []string{"me"}
+	}
+
+	// Prompt for labels
+	var labelsInput string
+	if err := survey.AskOne(&survey.Input{Message: "Labels (comma-separated, optional):"}, &labelsInput); err != nil {
+		return nil, err
+	}
+	req.Labels = parseCommaSeparated(labelsInput)
+
+	// Prompt for priority
+	var priority string
+	priorityPrompt := &survey.Select{
+		Message: "Priority:",
+		Options: []string{"none", "urgent", "high", "normal", "low"},
+		Default: "none",
+	}
+	if err := survey.AskOne(priorityPrompt, &priority); err != nil {
+		return nil, err
+	}
+	if priority != "none" {
+		req.Priority = priority
+	}
+
+	// Prompt for due date
+	var dueInput string
+	duePrompt := &survey.Input{Message: "Due date (YYYY-MM-DD, optional):"}
+	dueValidator := func(val interface{}) error {
+		_, err := parseDueDate(val.(string))
+		return err
+	}
+	if err := survey.AskOne(duePrompt, &dueInput, survey.WithValidator(dueValidator)); err != nil {
+		return nil, err
+	}
+	req.DueDate, _ = parseTrackerDueDate(dueInput)
+
+	return req, nil
+}
+
+// parseTrackerDueDate parses a YYYY-MM-DD date. An empty string returns nil.
+func parseTrackerDueDate(input string) (*time.Time, error) {
+	ms, err := parseDueDate(input)
+	if err != nil || ms == nil {
+		return nil, err
+	}
+	due := time.UnixMilli(*ms)
+	return &due, nil
+}
+
+// displayTrackerTicketPreview shows a formatted preview of the ticket to be created
+func displayTrackerTicketPreview(ctx *CommandContext, req *tracker.CreateRequest) {
+	bold := color.New(color.Bold)
+	cyan := color.New(color.FgCyan)
+
+	fmt.Println()
+	_, _ = bold.Println("Preview:")
+	fmt.Println()
+	fmt.Printf("  Title: %s\n", cyan.Sprint(req.Name))
+
+	project, label := req.Container, "Project"
+	if ctx.Config.Tracker.Provider == config.TrackerLinear {
+		label = "Team"
+		if project == "" {
+			project = ctx.Config.Linear.TeamKey
+		}
+	} else if project == "" {
+		project = ctx.Config.Jira.ProjectKey
+	}
+	if project != "" {
+		fmt.Printf("  %s: %s\n", label, strings.ToUpper(project))
+	}
+	if len(req.Assignees) > 0 {
+		fmt.Printf("  Assignees: %s\n", strings.Join(req.Assignees, ", "))
+	}
+	if len(req.Labels) > 0 {
+		fmt.Printf("  Labels: %s\n", strings.Join(req.Labels, ", "))
+	}
+	if req.Priority != "" {
+		fmt.Printf("  Priority: %s\n", req.Priority)
+	}
+	if req.DueDate != nil {
+		fmt.Printf("  Due: %s\n", req.DueDate.Format("2006-01-02"))
+	}
+	fmt.Println()
+
+	if req.Description != "" {
+		fmt.Println("  Description:")
+		fmt.Println(indentText(req.Description, "    "))
+		fmt.Println()
+	}
+}
@@ -0,0 +1,709 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	survey "github.com/AlecAivazis/survey/v2"
+	"github.com/briandowns/spinner"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/rithyhuot/vibe/internal/models"
+	"github.com/rithyhuot/vibe/internal/services/clickup"
+	"github.com/rithyhuot/vibe/internal/utils"
+)
+
+const (
+	sprintCurrent = "current"
+	sprintNext    = "next"
+
+	// Option shown in the list selection prompt for entering a list ID by hand
+	listOptionManual = "Enter a list ID..."
+)
+
+// TicketCreateOptions holds flags for the ticket create command
+type TicketCreateOptions struct {
+	Name            string
+	Description     string
+	DescriptionFile string
+	ListID          string
+	Project         string
+	Sprint          string
+	Workspace       string
+	Assignees       []string
+	Tags            []string
+	Priority        string
+	DueDate         string
+	Fields          []string
+	AI              bool
+	WorkOn          bool
+	Yes             bool
+}
+
+// sprintListChoice is a sprint list offered in the list selection prompt
+type sprintListChoice struct {
+	Label  string
+	ListID string
+}
+
+// NewTicketCreateCommand creates the ticket create command
+func NewTicketCreateCommand(ctx *CommandContext) *cobra.Command {
+	opts := &TicketCreateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a new ticket",
+		Long: `Create a new ClickUp ticket in a sprint or list, or a Jira or Linear issue
+in a project or team.
+
+Without flags, you'll be prompted for the title, sprint, description, assignees,
+tags, priority, due date, and custom fields. With --name, the ticket is created
+from flags; the current sprint of the first workspace is used unless --list or
+--sprint is given.
+
+Use "me" as an assignee to assign the ticket to yourself (clickup.user_id).
+Custom fields are set by name with --field "Name=value". For drop-down fields
+the value is the option name.
+
+With Jira (tracker.provider: jira), the issue is created in jira.project_key
+unless --project is given. With Linear, --project is the team key and defaults
+to linear.team_key. Sprints, lists and custom fields are ClickUp-only.
+
+Examples:
+  vibe ticket create                                        # Interactive mode
+  vibe ticket create --name "Fix login bug" --assignee me   # Create in current sprint
+  vibe ticket create -n "Add export" --sprint next --priority high --tag backend
+  vibe ticket create -n "Add export" --field "Type=Feature" --due 2026-03-01
+  vibe ticket create -n "Add export" --ai --workon --yes    # AI description, then start work
+  vibe ticket create -n "Add export" --project ABC          # Create a Jira issue in project ABC`,
+		Args: cobra.NoArgs,
+		RunE: func(cobraCmd *cobra.Command, _ []string) error {
+			ctx = getCommandContext(cobraCmd, ctx)
+			return runTicketCreate(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Name, "name", "n", "", "Ticket title")
+	cmd.Flags().StringVarP(&opts.Description, "description", "d", "", "Ticket description (markdown)")
+	cmd.Flags().StringVar(&opts.DescriptionFile, "description-file", "", "Read ticket description from file")
+	cmd.Flags().StringVar(&opts.ListID, "list", "", "ClickUp list ID to create the ticket in")
+	cmd.Flags().StringVar(&opts.Project, "project", "", "Jira project key or Linear team key to create the ticket in (default: jira.project_key or linear.team_key)")
+	cmd.Flags().StringVar(&opts.Sprint, "sprint", sprintCurrent, "Sprint to create the ticket in (current, next)")
+	cmd.Flags().StringVar(&opts.Workspace, "workspace", "", "Workspace name to pick the sprint from (default: first workspace)")
+	cmd.Flags().StringSliceVar(&opts.Assignees, "assignee", []string{}, "Assignee user IDs or \"me\" (comma-separated)")
+	cmd.Flags().StringSliceVar(&opts.Tags, "tag", []string{}, "Tags (comma-separated)")
+	cmd.Flags().StringVar(&opts.Priority, "priority", "", "Priority (urgent, high, normal, low)")
+	cmd.Flags().StringVar(&opts.DueDate, "due", "", "Due date (YYYY-MM-DD)")
+	cmd.Flags().StringArrayVar(&opts.Fields, "field", []string{}, "Custom field as Name=value (repeatable)")
+	cmd.Flags().BoolVar(&opts.AI, "ai", false, "Draft the description and acceptance criteria with AI")
+	cmd.Flags().BoolVar(&opts.WorkOn, "workon", false, "Start working on the ticket after creating it")
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Skip confirmation prompts")
+
+	return cmd
+}
+
+func runTicketCreate(ctx *CommandContext, opts *TicketCreateOptions) error {
+	if opts.Sprint != sprintCurrent && opts.Sprint != sprintNext {
+		return fmt.Errorf("invalid sprint: %s (must be one of: current, next)", opts.Sprint)
+	}
+	// Interactive mode would otherwise skip the AI draft without a word
+	if opts.AI && ctx.ClaudeClient == nil {
+		return fmt.Errorf("--ai requires AI to be enabled\n\nSet ai.enabled: true and configure claude.api_key or install the claude CLI")
+	}
+
+	// Sprints, lists and custom fields are ClickUp-only
+	if ctx.ClickUpClient == nil {
+		return runTrackerTicketCreate(ctx, opts)
+	}
+
+	if opts.Yes || opts.Name != "" {
+		return createTicketNonInteractive(ctx, opts)
+	}
+
+	return createTicketInteractive(ctx, opts)
+}
+
+//nolint:gocyclo // Interactive prompts require sequential steps
+func createTicketInteractive(ctx *CommandContext, opts *TicketCreateOptions) error {
+	cmdCtx := context.Background()
+	bold := color.New(color.Bold)
+
+	fmt.Println()
+	_, _ = bold.Println("Create New Ticket")
+	fmt.Println()
+
+	// Prompt for title
+	var name string
+	if err := survey.AskOne(&survey.Input{Message: "Ticket title:"}, &name, survey.WithValidator(survey.Required)); err != nil {
+		return err
+	}
+
+	// Prompt for sprint/list
+	listID, err := promptTicketList(cmdCtx, ctx)
+	if err != nil {
+		return err
+	}
+
+	// Prompt for description, optionally drafted by AI
+	draft := ""
+	if ctx.ClaudeClient != nil {
+		useAI := opts.AI || ctx.Config.AI.GenerateDescriptions
+		if !opts.AI {
+			if err := survey.AskOne(&survey.Confirm{Message: "Draft the description with AI?", Default: useAI}, &useAI); err != nil {
+				return err
+			}
+		}
+		if useAI {
+			draft = generateTicketDescription(cmdCtx, ctx, name)
+		}
+	}
+
+	var description string
+	descriptionPrompt := &survey.Multiline{
+		Message: "Description (press Ctrl+D or Ctrl+Z when done):",
+		Default: draft,
+	}
+	if err := survey.AskOne(descriptionPrompt, &description); err != nil {
+		return err
+	}
+
+	req := &models.TaskCreateRequest{
+		Name:                name,
+		MarkdownDescription: strings.TrimSpace(description),
+	}
+
+	// Prompt for assignees from the list members
+	assignees, err := promptTicketAssignees(cmdCtx, ctx, listID)
+	if err != nil {
+		return err
+	}
+	req.Assignees = assignees
+
+	// Prompt for tags
+	var tagsInput string
+	if err := survey.AskOne(&survey.Input{Message: "Tags (comma-separated, optional):"}, &tagsInput); err != nil {
+		return err
+	}
+	req.Tags = parseCommaSeparated(tagsInput)
+
+	// Prompt for priority
+	var priority string
+	priorityPrompt := &survey.Select{
+		Message: "Priority:",
+		Options: []string{"none", "urgent", "high", "normal", "low"},
+		Default: "none",
+	}
+	if err := survey.AskOne(priorityPrompt, &priority); err != nil {
+		return err
+	}
+	req.Priority = clickup.Priorities[priority]
+
+	// Prompt for due date
+	var dueInput string
+	duePrompt := &survey.Input{Message: "Due date (YYYY-MM-DD, optional):"}
+	dueValidator := func(val interface{}) error {
+		_, err := parseDueDate(val.(string))
+		return err
+	}
+	if err := survey.AskOne(duePrompt, &dueInput, survey.WithValidator(dueValidator)); err != nil {
+		return err
+	}
+	req.DueDate, _ = parseDueDate(dueInput)
+
+	// Prompt for custom fields
+	fields, err := promptTicketCustomFields(cmdCtx, ctx, listID)
+	if err != nil {
+		return err
+	}
+	req.CustomFields = fields
+
+	return confirmAndCreateTicket(ctx, listID, req, opts)
+}
+
+//nolint:gocyclo // Each flag needs its own validation
+func createTicketNonInteractive(ctx *CommandContext, opts *TicketCreateOptions) error {
+	cmdCtx := context.Background()
+
+	if opts.Name == "" {
+		return fmt.Errorf("--name is required in non-interactive mode")
+	}
+
+	// Resolve list
+	listID := opts.ListID
+	if listID == "" {
+		var err error
+		listID, err = resolveSprintListID(cmdCtx, ctx, opts.Workspace, opts.Sprint)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Resolve description from file, flag, or AI
+	description := opts.Description
+	if opts.DescriptionFile != "" {
+		content, err := os.ReadFile(opts.DescriptionFile)
+		if err != nil {
+			return fmt.Errorf("failed to read description file: %w", err)
+		}
+		description = string(content)
+	}
+	if description == "" && opts.AI {
+		description = generateTicketDescription(cmdCtx, ctx, opts.Name)
+	}
+
+	req := &models.TaskCreateRequest{
+		Name:                opts.Name,
+		MarkdownDescription: strings.TrimSpace(description),
+		Tags:                opts.Tags,
+	}
+
+	// Resolve assignees
+	for _, assignee := range opts.Assignees {
+		if assignee == "me" {
+			assignee = ctx.Config.ClickUp.UserID
+		}
+		id, err := strconv.Atoi(assignee)
+		if err != nil {
+			return fmt.Errorf("invalid assignee: %s (must be a ClickUp user ID or \"me\")", assignee)
+		}
+		req.Assignees = append(req.Assignees, id)
+	}
+
+	// Resolve priority
+	if opts.Priority != "" {
+		priority, ok := clickup.Priorities[strings.ToLower(opts.Priority)]
+		if !ok {
+			return fmt.Errorf("invalid priority: %s (must be one of: urgent, high, normal, low)", opts.Priority)
+		}
+		req.Priority = priority
+	}
+
+	// Resolve due date
+	dueDate, err := parseDueDate(opts.DueDate)
+	if err != nil {
+		return err
+	}
+	req.DueDate = dueDate
+
+	// Resolve custom fields by name
+	if len(opts.Fields) > 0 {
+		definitions, err := ctx.ClickUpClient.GetListCustomFields(cmdCtx, listID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch custom fields: %w", err)
+		}
+		for _, field := range opts.Fields {
+			value, err := resolveCustomFieldFlag(definitions, field)
+			if err != nil {
+				return err
+			}
+			req.CustomFields = append(req.CustomFields, value)
+		}
+	}
+
+	return confirmAndCreateTicket(ctx, listID, req, opts)
+}
+
+// confirmAndCreateTicket previews the ticket, creates it, and optionally starts work on it
+func confirmAndCreateTicket(ctx *CommandContext, listID string, req *models.TaskCreateRequest, opts *TicketCreateOptions) error {
+	if !opts.Yes {
+		displayTicketCreatePreview(req)
+
+		var shouldCreate bool
+		if err := survey.AskOne(&survey.Confirm{Message: "Create this ticket?", Default: true}, &shouldCreate); err != nil {
+			return err
+		}
+		if !shouldCreate {
+			yellow := color.New(color.FgYellow)
+			_, _ = yellow.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	s.Suffix = " Creating ticket..."
+	s.Start()
+
+	task, err := ctx.ClickUpClient.CreateTask(context.Background(), listID, req)
+	s.Stop()
+	if err != nil {
+		return fmt.Errorf("failed to create ticket: %w", err)
+	}
+
+	return showCreatedTicket(ctx, task, opts)
+}
+
+// showCreatedTicket shows the new ticket and optionally starts work on it
+func showCreatedTicket(ctx *CommandContext, task *models.Task, opts *TicketCreateOptions) error {
+	green := color.New(color.FgGreen, color.Bold)
+	dim := color.New(color.Faint)
+
+	fmt.Println()
+	_, _ = green.Println("✓ Ticket created successfully!")
+	fmt.Println()
+	fmt.Printf("  %s: %s\n", task.ID, task.Name)
+	_, _ = dim.Println("  " + task.URL)
+	fmt.Println()
+
+	// Offer to start working on the new ticket
+	workOn := opts.WorkOn
+	if !workOn && !opts.Yes {
+		if err := survey.AskOne(&survey.Confirm{Message: "Start working on this ticket now?", Default: false}, &workOn); err != nil {
+			return err
+		}
+	}
+	if workOn {
+		return runVibe(ctx, task.ID)
+	}
+
+	return nil
+}
+
+// generateTicketDescription drafts a description with AI, returning an empty
+// string (with a warning) if generation fails
+func generateTicketDescription(cmdCtx context.Context, ctx *CommandContext, name string) string {
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	s.Suffix = " Generating description with AI..."
+	s.Start()
+
+	description, err := ctx.ClaudeClient.GenerateDescription(cmdCtx, name)
+	s.Stop()
+	if err != nil {
+		yellow := color.New(color.FgYellow)
+		_, _ = yellow.Printf("⚠ Could not generate description: %v\n", err)
+		return ""
+	}
+
+	return description
+}
+
+// resolveSprintListID returns the list ID of the current or next sprint in a workspace
+func resolveSprintListID(cmdCtx context.Context, ctx *CommandContext, workspaceName, sprint string) (string, error) {
+	if len(ctx.Config.Workspaces) == 0 {
+		return "", fmt.Errorf("no workspaces configured; use --list to choose a list")
+	}
+
+	workspace := ctx.Config.Workspaces[0]
+	if workspaceName != "" {
+		found := false
+		for _, ws := range ctx.Config.Workspaces {
+			if strings.EqualFold(ws.Name, workspaceName) {
+				workspace = ws
+				found = true
+				break
+			}
+		}
+		if !found {
+			return "", fmt.Errorf("workspace not found: %s", workspaceName)
+		}
+	}
+
+	lists, err := ctx.ClickUpClient.GetLists(cmdCtx, workspace.FolderID)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch sprints for workspace %s: %w", workspace.Name, err)
+	}
+
+	offset := 0
+	if sprint == sprintNext {
+		offset = 1
+	}
+
+	list := utils.FindAdjacentSprint(workspace.FolderID, lists, workspace.SprintPatterns, offset)
+	if list == nil {
+		return "", fmt.Errorf("no %s sprint found in workspace %s; use --list to choose a list", sprint, workspace.Name)
+	}
+
+	return list.ID, nil
+}
+
+// promptTicketList lets the user pick the current or next sprint of any workspace, or enter a list ID
+func promptTicketList(cmdCtx context.Context, ctx *CommandContext) (string, error) {
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	s.Suffix = " Finding sprints..."
+	s.Start()
+
+	var choices []sprintListChoice
+	for _, workspace := range ctx.Config.Workspaces {
+		lists, err := ctx.ClickUpClient.GetLists(cmdCtx, workspace.FolderID)
+		if err != nil {
+			continue
+		}
+		if current := utils.FindAdjacentSprint(workspace.FolderID, lists, workspace.SprintPatterns, 0); current != nil {
+			choices = append(choices, sprintListChoice{
+				Label:  fmt.Sprintf("%s — %s (current)", workspace.Name, current.Name),
+				ListID: current.ID,
+			})
+		}
+		if next := utils.FindAdjacentSprint(workspace.FolderID, lists, workspace.SprintPatterns, 1); next != nil {
+			choices = append(choices, sprintListChoice{
+				Label:  fmt.Sprintf("%s — %s (next)", workspace.Name, next.Name),
+				ListID: next.ID,
+			})
+		}
+	}
+	s.Stop()
+
+	options := make([]string, 0, len(choices)+1)
+	for _, choice := range choices {
+		options = append(options, choice.Label)
+	}
+	options = append(options, listOptionManual)
+
+	var selected string
+	if err := survey.AskOne(&survey.Select{Message: "Create in:", Options: options}, &selected); err != nil {
+		return "", err
+	}
+
+	for _, choice := range choices {
+		if choice.Label == selected {
+			return choice.ListID, nil
+		}
+	}
+
+	var listID string
+	if err := survey.AskOne(&survey.Input{Message: "List ID:"}, &listID, survey.WithValidator(survey.Required)); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(listID), nil
+}
+
+// promptTicketAssignees lets the user pick assignees from the list members
+func promptTicketAssignees(cmdCtx context.Context, ctx *CommandContext, listID string) ([]int, error) {
+	members, err := ctx.ClickUpClient.GetListMembers(cmdCtx, listID)
+	if err != nil || len(members) == 0 {
+		// Fall back to assigning yourself
+		var assignMe bool
+		if err := survey.AskOne(&survey.Confirm{Message: "Assign to yourself?", Default: true}, &assignMe); err != nil {
+			return nil, err
+		}
+		if !assignMe {
+			return nil, nil
+		}
+		id, err := strconv.Atoi(ctx.Config.ClickUp.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid clickup.user_id: %s", ctx.Config.ClickUp.UserID)
+		}
+		return []int{id}, nil
+	}
+
+	options := make([]string, len(members))
+	memberMap := make(map[string]int, len(members))
+	var defaults []string
+	for i, member := range members {
+		options[i] = member.Username
+		if member.Email != "" {
+			options[i] = fmt.Sprintf("%s <%s>", member.Username, member.Email)
+		}
+		memberMap[options[i]] = member.ID
+		if strconv.Itoa(member.ID) == ctx.Config.ClickUp.UserID {
+			defaults = append(defaults, options[i])
+		}
+	}
+
+	var selected []string
+	prompt := &survey.MultiSelect{
+		Message: "Assignees:",
+		Options: options,
+		Default: defaults,
+	}
+	if err := survey.AskOne(prompt, &selected); err != nil {
+		return nil, err
+	}
+
+	assignees := make([]int, len(selected))
+	for i, option := range selected {
+		assignees[i] = memberMap[option]
+	}
+	return assignees, nil
+}
+
+// promptTicketCustomFields lets the user set the list's custom fields
+func promptTicketCustomFields(cmdCtx context.Context, ctx *CommandContext, listID string) ([]models.CustomFieldValue, error) {
+	definitions, err := ctx.ClickUpClient.GetListCustomFields(cmdCtx, listID)
+	if err != nil || len(definitions) == 0 {
+		return nil, nil
+	}
+
+	var setFields bool
+	if err := survey.AskOne(&survey.Confirm{Message: "Set custom fields?", Default: false}, &setFields); err != nil {
+		return nil, err
+	}
+	if !setFields {
+		return nil, nil
+	}
+
+	var values []models.CustomFieldValue
+	for _, def := range definitions {
+		var raw string
+		if len(def.Options) > 0 {
+			options := []string{"(skip)"}
+			for _, opt := range def.Options {
+				options = append(options, opt.Name)
+			}
+			if err := survey.AskOne(&survey.Select{Message: def.Name + ":", Options: options}, &raw); err != nil {
+				return nil, err
+			}
+			if raw == "(skip)" {
+				continue
+			}
+		} else {
+			if err := survey.AskOne(&survey.Input{Message: def.Name + " (optional):"}, &raw); err != nil {
+				return nil, err
+			}
+			if strings.TrimSpace(raw) == "" {
+				continue
+			}
+		}
+
+		value, err := customFieldValue(def, raw)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, models.CustomFieldValue{ID: def.ID, Value: value})
+	}
+
+	return values, nil
+}
+
+// resolveCustomFieldFlag resolves a "Name=value" flag against the list's custom fields
+func resolveCustomFieldFlag(definitions []*models.CustomFieldDefinition, field string) (models.CustomFieldValue, error) {
+	name, raw, ok := strings.Cut(field, "=")
+	if !ok {
+		return models.CustomFieldValue{}, fmt.Errorf("invalid --field %q (expected Name=value)", field)
+	}
+	name = strings.TrimSpace(name)
+
+	for _, def := range definitions {
+		if strings.EqualFold(def.Name, name) {
+			value, err := customFieldValue(def, strings.TrimSpace(raw))
+			if err != nil {
+				return models.CustomFieldValue{}, err
+			}
+			return models.CustomFieldValue{ID: def.ID, Value: value}, nil
+		}
+	}
+
+	return models.CustomFieldValue{}, fmt.Errorf("custom field not found on list: %s", name)
+}
+
+// customFieldValue converts a raw string into the value ClickUp expects for the field type
+//
+//nolint:gocyclo // Each custom field type needs its own conversion
+func customFieldValue(def *models.CustomFieldDefinition, raw string) (interface{}, error) {
+	switch def.Type {
+	case "drop_down":
+		for _, opt := range def.Options {
+			if strings.EqualFold(opt.Name, raw) {
+				return opt.ID, nil
+			}
+		}
+		return nil, fmt.Errorf("invalid value %q for %s (options: %s)", raw, def.Name, customFieldOptionNames(def))
+	case "labels":
+		var ids []string
+		for _, label := range parseCommaSeparated(raw) {
+			found := false
+			for _, opt := range def.Options {
+				if strings.EqualFold(opt.Name, label) {
+					ids = append(ids, opt.ID)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("invalid label %q for %s (options: %s)", label, def.Name, customFieldOptionNames(def))
+			}
+		}
+		return ids, nil
+	case "number", "currency", "emoji":
+		n, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q for %s", raw, def.Name)
+		}
+		return n, nil
+	case "checkbox":
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid boolean %q for %s", raw, def.Name)
+		}
+		return b, nil
+	case "date":
+		due, err := parseDueDate(raw)
+		if err != nil || due == nil {
+			return nil, fmt.Errorf("invalid date %q for %s (expected YYYY-MM-DD)", raw, def.Name)
+		}
+		return *due, nil
+	default:
+		return raw, nil
+	}
+}
+
+// customFieldOptionNames lists the option names of a custom field
+func customFieldOptionNames(def *models.CustomFieldDefinition) string {
+	names := make([]string, len(def.Options))
+	for i, opt := range def.Options {
+		names[i] = opt.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// parseDueDate parses a YYYY-MM-DD date into Unix milliseconds.
+// An empty string returns nil.
+func parseDueDate(input string) (*int64, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return nil, nil
+	}
+
+	t, err := time.ParseInLocation("2006-01-02", input, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("invalid due date: %s (expected YYYY-MM-DD)", input)
+	}
+
+	ms := t.UnixMilli()
+	return &ms, nil
+}
+
+// displayTicketCreatePreview shows a formatted preview of the ticket to be created
+func displayTicketCreatePreview(req *models.TaskCreateRequest) {
+	bold := color.New(color.Bold)
+	cyan := color.New(color.FgCyan)
+
+	fmt.Println()
+	_, _ = bold.Println("Preview:")
+	fmt.Println()
+	fmt.Printf("  Title: %s\n", cyan.Sprint(req.Name))
+	if len(req.Assignees) > 0 {
+		ids := make([]string, len(req.Assignees))
+		for i, id := range req.Assignees {
+			ids[i] = strconv.Itoa(id)
+		}
+		fmt.Printf("  Assignees: %s\n", strings.Join(ids, ", "))
+	}
+	if len(req.Tags) > 0 {
+		fmt.Printf("  Tags: %s\n", strings.Join(req.Tags, ", "))
+	}
+	if req.Priority > 0 {
+		for name, value := range clickup.Priorities {
+			if value == req.Priority {
+				fmt.Printf("  Priority: %s\n", name)
+			}
+		}
+	}
+	if req.DueDate != nil {
+		fmt.Printf("  Due: %s\n", time.UnixMilli(*req.DueDate).Format("2006-01-02"))
+	}
+	if len(req.CustomFields) > 0 {
+		fmt.Printf("  Custom fields: %d\n", len(req.CustomFields))
+	}
+	fmt.Println()
+
+	if req.MarkdownDescription != "" {
+		fmt.Println("  Description:")
+		fmt.Println(indentText(req.MarkdownDescription, "    "))
+		fmt.Println()
+	}
+}
@@ -0,0 +1,134 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rithyhuot/vibe/internal/models"
+)
+
+func TestParseDueDate(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		due, err := parseDueDate("  ")
+		assert.NoError(t, err)
+		assert.Nil(t, due)
+	})
+
+	t.Run("date", func(t *testing.T) {
+		due, err := parseDueDate(" 2026-03-01 ")
+		assert.NoError(t, err)
+		if assert.NotNil(t, due) {
+			expected := time.Date(2026, 3, 1, 0, 0, 0, 0, time.Local).UnixMilli()
+			assert.Equal(t, expected, *due)
+		}
+	})
+
+	for _, input := range []string{"2026-13-01", "03/01/2026", "tomorrow"} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			_, err := parseDueDate(input)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestCustomFieldValue(t *testing.T) {
+	dropDown := &models.CustomFieldDefinition{
+		Name: "Type",
+		Type: "drop_down",
+		Options: []models.CustomFieldOption{
+			{ID: "opt-bug", Name: "Bug"},
+			{ID: "opt-feature", Name: "Feature"},
+		},
+	}
+	labels := &models.CustomFieldDefinition{
+		Name: "Areas",
+		Type: "labels",
+		Options: []models.CustomFieldOption{
+			{ID: "lbl-api", Name: "API"},
+			{ID: "lbl-ui", Name: "UI"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		def      *models.CustomFieldDefinition
+		raw      string
+		expected interface{}
+		wantErr  bool
+	}{
+		{name: "drop-down option by name", def: dropDown, raw: "feature", expected: "opt-feature"},
+		{name: "unknown drop-down option", def: dropDown, raw: "Chore", wantErr: true},
+		{name: "labels", def: labels, raw: "api, ui", expected: []string{"lbl-api", "lbl-ui"}},
+		{name: "unknown label", def: labels, raw: "API,Docs", wantErr: true},
+		{name: "number", def: &models.CustomFieldDefinition{Name: "Points", Type: "number"}, raw: "3.5", expected: 3.5},
+		{name: "invalid number", def: &models.CustomFieldDefinition{Name: "Points", Type: "number"}, raw: "three", wantErr: true},
+		{name: "checkbox", def: &models.CustomFieldDefinition{Name: "Blocked", Type: "checkbox"}, raw: "true", expected: true},
+		{name: "invalid checkbox", def: &models.CustomFieldDefinition{Name: "Blocked", Type: "checkbox"}, raw: "maybe", wantErr: true},
+		{
+			name:     "date",
+			def:      &models.CustomFieldDefinition{Name: "Launch", Type: "date"},
+			raw:      "2026-03-01",
+			expected: time.Date(2026, 3, 1, 0, 0, 0, 0, time.Local).UnixMilli(),
+		},
+		{name: "empty date", def: &models.CustomFieldDefinition{Name: "Launch", Type: "date"}, raw: "", wantErr: true},
+		{name: "text", def: &models.CustomFieldDefinition{Name: "Notes", Type: "text"}, raw: "hello", expected: "hello"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := customFieldValue(tt.def, tt.raw)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
+
+func TestResolveCustomFieldFlag(t *testing.T) {
+	definitions := []*models.CustomFieldDefinition{
+		{ID: "field-type", Name: "Type", Type: "drop_down", Options: []models.CustomFieldOption{{ID: "opt-bug", Name: "Bug"}}},
+		{ID: "field-points", Name: "Story Points", Type: "number"},
+	}
+
+	tests := []struct {
+		name     string
+		field    string
+		expected models.CustomFieldValue
+		wantErr  bool
+	}{
+		{name: "drop-down", field: "Type=Bug", expected: models.CustomFieldValue{ID: "field-type", Value: "opt-bug"}},
+		{name: "name is case-insensitive and trimmed", field: " story points = 5 ", expected: models.CustomFieldValue{ID: "field-points", Value: 5.0}},
+		{name: "value is everything after the first =", field: "Type=Bug=1", wantErr: true},
+		{name: "missing =", field: "Type", wantErr: true},
+		{name: "unknown field", field: "Severity=High", wantErr: true},
+		{name: "invalid value", field: "Story Points=lots", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := resolveCustomFieldFlag(definitions, tt.field)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
+
+func TestRunTicketCreate_AIWithoutClaude(t *testing.T) {
+	// Both modes fail the same way before prompting or calling the tracker
+	for _, opts := range []*TicketCreateOptions{
+		{Sprint: sprintCurrent, AI: true},
+		{Sprint: sprintCurrent, AI: true, Name: "Add export", Yes: true},
+	} {
+		err := runTicketCreate(&CommandContext{}, opts)
+		assert.ErrorContains(t, err, "--ai requires AI to be enabled")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/briandowns/spinner"
 	"github.com/spf13/cobra"
 
+	"github.com/rithyhuot/vibe/internal/output"
 	"github.com/rithyhuot/vibe/internal/utils"
 )
 
@@ -21,7 +22,10 @@ func NewTicketCommand(ctx *CommandContext) *cobra.Command {
 Examples:
   vibe ticket                    # View ticket for current branch
   vibe ticket abc123             # View specific ticket by ID
-  vibe ticket 86b7x5453          # View ticket with full ClickUp ID`,
+  vibe ticket 86b7x5453          # View ticket with full ClickUp ID
+  vibe ticket ABC-123            # View a Jira issue
+  vibe ticket ENG-482            # View a Linear issue
+  vibe ticket -o json            # Output ticket as JSON`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(_ *cobra.Command, args []string) error {
 			var ticketID string
@@ -35,7 +39,7 @@ Examples:
 					return fmt.Errorf("failed to get current branch: %w", err)
 				}
 
-				ticketID, err = utils.ExtractTicketID(currentBranch)
+				ticketID, err = branchTicketID(ctx, currentBranch)
 				if err != nil {
 					return fmt.Errorf("could not extract ticket ID from branch '%s': %w", currentBranch, err)
 				}
@@ -50,8 +54,9 @@ Examples:
 
 func runTicket(ctx *CommandContext, ticketID string) error {
 	// Validate ticket ID
-	if !utils.IsTicketID(ticketID) {
-		return fmt.Errorf("invalid ticket ID format: %s", ticketID)
+	ticketID = utils.NormalizeTicketID(ticketID)
+	if !isTicketID(ctx, ticketID) {
+		return fmt.Errorf("invalid ticket ID format: %s (expected %s)", ticketID, ticketIDFormat(ctx))
 	}
 
 	cmdCtx := context.Background()
@@ -62,7 +67,7 @@ func runTicket(ctx *CommandContext, ticketID string) error {
 	s.Start()
 
 	// Fetch task
-	task, err := ctx.ClickUpClient.GetTask(cmdCtx, ticketID)
+	task, err := ctx.Tracker.GetTicket(cmdCtx, ticketID)
 	if err != nil {
 		s.Stop()
 		return fmt.Errorf("failed to fetch task: %w", err)
@@ -70,6 +75,10 @@ func runTicket(ctx *CommandContext, ticketID string) error {
 
 	s.Stop()
 
+	if ctx.Output.IsStructured() {
+		return writeOutput(ctx, output.KindTask, output.NewTask(task))
+	}
+
 	// Display task
 	displayTask(task)
 
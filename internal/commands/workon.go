@@ -18,11 +18,13 @@ func NewVibeCommand(ctx *CommandContext) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "vibe <ticket-id>",
 		Short: "Start working on a ticket",
-		Long: `Fetches a task from ClickUp, creates a branch, and updates the task status.
+		Long: `Fetches a ticket from ClickUp, Jira or Linear, creates a branch, and updates the ticket status.
 
 Examples:
   vibe workon abc123             # Start working on ticket abc123
   vibe workon 86b7x5453          # Start working with full ClickUp ID
+  vibe workon ABC-123            # Start working on a Jira issue
+  vibe workon ENG-482            # Start working on a Linear issue
   vibe abc123                    # Shorthand: vibe command works the same`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(_ *cobra.Command, args []string) error {
@@ -35,19 +37,20 @@ Examples:
 
 func runVibe(ctx *CommandContext, ticketID string) error {
 	// Validate ticket ID
-	if !utils.IsTicketID(ticketID) {
-		return fmt.Errorf("invalid ticket ID format: %s (expected 9 alphanumeric characters)", ticketID)
+	ticketID = utils.NormalizeTicketID(ticketID)
+	if !isTicketID(ctx, ticketID) {
+		return fmt.Errorf("invalid ticket ID format: %s (expected %s)", ticketID, ticketIDFormat(ctx))
 	}
 
 	cmdCtx := context.Background()
 
 	// Create spinner
 	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
-	s.Suffix = " Fetching task from ClickUp..."
+	s.Suffix = fmt.Sprintf(" Fetching task from %s...", ctx.Tracker.Name())
 	s.Start()
 
-	// Fetch task from ClickUp
-	task, err := ctx.ClickUpClient.GetTask(cmdCtx, ticketID)
+	// Fetch task from the tracker
+	task, err := ctx.Tracker.GetTicket(cmdCtx, ticketID)
 	if err != nil {
 		s.Stop()
 		return fmt.Errorf("failed to fetch task: %w", err)
@@ -65,9 +68,9 @@ func runVibe(ctx *CommandContext, ticketID string) error {
 	}
 	var branchName string
 	if username != "" {
-		branchName = utils.GenerateBranchName(prefix, ticketID, task.Name, username)
+		branchName = ticketBranchName(ctx, prefix, ticketID, task.Name, username)
 	} else {
-		branchName = utils.GenerateBranchName(prefix, ticketID, task.Name)
+		branchName = ticketBranchName(ctx, prefix, ticketID, task.Name)
 	}
 
 	// Validate branch name
@@ -128,12 +131,7 @@ func runVibe(ctx *CommandContext, ticketID string) error {
 		s.Suffix = " Updating task status..."
 		s.Start()
 
-		status := ctx.Config.Defaults.Status
-		updateReq := &models.TaskUpdateRequest{
-			Status: &status,
-		}
-
-		_, err = ctx.ClickUpClient.UpdateTask(cmdCtx, ticketID, updateReq)
+		err = ctx.Tracker.UpdateStatus(cmdCtx, ticketID, ctx.Config.Defaults.Status)
 		s.Stop()
 		if err != nil {
 			yellow := color.New(color.FgYellow)
@@ -24,6 +24,24 @@ clickup:
   workspace_id: "1234567"
   team_id: "1234567"
 
+# Ticket tracker (optional, default: clickup)
+# Set provider to "jira" or "linear" to use Jira or Linear instead of
+# ClickUp. The clickup and workspaces sections aren't needed then.
+# tracker:
+#   provider: "jira"
+#
+# jira:
+#   base_url: "https://your-site.atlassian.net"
+#   email: "you@example.com"        # Jira Cloud only
+#   api_token: "your_jira_api_token" # API token (Cloud) or personal access token (Server)
+#   deployment: "cloud"             # Options: "cloud" or "server" (default: detected from base_url)
+#   project_key: "ABC"              # Project for new tickets and search
+#   issue_type: "Task"              # Issue type for new tickets
+#
+# linear:
+#   api_key: "lin_api_your_linear_key"
+#   team_key: "ENG"                 # Team for new tickets and search
+
 # GitHub configuration
 github:
   token: "ghp_your_github_token"  # Optional if using CLI mode
@@ -36,10 +54,40 @@ github:
 git:
   branch_prefix: "your-username"
   base_branch: "main"
+  # branch_style: "linear"  # Options: "default" or "linear" (prefix/eng-123-title)
+
+# GitLab configuration (optional)
+# Used instead of GitHub when the origin remote is on gitlab.com, a gitlab.*
+# host, or one of hosts.
+# gitlab:
+#   token: "glpat-your_gitlab_token"
+#   hosts:
+#     - "git.example.com"
+
+# CI provider (optional, default: detected from the repository)
+# GitLab remotes use GitLab pipelines, .circleci/config.yml uses CircleCI,
+# and .github/workflows uses GitHub Actions with the GitHub token.
+# ci:
+#   provider: "github-actions"  # Options: "circleci", "github-actions", or "gitlab"
 
 # CircleCI configuration (optional)
 circleci:
   api_token: "circle_your_circleci_token"
+  # The project slug is taken from the origin remote (gh/org/repo or
+  # bb/workspace/repo). Set it in a repository's .vibe.yaml for other hosts,
+  # standalone projects, or pipelines that live in another project.
+  # project_slug: "circleci/<org-id>/<project-id>"
+  # Other projects whose pipelines ci-status shows alongside the main one
+  # extra_projects:
+  #   - "gh/acme/monorepo-deploy"
+
+# Merging (optional)
+# vibe merge posts a comment for merge automation (like a merge bot). Set
+# strategy to "api" to merge through the GitHub API instead.
+# merge:
+#   strategy: "api"    # Options: "api" or "comment" (default: comment)
+#   method: "squash"   # Options: "merge", "squash", or "rebase" (default: merge)
+#   comment: "/merge"  # Comment posted by the comment strategy
 
 # Claude AI configuration (optional)
 claude:
@@ -69,6 +117,30 @@ ai:
 # UI preferences
 ui:
   color_enabled: true
+
+# HTTP retry budgets (optional)
+# Rate-limited (429) requests and transient server errors are retried with
+# jittered backoff. max_wait caps how long a Retry-After or rate-limit reset
+# is honored before giving up.
+# http:
+#   github:
+#     max_retries: 3
+#     max_wait: 60s
+#   clickup:
+#     max_retries: 5
+#     max_wait: 2m
+
+# On-disk response cache (optional)
+# Responses are stored under ~/.config/vibe/cache. GitHub and CircleCI
+# responses are revalidated with ETag/Last-Modified on every request; ttl
+# reuses a response without any request for that long. Use --no-cache to
+# bypass the cache for a single command.
+# cache:
+#   enabled: true
+#   clickup:
+#     ttl: 30s
+#   github:
+#     ttl: 0s
 `
 }
 
@@ -92,6 +164,13 @@ git:
 # github:
 #   mode: "cli"
 
+# Example: CircleCI project of this repository, and other projects whose
+# pipelines ci-status shows with it
+# circleci:
+#   project_slug: "bb/acme/app"
+#   extra_projects:
+#     - "circleci/<org-id>/<project-id>"
+
 # Example: Override workspace for this project
 # workspaces:
 #   - name: "Engineering"
@@ -2,8 +2,10 @@ package config
 
 import (
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 
 	validator "github.com/go-playground/validator/v10"
 	"github.com/spf13/viper"
@@ -11,6 +13,29 @@ import (
 
 var validate *validator.Validate
 
+const (
+	// defaultMaxRetries is the default number of retries per request
+	defaultMaxRetries = 3
+
+	// defaultMaxWait is the longest Retry-After/rate-limit wait honored by default
+	defaultMaxWait = "60s"
+
+	// defaultClickUpCacheTTL is how long ClickUp responses are reused, since
+	// ClickUp doesn't send validators for conditional requests
+	defaultClickUpCacheTTL = "30s"
+
+	// defaultJiraCacheTTL is how long Jira responses are reused
+	defaultJiraCacheTTL = "30s"
+
+	// defaultJiraIssueType is the issue type of tickets created in Jira
+	defaultJiraIssueType = "Task"
+
+	// defaultMergeMethod and defaultMergeComment are the merge method of the
+	// api merge strategy and the comment of the comment strategy
+	defaultMergeMethod  = "merge"
+	defaultMergeComment = "/merge"
+)
+
 func init() {
 	validate = validator.New()
 }
@@ -41,10 +66,33 @@ func Load(configPath string) (*Config, error) {
 
 	// Bind specific env variables
 	_ = v.BindEnv("clickup.api_token", "VIBE_CLICKUP_TOKEN")
+	_ = v.BindEnv("jira.api_token", "VIBE_JIRA_TOKEN")
+	_ = v.BindEnv("linear.api_key", "VIBE_LINEAR_TOKEN")
 	_ = v.BindEnv("github.token", "VIBE_GITHUB_TOKEN")
+	_ = v.BindEnv("gitlab.token", "VIBE_GITLAB_TOKEN")
 	_ = v.BindEnv("circleci.api_token", "VIBE_CIRCLECI_TOKEN")
 	_ = v.BindEnv("claude.api_key", "VIBE_CLAUDE_API_KEY")
 
+	// Default retry budgets per service
+	for _, service := range []string{"clickup", "jira", "linear", "github", "gitlab", "circleci", "claude"} {
+		v.SetDefault("http."+service+".max_retries", defaultMaxRetries)
+		v.SetDefault("http."+service+".max_wait", defaultMaxWait)
+	}
+
+	// Response cache defaults. GitHub, GitLab and CircleCI always revalidate.
+	v.SetDefault("cache.enabled", true)
+	v.SetDefault("cache.clickup.ttl", defaultClickUpCacheTTL)
+	v.SetDefault("cache.jira.ttl", defaultJiraCacheTTL)
+
+	// Ticket tracker defaults
+	v.SetDefault("tracker.provider", TrackerClickUp)
+	v.SetDefault("jira.issue_type", defaultJiraIssueType)
+
+	// Merge defaults
+	v.SetDefault("merge.strategy", MergeStrategyComment)
+	v.SetDefault("merge.method", defaultMergeMethod)
+	v.SetDefault("merge.comment", defaultMergeComment)
+
 	// Read global config
 	if err := v.ReadInConfig(); err != nil {
 		return nil, fmt.Errorf("failed to read config: %w", err)
@@ -91,8 +139,18 @@ func Load(configPath string) (*Config, error) {
 		return nil, err
 	}
 
-	// Validate
-	if err := validate.Struct(&cfg); err != nil {
+	// Validate tracker provider and the settings it needs
+	if err := validateTracker(&cfg); err != nil {
+		return nil, err
+	}
+
+	// Validate, skipping the settings of the tracker that isn't in use
+	except := unusedTrackerFields(cfg.Tracker.Provider)
+	if cfg.GitLab.Token != "" && cfg.GitHub.Owner == "" && cfg.GitHub.Repo == "" {
+		// GitLab-only setups take the project from the git remote
+		except = append(except, "GitHub.Owner", "GitHub.Repo")
+	}
+	if err := validate.StructExcept(&cfg, except...); err != nil {
 		return nil, fmt.Errorf("config validation failed: %w", err)
 	}
 
@@ -123,6 +181,15 @@ func GetConfigDir() (string, error) {
 	return filepath.Join(home, ".config", "vibe"), nil
 }
 
+// GetCacheDir returns the directory of the on-disk response cache
+func GetCacheDir() (string, error) {
+	dir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "cache"), nil
+}
+
 // GetConfigPath returns the default config file path
 func GetConfigPath() (string, error) {
 	dir, err := GetConfigDir()
@@ -166,3 +233,58 @@ func validateGitHubMode(cfg *GitHubConfig) error {
 
 	return nil
 }
+
+// validateTracker validates the tracker provider and fills in the Jira
+// deployment when it isn't set
+func validateTracker(cfg *Config) error {
+	switch cfg.Tracker.Provider {
+	case TrackerClickUp, TrackerLinear:
+		return nil
+	case TrackerJira:
+	default:
+		return fmt.Errorf("invalid tracker.provider: %s (must be '%s', '%s' or '%s')",
+			cfg.Tracker.Provider, TrackerClickUp, TrackerJira, TrackerLinear)
+	}
+
+	if cfg.Jira.Deployment == "" {
+		cfg.Jira.Deployment = detectJiraDeployment(cfg.Jira.BaseURL)
+	}
+
+	switch cfg.Jira.Deployment {
+	case JiraDeploymentCloud:
+		// Jira Cloud authenticates with email and API token
+		if cfg.Jira.Email == "" {
+			return fmt.Errorf("jira.email is required for Jira Cloud")
+		}
+	case JiraDeploymentServer:
+		// Server and Data Center authenticate with a personal access token
+	default:
+		return fmt.Errorf("invalid jira.deployment: %s (must be '%s' or '%s')",
+			cfg.Jira.Deployment, JiraDeploymentCloud, JiraDeploymentServer)
+	}
+
+	return nil
+}
+
+// detectJiraDeployment guesses the deployment from the base URL: Jira Cloud
+// sites are hosted on atlassian.net
+func detectJiraDeployment(baseURL string) string {
+	u, err := url.Parse(baseURL)
+	if err == nil && strings.HasSuffix(strings.ToLower(u.Hostname()), ".atlassian.net") {
+		return JiraDeploymentCloud
+	}
+	return JiraDeploymentServer
+}
+
+// unusedTrackerFields returns the config fields that belong to the trackers
+// that aren't in use, which are excluded from validation
+func unusedTrackerFields(provider string) []string {
+	switch provider {
+	case TrackerJira:
+		return []string{"ClickUp", "Workspaces", "Linear"}
+	case TrackerLinear:
+		return []string{"ClickUp", "Workspaces", "Jira"}
+	default:
+		return []string{"Jira", "Linear"}
+	}
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -217,6 +218,67 @@ ui:
 	}
 }
 
+func TestLoadWithJiraTracker(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	// A Jira config needs no ClickUp settings or workspaces
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	configYAML := `tracker:
+  provider: "jira"
+
+jira:
+  base_url: "https://example.atlassian.net"
+  email: "dev@example.com"
+  api_token: "jira_token"
+  project_key: "ABC"
+
+github:
+  token: "test_github_token"
+  username: "test-user"
+  owner: "test-org"
+  repo: "test-repo"
+
+git:
+  branch_prefix: "test-prefix"
+  base_branch: "main"
+`
+	if err := os.WriteFile(configPath, []byte(configYAML), 0600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	originalDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Failed to get current dir: %v", err)
+	}
+	defer func() { _ = os.Chdir(originalDir) }()
+
+	if err := os.Chdir(tmpDir); err != nil {
+		t.Fatalf("Failed to change to temp dir: %v", err)
+	}
+
+	cfg, err := Load(configPath)
+	if err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+
+	if cfg.Jira.Deployment != JiraDeploymentCloud {
+		t.Errorf("Expected deployment to be detected as '%s', got '%s'", JiraDeploymentCloud, cfg.Jira.Deployment)
+	}
+	if cfg.Jira.IssueType != "Task" {
+		t.Errorf("Expected default issue type 'Task', got '%s'", cfg.Jira.IssueType)
+	}
+
+	// Jira Cloud requires an email
+	configYAML = strings.Replace(configYAML, `  email: "dev@example.com"
+`, "", 1)
+	if err := os.WriteFile(configPath, []byte(configYAML), 0600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	if _, err := Load(configPath); err == nil || !strings.Contains(err.Error(), "jira.email") {
+		t.Errorf("Expected jira.email error, got %v", err)
+	}
+}
+
 func TestFindLocalConfig(t *testing.T) {
 	// Test when .vibe.yaml exists
 	tmpDir, err := os.MkdirTemp("", "vibe-config-test-*")
@@ -263,3 +325,228 @@ func TestFindLocalConfig(t *testing.T) {
 		t.Errorf("Expected empty string when no local config exists, got '%s'", result)
 	}
 }
+
+func TestLoadWithLinearTracker(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	// A Linear config needs no ClickUp or Jira settings
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	configYAML := `tracker:
+  provider: "linear"
+
+linear:
+  api_key: "lin_api_test"
+  team_key: "ENG"
+
+github:
+  token: "test_github_token"
+  username: "test-user"
+  owner: "test-org"
+  repo: "test-repo"
+
+git:
+  branch_prefix: "test-prefix"
+  base_branch: "main"
+  branch_style: "linear"
+`
+	if err := os.WriteFile(configPath, []byte(configYAML), 0600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	originalDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Failed to get current dir: %v", err)
+	}
+	defer func() { _ = os.Chdir(originalDir) }()
+
+	if err := os.Chdir(tmpDir); err != nil {
+		t.Fatalf("Failed to change to temp dir: %v", err)
+	}
+
+	cfg, err := Load(configPath)
+	if err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+
+	if cfg.Linear.TeamKey != "ENG" {
+		t.Errorf("Expected team key 'ENG', got '%s'", cfg.Linear.TeamKey)
+	}
+	if cfg.Git.BranchStyle != "linear" {
+		t.Errorf("Expected branch style 'linear', got '%s'", cfg.Git.BranchStyle)
+	}
+
+	// Linear requires an API key
+	configYAML = strings.Replace(configYAML, `  api_key: "lin_api_test"
+`, "", 1)
+	if err := os.WriteFile(configPath, []byte(configYAML), 0600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	if _, err := Load(configPath); err == nil || !strings.Contains(err.Error(), "APIKey") {
+		t.Errorf("Expected APIKey error, got %v", err)
+	}
+}
+
+func TestLoadWithGitLabOnly(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	// GitLab projects come from the git remote, so github.owner and github.repo aren't needed
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	configYAML := `tracker:
+  provider: "linear"
+
+linear:
+  api_key: "lin_api_test"
+
+github:
+  username: "test-user"
+
+gitlab:
+  token: "glpat-test"
+  hosts:
+    - "git.example.com"
+
+git:
+  branch_prefix: "test-prefix"
+  base_branch: "main"
+`
+	if err := os.WriteFile(configPath, []byte(configYAML), 0600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	originalDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Failed to get current dir: %v", err)
+	}
+	defer func() { _ = os.Chdir(originalDir) }()
+
+	if err := os.Chdir(tmpDir); err != nil {
+		t.Fatalf("Failed to change to temp dir: %v", err)
+	}
+
+	cfg, err := Load(configPath)
+	if err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+
+	if cfg.GitLab.Token != "glpat-test" {
+		t.Errorf("Expected GitLab token 'glpat-test', got '%s'", cfg.GitLab.Token)
+	}
+	if len(cfg.GitLab.Hosts) != 1 || cfg.GitLab.Hosts[0] != "git.example.com" {
+		t.Errorf("Expected hosts [git.example.com], got %v", cfg.GitLab.Hosts)
+	}
+	if cfg.HTTP.GitLab.MaxRetries != defaultMaxRetries {
+		t.Errorf("Expected default GitLab retries %d, got %d", defaultMaxRetries, cfg.HTTP.GitLab.MaxRetries)
+	}
+
+	// The GitHub username is still required for branch names
+	configYAML = strings.Replace(configYAML, `  username: "test-user"
+`, "", 1)
+	if err := os.WriteFile(configPath, []byte(configYAML), 0600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	if _, err := Load(configPath); err == nil || !strings.Contains(err.Error(), "Username") {
+		t.Errorf("Expected Username error, got %v", err)
+	}
+}
+
+func TestLoadWithInvalidCIProvider(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	configYAML := `tracker:
+  provider: "linear"
+
+linear:
+  api_key: "lin_api_test"
+
+github:
+  username: "test-user"
+  owner: "test-org"
+  repo: "test-repo"
+
+git:
+  branch_prefix: "test-prefix"
+  base_branch: "main"
+
+ci:
+  provider: "travis"
+`
+	if err := os.WriteFile(configPath, []byte(configYAML), 0600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	originalDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Failed to get current dir: %v", err)
+	}
+	defer func() { _ = os.Chdir(originalDir) }()
+
+	if err := os.Chdir(tmpDir); err != nil {
+		t.Fatalf("Failed to change to temp dir: %v", err)
+	}
+
+	if _, err := Load(configPath); err == nil || !strings.Contains(err.Error(), "Provider") {
+		t.Errorf("Expected Provider error, got %v", err)
+	}
+
+	configYAML = strings.Replace(configYAML, "travis", CIProviderGitHubActions, 1)
+	if err := os.WriteFile(configPath, []byte(configYAML), 0600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	cfg, err := Load(configPath)
+	if err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+	if cfg.CI.Provider != CIProviderGitHubActions {
+		t.Errorf("Expected CI provider %q, got %q", CIProviderGitHubActions, cfg.CI.Provider)
+	}
+}
+
+func TestLoadMergeConfig(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	configYAML := `tracker:
+  provider: "linear"
+
+linear:
+  api_key: "lin_api_test"
+
+github:
+  username: "test-user"
+  owner: "test-org"
+  repo: "test-repo"
+
+git:
+  branch_prefix: "test-prefix"
+  base_branch: "main"
+`
+	if err := os.WriteFile(configPath, []byte(configYAML), 0600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	originalDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Failed to get current dir: %v", err)
+	}
+	defer func() { _ = os.Chdir(originalDir) }()
+
+	if err := os.Chdir(tmpDir); err != nil {
+		t.Fatalf("Failed to change to temp dir: %v", err)
+	}
+
+	cfg, err := Load(configPath)
+	if err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+	if cfg.Merge.Strategy != MergeStrategyComment || cfg.Merge.Method != "merge" || cfg.Merge.Comment != "/merge" {
+		t.Errorf("Expected comment strategy, merge method and /merge comment by default, got %+v", cfg.Merge)
+	}
+
+	if err := os.WriteFile(configPath, []byte(configYAML+"\nmerge:\n  method: \"fast-forward\"\n"), 0600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	if _, err := Load(configPath); err == nil || !strings.Contains(err.Error(), "Method") {
+		t.Errorf("Expected Method error, got %v", err)
+	}
+}
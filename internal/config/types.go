@@ -9,17 +9,51 @@ const (
 	GitHubModeAuto = "auto"
 )
 
+// Ticket tracker provider constants
+const (
+	TrackerClickUp = "clickup"
+	TrackerJira    = "jira"
+	TrackerLinear  = "linear"
+)
+
+// CI provider constants
+const (
+	CIProviderCircleCI      = "circleci"
+	CIProviderGitHubActions = "github-actions"
+	CIProviderGitLab        = "gitlab"
+)
+
+// Merge strategy constants
+const (
+	MergeStrategyAPI     = "api"
+	MergeStrategyComment = "comment"
+)
+
+// Jira deployment constants
+const (
+	JiraDeploymentCloud  = "cloud"
+	JiraDeploymentServer = "server"
+)
+
 // Config represents the application configuration
 type Config struct {
+	Tracker    TrackerConfig     `yaml:"tracker" mapstructure:"tracker"`
 	ClickUp    ClickUpConfig     `yaml:"clickup" mapstructure:"clickup" validate:"required"`
+	Jira       JiraConfig        `yaml:"jira" mapstructure:"jira"`
+	Linear     LinearConfig      `yaml:"linear" mapstructure:"linear"`
 	GitHub     GitHubConfig      `yaml:"github" mapstructure:"github" validate:"required"`
+	GitLab     GitLabConfig      `yaml:"gitlab" mapstructure:"gitlab"`
 	Git        GitConfig         `yaml:"git" mapstructure:"git" validate:"required"`
+	CI         CIConfig          `yaml:"ci" mapstructure:"ci"`
 	CircleCI   CircleCIConfig    `yaml:"circleci" mapstructure:"circleci"`
+	Merge      MergeConfig       `yaml:"merge" mapstructure:"merge"`
 	Claude     ClaudeConfig      `yaml:"claude" mapstructure:"claude"`
 	Workspaces []WorkspaceConfig `yaml:"workspaces" mapstructure:"workspaces" validate:"required,min=1"`
 	Defaults   DefaultsConfig    `yaml:"defaults" mapstructure:"defaults"`
 	AI         AIConfig          `yaml:"ai" mapstructure:"ai"`
 	UI         UIConfig          `yaml:"ui" mapstructure:"ui"`
+	HTTP       HTTPConfig        `yaml:"http" mapstructure:"http"`
+	Cache      CacheConfig       `yaml:"cache" mapstructure:"cache"`
 }
 
 // ClickUpConfig holds ClickUp API configuration
@@ -30,6 +64,27 @@ type ClickUpConfig struct {
 	TeamID      string `yaml:"team_id" mapstructure:"team_id" validate:"required"`
 }
 
+// TrackerConfig selects the ticket tracker
+type TrackerConfig struct {
+	Provider string `yaml:"provider" mapstructure:"provider"` // "clickup", "jira", or "linear" (default: clickup)
+}
+
+// JiraConfig holds Jira Cloud or Jira Server/Data Center configuration
+type JiraConfig struct {
+	BaseURL    string `yaml:"base_url" mapstructure:"base_url" validate:"required,url"`
+	Email      string `yaml:"email" mapstructure:"email"` // Required for Jira Cloud
+	APIToken   string `yaml:"api_token" mapstructure:"api_token" validate:"required"`
+	Deployment string `yaml:"deployment" mapstructure:"deployment"` // "cloud" or "server" (default: detected from base_url)
+	ProjectKey string `yaml:"project_key" mapstructure:"project_key"`
+	IssueType  string `yaml:"issue_type" mapstructure:"issue_type"` // Issue type for new tickets (default: Task)
+}
+
+// LinearConfig holds Linear API configuration
+type LinearConfig struct {
+	APIKey  string `yaml:"api_key" mapstructure:"api_key" validate:"required"`
+	TeamKey string `yaml:"team_key" mapstructure:"team_key"` // e.g. ENG; scopes searches and new tickets
+}
+
 // GitHubConfig holds GitHub configuration
 type GitHubConfig struct {
 	Token    string `yaml:"token" mapstructure:"token"`
@@ -39,15 +94,38 @@ type GitHubConfig struct {
 	Mode     string `yaml:"mode" mapstructure:"mode"` // "api", "cli", or "auto" (default: auto)
 }
 
+// GitLabConfig holds GitLab configuration, used when the origin remote is
+// hosted on GitLab
+type GitLabConfig struct {
+	Token   string   `yaml:"token" mapstructure:"token"`
+	Hosts   []string `yaml:"hosts" mapstructure:"hosts"`     // Self-hosted GitLab hosts, besides gitlab.com and gitlab.*
+	Project string   `yaml:"project" mapstructure:"project"` // e.g. group/repo (default: from the origin remote)
+}
+
 // GitConfig holds Git-related configuration
 type GitConfig struct {
 	BranchPrefix string `yaml:"branch_prefix" mapstructure:"branch_prefix" validate:"required"`
 	BaseBranch   string `yaml:"base_branch" mapstructure:"base_branch" validate:"required"`
+	BranchStyle  string `yaml:"branch_style" mapstructure:"branch_style" validate:"omitempty,oneof=default linear"` // "default" or "linear"
+}
+
+// CIConfig selects the CI provider
+type CIConfig struct {
+	Provider string `yaml:"provider" mapstructure:"provider" validate:"omitempty,oneof=circleci github-actions gitlab"` // Default: detected from the repository
 }
 
 // CircleCIConfig holds CircleCI API configuration
 type CircleCIConfig struct {
-	APIToken string `yaml:"api_token" mapstructure:"api_token"`
+	APIToken      string   `yaml:"api_token" mapstructure:"api_token"`
+	ProjectSlug   string   `yaml:"project_slug" mapstructure:"project_slug"`     // Default: gh/ or bb/ slug of the origin remote
+	ExtraProjects []string `yaml:"extra_projects" mapstructure:"extra_projects"` // Other projects whose pipelines ci-status includes
+}
+
+// MergeConfig holds settings for vibe merge
+type MergeConfig struct {
+	Strategy string `yaml:"strategy" mapstructure:"strategy" validate:"omitempty,oneof=api comment"`     // "api" or "comment" (default: api)
+	Method   string `yaml:"method" mapstructure:"method" validate:"omitempty,oneof=merge squash rebase"` // Merge method of the api strategy (default: merge)
+	Comment  string `yaml:"comment" mapstructure:"comment"`                                              // Comment posted by the comment strategy (default: /merge)
 }
 
 // ClaudeConfig holds Claude AI configuration
@@ -78,6 +156,39 @@ type UIConfig struct {
 	ColorEnabled bool `yaml:"color_enabled" mapstructure:"color_enabled"`
 }
 
+// HTTPConfig holds per-service HTTP retry budgets
+type HTTPConfig struct {
+	ClickUp  RetryConfig `yaml:"clickup" mapstructure:"clickup"`
+	Jira     RetryConfig `yaml:"jira" mapstructure:"jira"`
+	Linear   RetryConfig `yaml:"linear" mapstructure:"linear"`
+	GitHub   RetryConfig `yaml:"github" mapstructure:"github"`
+	GitLab   RetryConfig `yaml:"gitlab" mapstructure:"gitlab"`
+	CircleCI RetryConfig `yaml:"circleci" mapstructure:"circleci"`
+	Claude   RetryConfig `yaml:"claude" mapstructure:"claude"`
+}
+
+// RetryConfig holds the retry budget for a single service
+type RetryConfig struct {
+	MaxRetries int           `yaml:"max_retries" mapstructure:"max_retries" validate:"min=0"`
+	MaxWait    time.Duration `yaml:"max_wait" mapstructure:"max_wait" validate:"min=0"` // Longest Retry-After/rate-limit wait to honor
+}
+
+// CacheConfig holds on-disk response cache configuration
+type CacheConfig struct {
+	Enabled  bool               `yaml:"enabled" mapstructure:"enabled"`
+	Refresh  bool               `yaml:"-" mapstructure:"-"` // Set by --no-cache: skip cached responses but store fresh ones
+	ClickUp  ServiceCacheConfig `yaml:"clickup" mapstructure:"clickup"`
+	Jira     ServiceCacheConfig `yaml:"jira" mapstructure:"jira"`
+	GitHub   ServiceCacheConfig `yaml:"github" mapstructure:"github"`
+	GitLab   ServiceCacheConfig `yaml:"gitlab" mapstructure:"gitlab"`
+	CircleCI ServiceCacheConfig `yaml:"circleci" mapstructure:"circleci"`
+}
+
+// ServiceCacheConfig holds cache settings for a single service
+type ServiceCacheConfig struct {
+	TTL time.Duration `yaml:"ttl" mapstructure:"ttl" validate:"min=0"` // How long responses are reused without revalidating
+}
+
 // HTTPClientConfig holds HTTP client configuration
 type HTTPClientConfig struct {
 	Timeout     time.Duration
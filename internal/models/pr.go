@@ -43,10 +43,12 @@ type GitHubUser struct {
 // PRStatus represents the status of a pull request
 type PRStatus struct {
 	Number       int
+	Title        string
 	State        string
 	Draft        bool
 	Merged       bool
 	Mergeable    bool
+	BaseBranch   string
 	ReviewStatus ReviewStatus
 	CheckStatus  CheckStatus
 	URL          string
@@ -58,7 +60,14 @@ type ReviewStatus struct {
 	ChangesRequested int
 	Commented        int
 	Pending          int
-	OverallStatus    string // "approved", "changes_requested", "pending"
+	OverallStatus    string   // "approved", "changes_requested", "pending"
+	Reviews          []Review // Latest review state per reviewer
+}
+
+// Review represents the latest review state of a single reviewer
+type Review struct {
+	Reviewer string
+	State    string // "APPROVED", "CHANGES_REQUESTED", "COMMENTED", "DISMISSED"
 }
 
 // CheckStatus represents CI check status
@@ -67,7 +76,14 @@ type CheckStatus struct {
 	Passed        int
 	Failed        int
 	Pending       int
-	OverallStatus string // "success", "failure", "pending"
+	OverallStatus string  // "success", "failure", "pending"
+	Checks        []Check // Individual check results
+}
+
+// Check represents the result of a single CI check
+type Check struct {
+	Name   string
+	Status string // "success", "failure", "pending"
 }
 
 // PRCreateRequest represents a PR creation request
@@ -77,4 +93,131 @@ type PRCreateRequest struct {
 	Head  string
 	Base  string
 	Draft bool
+	PRMetadata
+}
+
+// PRUpdateRequest represents a PR update request. Nil fields are left unchanged.
+type PRUpdateRequest struct {
+	Title *string
+	Body  *string
+	Base  *string // Branch to retarget the PR to
+}
+
+// PRMetadata holds the reviewers, labels, and assignees of a pull request
+type PRMetadata struct {
+	Reviewers     []string // GitHub usernames
+	TeamReviewers []string // Team slugs, optionally prefixed with the org (org/team)
+	Labels        []string
+	Assignees     []string
+}
+
+// IsEmpty reports whether there is nothing to add to a pull request
+func (m *PRMetadata) IsEmpty() bool {
+	return len(m.Reviewers) == 0 && len(m.TeamReviewers) == 0 && len(m.Labels) == 0 && len(m.Assignees) == 0
+}
+
+// ReviewThread represents a thread of review comments on a line of a file
+type ReviewThread struct {
+	ID         string // GraphQL node ID
+	Number     int    // Position among the pull request's threads, starting at 1
+	Path       string
+	Line       int // Line in the latest diff, or the original line when outdated
+	StartLine  int // First line of multi-line comments, 0 otherwise
+	DiffHunk   string
+	IsResolved bool
+	IsOutdated bool
+	ResolvedBy string
+	Comments   []ReviewComment
+}
+
+// ReviewComment represents a comment in a review thread
+type ReviewComment struct {
+	ID        string
+	Author    string
+	Body      string
+	URL       string
+	CreatedAt time.Time
+}
+
+// PRReview is a pull request review to submit
+type PRReview struct {
+	Event    string // APPROVE, REQUEST_CHANGES, or COMMENT
+	Body     string
+	CommitID string // Head commit the comments refer to, optional
+	Comments []DraftReviewComment
+}
+
+// DraftReviewComment is an inline comment of a review that hasn't been submitted
+type DraftReviewComment struct {
+	Path      string
+	Line      int    // Last line the comment applies to, 0 when it isn't on a line
+	StartLine int    // First line of multi-line comments, 0 otherwise
+	Side      string // RIGHT for added and unchanged lines, LEFT for deleted lines
+	Body      string
+}
+
+// SummarizeReviews builds a review status from the latest review state of
+// each reviewer
+func SummarizeReviews(reviews []Review) ReviewStatus {
+	status := ReviewStatus{Reviews: reviews}
+
+	for _, review := range reviews {
+		switch review.State {
+		case "APPROVED":
+			status.Approved++
+		case "CHANGES_REQUESTED":
+			status.ChangesRequested++
+		case "COMMENTED":
+			status.Commented++
+		default:
+			status.Pending++
+		}
+	}
+
+	status.OverallStatus = determineOverallReviewStatus(status)
+	return status
+}
+
+// SummarizeChecks builds a check status from individual check results
+func SummarizeChecks(checks []Check) CheckStatus {
+	status := CheckStatus{
+		Total:  len(checks),
+		Checks: checks,
+	}
+
+	for _, check := range checks {
+		switch check.Status {
+		case "success":
+			status.Passed++
+		case "failure":
+			status.Failed++
+		default:
+			status.Pending++
+		}
+	}
+
+	status.OverallStatus = determineOverallCheckStatus(status)
+	return status
+}
+
+func determineOverallReviewStatus(rs ReviewStatus) string {
+	if rs.ChangesRequested > 0 {
+		return "changes_requested"
+	} else if rs.Approved > 0 {
+		return "approved"
+	} else if rs.Commented > 0 || rs.Pending > 0 {
+		return "pending"
+	}
+	return "none"
+}
+
+func determineOverallCheckStatus(cs CheckStatus) string {
+	if cs.Failed > 0 {
+		return "failure"
+	} else if cs.Pending > 0 {
+		return "pending"
+	} else if cs.Passed > 0 {
+		return "success"
+	}
+	return "none"
 }
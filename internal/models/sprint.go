@@ -16,7 +16,7 @@ func (s *Sprint) IsActive() bool {
 	return now.After(s.StartDate) && now.Before(s.EndDate)
 }
 
-// Folder represents a ClickUp folder (used for sprint detection)
+// Folder represents a ClickUp folder or list (used for sprint detection)
 type Folder struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
@@ -82,14 +82,35 @@ func (t *Task) GetCustomFieldString(name string) string {
 
 // TaskCreateRequest represents a request to create a task
 type TaskCreateRequest struct {
-	Name         string                 `json:"name"`
-	Description  string                 `json:"description,omitempty"`
-	Status       string                 `json:"status,omitempty"`
-	Priority     int                    `json:"priority,omitempty"`
-	DueDate      *time.Time             `json:"due_date,omitempty"`
-	Assignees    []int                  `json:"assignees,omitempty"`
-	Tags         []string               `json:"tags,omitempty"`
-	CustomFields map[string]interface{} `json:"custom_fields,omitempty"`
+	Name                string             `json:"name"`
+	Description         string             `json:"description,omitempty"`
+	MarkdownDescription string             `json:"markdown_description,omitempty"`
+	Status              string             `json:"status,omitempty"`
+	Priority            int                `json:"priority,omitempty"`
+	DueDate             *int64             `json:"due_date,omitempty"` // Unix milliseconds
+	Assignees           []int              `json:"assignees,omitempty"`
+	Tags                []string           `json:"tags,omitempty"`
+	CustomFields        []CustomFieldValue `json:"custom_fields,omitempty"`
+}
+
+// CustomFieldValue sets a custom field value when creating a task
+type CustomFieldValue struct {
+	ID    string      `json:"id"`
+	Value interface{} `json:"value"`
+}
+
+// CustomFieldDefinition describes a custom field available on a list
+type CustomFieldDefinition struct {
+	ID      string              `json:"id"`
+	Name    string              `json:"name"`
+	Type    string              `json:"type"`
+	Options []CustomFieldOption `json:"options,omitempty"`
+}
+
+// CustomFieldOption represents an option of a drop_down or labels custom field
+type CustomFieldOption struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
 }
 
 // TaskUpdateRequest represents a request to update a task
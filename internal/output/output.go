@@ -0,0 +1,83 @@
+// Package output renders command results in machine-readable formats.
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// SchemaVersion is the version of the structured output schema.
+// Bump it whenever a field is renamed, removed, or changes meaning;
+// adding new fields does not require a bump.
+const SchemaVersion = 1
+
+// Format is an output format selected with --output
+type Format string
+
+const (
+	// FormatText renders human-readable, colored output (default)
+	FormatText Format = "text"
+	// FormatJSON renders the versioned schema as JSON
+	FormatJSON Format = "json"
+	// FormatYAML renders the versioned schema as YAML
+	FormatYAML Format = "yaml"
+)
+
+// ParseFormat validates an --output flag value. An empty value selects text.
+func ParseFormat(value string) (Format, error) {
+	switch Format(strings.ToLower(strings.TrimSpace(value))) {
+	case "", FormatText:
+		return FormatText, nil
+	case FormatJSON:
+		return FormatJSON, nil
+	case FormatYAML, "yml":
+		return FormatYAML, nil
+	default:
+		return "", fmt.Errorf("invalid output format: %s (must be one of: text, json, yaml)", value)
+	}
+}
+
+// IsStructured reports whether the format is machine-readable
+func (f Format) IsStructured() bool {
+	return f == FormatJSON || f == FormatYAML
+}
+
+// Envelope wraps every structured result so consumers can check the schema
+// version and kind before decoding data
+type Envelope struct {
+	SchemaVersion int    `json:"schema_version" yaml:"schema_version"`
+	Kind          string `json:"kind" yaml:"kind"`
+	Data          any    `json:"data" yaml:"data"`
+}
+
+// Write renders data of the given kind to w in the given structured format
+func Write(w io.Writer, format Format, kind string, data any) error {
+	envelope := Envelope{
+		SchemaVersion: SchemaVersion,
+		Kind:          kind,
+		Data:          data,
+	}
+
+	switch format {
+	case FormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(envelope); err != nil {
+			return fmt.Errorf("failed to encode JSON output: %w", err)
+		}
+		return nil
+	case FormatYAML:
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(envelope); err != nil {
+			return fmt.Errorf("failed to encode YAML output: %w", err)
+		}
+		return encoder.Close()
+	default:
+		return fmt.Errorf("output format %q is not structured", format)
+	}
+}
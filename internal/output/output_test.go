@@ -0,0 +1,116 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.yaml.in/yaml/v3"
+
+	"github.com/rithyhuot/vibe/internal/models"
+)
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Format
+		wantErr  bool
+	}{
+		{"", FormatText, false},
+		{"text", FormatText, false},
+		{"json", FormatJSON, false},
+		{"JSON", FormatJSON, false},
+		{"yaml", FormatYAML, false},
+		{"yml", FormatYAML, false},
+		{"xml", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			format, err := ParseFormat(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, format)
+		})
+	}
+}
+
+func TestWrite_JSONEnvelope(t *testing.T) {
+	var buf bytes.Buffer
+	task := NewTask(&models.Task{
+		ID:       "abc123",
+		Name:     "Fix login",
+		Status:   models.Status{Status: "in progress", Type: "custom"},
+		Priority: &models.Priority{Priority: "high"},
+		Tags:     []models.Tag{{Name: "backend"}},
+	})
+
+	require.NoError(t, Write(&buf, FormatJSON, KindTask, task))
+
+	var decoded struct {
+		SchemaVersion int    `json:"schema_version"`
+		Kind          string `json:"kind"`
+		Data          Task   `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+
+	assert.Equal(t, SchemaVersion, decoded.SchemaVersion)
+	assert.Equal(t, KindTask, decoded.Kind)
+	assert.Equal(t, "abc123", decoded.Data.ID)
+	assert.Equal(t, "in progress", decoded.Data.Status)
+	assert.Equal(t, "high", decoded.Data.Priority)
+	assert.Equal(t, []string{"backend"}, decoded.Data.Tags)
+	assert.Empty(t, decoded.Data.Assignees)
+}
+
+func TestWrite_YAMLEnvelope(t *testing.T) {
+	var buf bytes.Buffer
+	status := NewPRStatus(&models.PRStatus{
+		Number: 42,
+		Title:  "Add feature",
+		ReviewStatus: models.ReviewStatus{
+			Approved:      1,
+			OverallStatus: "approved",
+			Reviews:       []models.Review{{Reviewer: "alice", State: "APPROVED"}},
+		},
+		CheckStatus: models.CheckStatus{
+			Total:         1,
+			Failed:        1,
+			OverallStatus: "failure",
+			Checks:        []models.Check{{Name: "lint", Status: "failure"}},
+		},
+	})
+
+	require.NoError(t, Write(&buf, FormatYAML, KindPRStatus, status))
+
+	var decoded struct {
+		SchemaVersion int      `yaml:"schema_version"`
+		Kind          string   `yaml:"kind"`
+		Data          PRStatus `yaml:"data"`
+	}
+	require.NoError(t, yaml.Unmarshal(buf.Bytes(), &decoded))
+
+	assert.Equal(t, SchemaVersion, decoded.SchemaVersion)
+	assert.Equal(t, KindPRStatus, decoded.Kind)
+	assert.Equal(t, 42, decoded.Data.Number)
+	assert.Equal(t, []Reviewer{{Login: "alice", State: "APPROVED"}}, decoded.Data.Reviews.Reviewers)
+	assert.Equal(t, []Check{{Name: "lint", Status: "failure"}}, decoded.Data.Checks.Items)
+}
+
+func TestWrite_NilData(t *testing.T) {
+	var buf bytes.Buffer
+
+	require.NoError(t, Write(&buf, FormatJSON, KindCIStatus, nil))
+	assert.JSONEq(t, `{"schema_version": 1, "kind": "CIStatus", "data": null}`, buf.String())
+}
+
+func TestWrite_TextFormatRejected(t *testing.T) {
+	var buf bytes.Buffer
+
+	assert.Error(t, Write(&buf, FormatText, KindTask, nil))
+}
@@ -0,0 +1,718 @@
+package output
+
+import (
+	"sort"
+	"time"
+
+	"github.com/rithyhuot/vibe/internal/models"
+	"github.com/rithyhuot/vibe/internal/services/ci"
+	"github.com/rithyhuot/vibe/internal/services/circleci"
+)
+
+// Kinds identify the type of data in an Envelope
+const (
+	KindTask          = "Task"
+	KindSprintBoard   = "SprintBoard"
+	KindPRStatus      = "PRStatus"
+	KindCIStatus      = "CIStatus"
+	KindCIFailure     = "CIFailure"
+	KindCIArtifacts   = "CIArtifactList"
+	KindFlakyTests    = "FlakyTestList"
+	KindCIHistory     = "CIHistory"
+	KindCIConfig      = "CIConfigValidation"
+	KindIssue         = "Issue"
+	KindIssueList     = "IssueList"
+	KindReviewThreads = "ReviewThreadList"
+)
+
+// Task is the structured form of a ClickUp task
+type Task struct {
+	ID           string        `json:"id" yaml:"id"`
+	Name         string        `json:"name" yaml:"name"`
+	Description  string        `json:"description" yaml:"description"`
+	Status       string        `json:"status" yaml:"status"`
+	StatusType   string        `json:"status_type" yaml:"status_type"`
+	Priority     string        `json:"priority,omitempty" yaml:"priority,omitempty"`
+	DueDate      *time.Time    `json:"due_date,omitempty" yaml:"due_date,omitempty"`
+	StartDate    *time.Time    `json:"start_date,omitempty" yaml:"start_date,omitempty"`
+	TimeSpentMs  int64         `json:"time_spent_ms" yaml:"time_spent_ms"`
+	Assignees    []User        `json:"assignees" yaml:"assignees"`
+	Tags         []string      `json:"tags" yaml:"tags"`
+	CustomFields []CustomField `json:"custom_fields" yaml:"custom_fields"`
+	URL          string        `json:"url" yaml:"url"`
+	ListID       string        `json:"list_id" yaml:"list_id"`
+	FolderID     string        `json:"folder_id" yaml:"folder_id"`
+	SpaceID      string        `json:"space_id" yaml:"space_id"`
+}
+
+// User is the structured form of a ClickUp user
+type User struct {
+	ID       int    `json:"id" yaml:"id"`
+	Username string `json:"username" yaml:"username"`
+	Email    string `json:"email,omitempty" yaml:"email,omitempty"`
+}
+
+// CustomField is the structured form of a ClickUp custom field value
+type CustomField struct {
+	ID    string `json:"id" yaml:"id"`
+	Name  string `json:"name" yaml:"name"`
+	Type  string `json:"type" yaml:"type"`
+	Value any    `json:"value" yaml:"value"`
+}
+
+// SprintBoard is the structured form of a workspace's sprint board
+type SprintBoard struct {
+	Workspace string `json:"workspace" yaml:"workspace"`
+	SprintID  string `json:"sprint_id" yaml:"sprint_id"`
+	Sprint    string `json:"sprint" yaml:"sprint"`
+	Tasks     []Task `json:"tasks" yaml:"tasks"`
+}
+
+// PRStatus is the structured form of a pull request's review and check status
+type PRStatus struct {
+	Number       int           `json:"number" yaml:"number"`
+	Title        string        `json:"title" yaml:"title"`
+	URL          string        `json:"url" yaml:"url"`
+	State        string        `json:"state" yaml:"state"`
+	Draft        bool          `json:"draft" yaml:"draft"`
+	Merged       bool          `json:"merged" yaml:"merged"`
+	Mergeable    bool          `json:"mergeable" yaml:"mergeable"`
+	ReadyToMerge bool          `json:"ready_to_merge" yaml:"ready_to_merge"`
+	Reviews      ReviewSummary `json:"reviews" yaml:"reviews"`
+	Checks       CheckSummary  `json:"checks" yaml:"checks"`
+}
+
+// ReviewSummary is the structured form of a pull request's reviews
+type ReviewSummary struct {
+	Status           string     `json:"status" yaml:"status"`
+	Approved         int        `json:"approved" yaml:"approved"`
+	ChangesRequested int        `json:"changes_requested" yaml:"changes_requested"`
+	Commented        int        `json:"commented" yaml:"commented"`
+	Reviewers        []Reviewer `json:"reviewers" yaml:"reviewers"`
+}
+
+// Reviewer is the latest review state of a single reviewer
+type Reviewer struct {
+	Login string `json:"login" yaml:"login"`
+	State string `json:"state" yaml:"state"`
+}
+
+// CheckSummary is the structured form of a pull request's CI checks
+type CheckSummary struct {
+	Status  string  `json:"status" yaml:"status"`
+	Total   int     `json:"total" yaml:"total"`
+	Passed  int     `json:"passed" yaml:"passed"`
+	Failed  int     `json:"failed" yaml:"failed"`
+	Pending int     `json:"pending" yaml:"pending"`
+	Items   []Check `json:"items" yaml:"items"`
+}
+
+// Check is the result of a single CI check
+type Check struct {
+	Name   string `json:"name" yaml:"name"`
+	Status string `json:"status" yaml:"status"`
+}
+
+// CIStatus is the structured form of a branch's latest CircleCI pipeline
+type CIStatus struct {
+	Branch         string      `json:"branch" yaml:"branch"`
+	ProjectSlug    string      `json:"project_slug" yaml:"project_slug"`
+	PipelineNumber int         `json:"pipeline_number" yaml:"pipeline_number"`
+	PipelineID     string      `json:"pipeline_id" yaml:"pipeline_id"`
+	Revision       string      `json:"revision,omitempty" yaml:"revision,omitempty"`
+	Status         string      `json:"status" yaml:"status"`
+	Workflows      []Workflow  `json:"workflows" yaml:"workflows"`
+	FailedJobs     []FailedJob `json:"failed_jobs" yaml:"failed_jobs"`
+	Projects       []Project   `json:"projects,omitempty" yaml:"projects,omitempty"`
+}
+
+// Project is the structured form of the pipeline of one CircleCI project,
+// when a status spans several
+type Project struct {
+	ProjectSlug    string `json:"project_slug" yaml:"project_slug"`
+	PipelineNumber int    `json:"pipeline_number" yaml:"pipeline_number"`
+	PipelineID     string `json:"pipeline_id" yaml:"pipeline_id"`
+}
+
+// Workflow is the structured form of a CircleCI workflow
+type Workflow struct {
+	ID          string `json:"id" yaml:"id"`
+	Name        string `json:"name" yaml:"name"`
+	Status      string `json:"status" yaml:"status"`
+	Jobs        []Job  `json:"jobs" yaml:"jobs"`
+	ProjectSlug string `json:"project_slug,omitempty" yaml:"project_slug,omitempty"`
+}
+
+// Job is the structured form of a CircleCI job
+type Job struct {
+	Name      string     `json:"name" yaml:"name"`
+	Number    int        `json:"number,omitempty" yaml:"number,omitempty"`
+	Status    string     `json:"status" yaml:"status"`
+	Type      string     `json:"type" yaml:"type"`
+	StartedAt *time.Time `json:"started_at,omitempty" yaml:"started_at,omitempty"`
+	StoppedAt *time.Time `json:"stopped_at,omitempty" yaml:"stopped_at,omitempty"`
+}
+
+// FailedJob is the structured form of a failed CircleCI job and its failed tests
+type FailedJob struct {
+	Name        string       `json:"name" yaml:"name"`
+	Number      int          `json:"number" yaml:"number"`
+	Workflow    string       `json:"workflow" yaml:"workflow"`
+	WebURL      string       `json:"web_url" yaml:"web_url"`
+	FailedTests []FailedTest `json:"failed_tests" yaml:"failed_tests"`
+	ProjectSlug string       `json:"project_slug,omitempty" yaml:"project_slug,omitempty"`
+}
+
+// FailedTest is the structured form of a failed test result
+type FailedTest struct {
+	Name           string  `json:"name" yaml:"name"`
+	Classname      string  `json:"classname,omitempty" yaml:"classname,omitempty"`
+	File           string  `json:"file,omitempty" yaml:"file,omitempty"`
+	Message        string  `json:"message,omitempty" yaml:"message,omitempty"`
+	RunTimeSeconds float64 `json:"run_time_seconds" yaml:"run_time_seconds"`
+	KnownFlake     bool    `json:"known_flake,omitempty" yaml:"known_flake,omitempty"`
+}
+
+// CIFailure is the structured form of a failed CI job's step output.
+// Explanation is Claude's diagnosis of the failure, with --explain.
+type CIFailure struct {
+	ProjectSlug string       `json:"project_slug" yaml:"project_slug"`
+	JobNumber   int          `json:"job_number" yaml:"job_number"`
+	Steps       []FailedStep `json:"steps" yaml:"steps"`
+	Explanation string       `json:"explanation,omitempty" yaml:"explanation,omitempty"`
+}
+
+// FailedStep is the structured form of a failed job step
+type FailedStep struct {
+	Name    string         `json:"name" yaml:"name"`
+	Actions []FailedAction `json:"actions" yaml:"actions"`
+}
+
+// FailedAction is the structured form of a failed step action and its output,
+// with the failures parsed from the output
+type FailedAction struct {
+	Name     string    `json:"name" yaml:"name"`
+	Status   string    `json:"status" yaml:"status"`
+	ExitCode *int      `json:"exit_code,omitempty" yaml:"exit_code,omitempty"`
+	Output   string    `json:"output" yaml:"output"`
+	Failures []Failure `json:"failures,omitempty" yaml:"failures,omitempty"`
+}
+
+// Failure is the structured form of a test failure, lint issue, or compile
+// error found in step output
+type Failure struct {
+	Tool    string `json:"tool" yaml:"tool"`
+	File    string `json:"file,omitempty" yaml:"file,omitempty"`
+	Line    int    `json:"line,omitempty" yaml:"line,omitempty"`
+	Column  int    `json:"column,omitempty" yaml:"column,omitempty"`
+	Test    string `json:"test,omitempty" yaml:"test,omitempty"`
+	Message string `json:"message" yaml:"message"`
+}
+
+// CIArtifactList is the structured form of the artifacts of a CI job
+type CIArtifactList struct {
+	JobNumber int          `json:"job_number" yaml:"job_number"`
+	Artifacts []CIArtifact `json:"artifacts" yaml:"artifacts"`
+}
+
+// CIArtifact is the structured form of a CI job artifact. File is the local
+// path it was downloaded to, if it was.
+type CIArtifact struct {
+	Path      string `json:"path" yaml:"path"`
+	NodeIndex int    `json:"node_index" yaml:"node_index"`
+	URL       string `json:"url" yaml:"url"`
+	File      string `json:"file,omitempty" yaml:"file,omitempty"`
+}
+
+// FlakyTestList is the structured form of the flaky tests of a branch
+type FlakyTestList struct {
+	ProjectSlug string      `json:"project_slug" yaml:"project_slug"`
+	Branch      string      `json:"branch" yaml:"branch"`
+	Pipelines   int         `json:"pipelines" yaml:"pipelines"`
+	Tests       []FlakyTest `json:"tests" yaml:"tests"`
+}
+
+// FlakyTest is the structured form of a flaky test. Runs, failures, and the
+// flake rate cover the analyzed pipelines; times_flaked comes from Insights.
+type FlakyTest struct {
+	Name        string  `json:"name" yaml:"name"`
+	Classname   string  `json:"classname,omitempty" yaml:"classname,omitempty"`
+	File        string  `json:"file,omitempty" yaml:"file,omitempty"`
+	JobName     string  `json:"job_name,omitempty" yaml:"job_name,omitempty"`
+	Runs        int     `json:"runs" yaml:"runs"`
+	Failures    int     `json:"failures" yaml:"failures"`
+	FlakeRate   float64 `json:"flake_rate" yaml:"flake_rate"`
+	SameCommit  bool    `json:"same_commit" yaml:"same_commit"`
+	TimesFlaked int     `json:"times_flaked" yaml:"times_flaked"`
+}
+
+// CIHistory is the structured form of the recent pipelines of a branch,
+// with the duration percentiles of their jobs
+type CIHistory struct {
+	ProjectSlug  string             `json:"project_slug" yaml:"project_slug"`
+	Branch       string             `json:"branch" yaml:"branch"`
+	Pipelines    []PipelineRun      `json:"pipelines" yaml:"pipelines"`
+	JobDurations []JobDurationTrend `json:"job_durations,omitempty" yaml:"job_durations,omitempty"`
+}
+
+// PipelineRun is the structured form of a pipeline in the history of a branch.
+// Durations are in seconds; queued is the part of wall in which no job ran.
+type PipelineRun struct {
+	Number        int              `json:"number" yaml:"number"`
+	Revision      string           `json:"revision" yaml:"revision"`
+	Actor         string           `json:"actor,omitempty" yaml:"actor,omitempty"`
+	Trigger       string           `json:"trigger,omitempty" yaml:"trigger,omitempty"`
+	CreatedAt     time.Time        `json:"created_at" yaml:"created_at"`
+	Workflows     []WorkflowResult `json:"workflows" yaml:"workflows"`
+	WallSeconds   int64            `json:"wall_seconds" yaml:"wall_seconds"`
+	QueuedSeconds int64            `json:"queued_seconds" yaml:"queued_seconds"`
+}
+
+// WorkflowResult is the outcome of a workflow in a pipeline run
+type WorkflowResult struct {
+	Name   string `json:"name" yaml:"name"`
+	Status string `json:"status" yaml:"status"`
+}
+
+// JobDurationTrend is the structured form of the Insights duration metrics of
+// a job over the last 30 days, in seconds
+type JobDurationTrend struct {
+	Workflow    string  `json:"workflow" yaml:"workflow"`
+	Job         string  `json:"job" yaml:"job"`
+	Runs        int     `json:"runs" yaml:"runs"`
+	SuccessRate float64 `json:"success_rate" yaml:"success_rate"`
+	P50Seconds  int64   `json:"p50_seconds" yaml:"p50_seconds"`
+	P95Seconds  int64   `json:"p95_seconds" yaml:"p95_seconds"`
+}
+
+// CIConfigValidation is the structured form of the result of validating a
+// CircleCI config. CompileErrors are from CircleCI, when the config was compiled.
+type CIConfigValidation struct {
+	Path          string        `json:"path" yaml:"path"`
+	Valid         bool          `json:"valid" yaml:"valid"`
+	Issues        []ConfigIssue `json:"issues" yaml:"issues"`
+	Compiled      bool          `json:"compiled" yaml:"compiled"`
+	CompileErrors []string      `json:"compile_errors,omitempty" yaml:"compile_errors,omitempty"`
+}
+
+// ConfigIssue is the structured form of a problem found in a CircleCI config
+type ConfigIssue struct {
+	Severity string `json:"severity" yaml:"severity"`
+	Path     string `json:"path" yaml:"path"`
+	Line     int    `json:"line,omitempty" yaml:"line,omitempty"`
+	Message  string `json:"message" yaml:"message"`
+}
+
+// Issue is the structured form of a GitHub issue
+type Issue struct {
+	Number    int            `json:"number" yaml:"number"`
+	Title     string         `json:"title" yaml:"title"`
+	Body      string         `json:"body" yaml:"body"`
+	State     string         `json:"state" yaml:"state"`
+	URL       string         `json:"url" yaml:"url"`
+	Author    string         `json:"author" yaml:"author"`
+	Assignees []string       `json:"assignees" yaml:"assignees"`
+	Labels    []string       `json:"labels" yaml:"labels"`
+	Milestone string         `json:"milestone,omitempty" yaml:"milestone,omitempty"`
+	Projects  []string       `json:"projects" yaml:"projects"`
+	Comments  []IssueComment `json:"comments,omitempty" yaml:"comments,omitempty"`
+	CreatedAt time.Time      `json:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at" yaml:"updated_at"`
+	ClosedAt  *time.Time     `json:"closed_at,omitempty" yaml:"closed_at,omitempty"`
+}
+
+// IssueComment is the structured form of a GitHub issue comment
+type IssueComment struct {
+	ID        int       `json:"id" yaml:"id"`
+	Author    string    `json:"author" yaml:"author"`
+	Body      string    `json:"body" yaml:"body"`
+	CreatedAt time.Time `json:"created_at" yaml:"created_at"`
+}
+
+// ReviewThreadList is the structured form of a pull request's review threads
+type ReviewThreadList struct {
+	PRNumber int            `json:"pr_number" yaml:"pr_number"`
+	Threads  []ReviewThread `json:"threads" yaml:"threads"`
+}
+
+// ReviewThread is the structured form of a review thread on a line of a file
+type ReviewThread struct {
+	ID         string          `json:"id" yaml:"id"`
+	Number     int             `json:"number" yaml:"number"`
+	Path       string          `json:"path" yaml:"path"`
+	Line       int             `json:"line,omitempty" yaml:"line,omitempty"`
+	StartLine  int             `json:"start_line,omitempty" yaml:"start_line,omitempty"`
+	DiffHunk   string          `json:"diff_hunk" yaml:"diff_hunk"`
+	Resolved   bool            `json:"resolved" yaml:"resolved"`
+	Outdated   bool            `json:"outdated" yaml:"outdated"`
+	ResolvedBy string          `json:"resolved_by,omitempty" yaml:"resolved_by,omitempty"`
+	Comments   []ReviewComment `json:"comments" yaml:"comments"`
+}
+
+// ReviewComment is the structured form of a comment in a review thread
+type ReviewComment struct {
+	ID        string    `json:"id" yaml:"id"`
+	Author    string    `json:"author" yaml:"author"`
+	Body      string    `json:"body" yaml:"body"`
+	URL       string    `json:"url" yaml:"url"`
+	CreatedAt time.Time `json:"created_at" yaml:"created_at"`
+}
+
+// NewTask converts a ClickUp task to its structured form
+func NewTask(task *models.Task) Task {
+	result := Task{
+		ID:           task.ID,
+		Name:         task.Name,
+		Description:  task.Description,
+		Status:       task.Status.Status,
+		StatusType:   task.Status.Type,
+		DueDate:      task.DueDate,
+		StartDate:    task.StartDate,
+		TimeSpentMs:  task.TimeSpent,
+		Assignees:    make([]User, len(task.Assignees)),
+		Tags:         make([]string, len(task.Tags)),
+		CustomFields: make([]CustomField, len(task.CustomFields)),
+		URL:          task.URL,
+		ListID:       task.ListID,
+		FolderID:     task.FolderID,
+		SpaceID:      task.SpaceID,
+	}
+
+	if task.Priority != nil {
+		result.Priority = task.Priority.Priority
+	}
+	for i, assignee := range task.Assignees {
+		result.Assignees[i] = User{ID: assignee.ID, Username: assignee.Username, Email: assignee.Email}
+	}
+	for i, tag := range task.Tags {
+		result.Tags[i] = tag.Name
+	}
+	for i, field := range task.CustomFields {
+		result.CustomFields[i] = CustomField{ID: field.ID, Name: field.Name, Type: field.Type, Value: field.Value}
+	}
+
+	return result
+}
+
+// NewTasks converts ClickUp tasks to their structured form
+func NewTasks(tasks []*models.Task) []Task {
+	result := make([]Task, len(tasks))
+	for i, task := range tasks {
+		result[i] = NewTask(task)
+	}
+	return result
+}
+
+// NewPRStatus converts a pull request status to its structured form
+func NewPRStatus(status *models.PRStatus) PRStatus {
+	result := PRStatus{
+		Number:    status.Number,
+		Title:     status.Title,
+		URL:       status.URL,
+		State:     status.State,
+		Draft:     status.Draft,
+		Merged:    status.Merged,
+		Mergeable: status.Mergeable,
+		Reviews: ReviewSummary{
+			Status:           status.ReviewStatus.OverallStatus,
+			Approved:         status.ReviewStatus.Approved,
+			ChangesRequested: status.ReviewStatus.ChangesRequested,
+			Commented:        status.ReviewStatus.Commented,
+			Reviewers:        make([]Reviewer, len(status.ReviewStatus.Reviews)),
+		},
+		Checks: CheckSummary{
+			Status:  status.CheckStatus.OverallStatus,
+			Total:   status.CheckStatus.Total,
+			Passed:  status.CheckStatus.Passed,
+			Failed:  status.CheckStatus.Failed,
+			Pending: status.CheckStatus.Pending,
+			Items:   make([]Check, len(status.CheckStatus.Checks)),
+		},
+	}
+
+	for i, review := range status.ReviewStatus.Reviews {
+		result.Reviews.Reviewers[i] = Reviewer{Login: review.Reviewer, State: review.State}
+	}
+	for i, check := range status.CheckStatus.Checks {
+		result.Checks.Items[i] = Check{Name: check.Name, Status: check.Status}
+	}
+
+	return result
+}
+
+// NewCIStatus converts a CircleCI pipeline status to its structured form
+func NewCIStatus(status *circleci.CIStatus) CIStatus {
+	result := CIStatus{
+		Branch:         status.Branch,
+		ProjectSlug:    status.ProjectSlug,
+		PipelineNumber: status.PipelineNumber,
+		PipelineID:     status.PipelineID,
+		Revision:       status.Revision,
+		Workflows:      make([]Workflow, len(status.Workflows)),
+		FailedJobs:     make([]FailedJob, len(status.FailedJobs)),
+	}
+
+	for i, workflow := range status.Workflows {
+		jobs := make([]Job, len(workflow.Jobs))
+		for j, job := range workflow.Jobs {
+			jobs[j] = Job{
+				Name:      job.Name,
+				Number:    job.JobNumber,
+				Status:    job.Status,
+				Type:      job.Type,
+				StartedAt: job.StartedAt,
+				StoppedAt: job.StoppedAt,
+			}
+		}
+		result.Workflows[i] = Workflow{ID: workflow.ID, Name: workflow.Name, Status: workflow.Status, Jobs: jobs, ProjectSlug: workflow.ProjectSlug}
+	}
+
+	for _, project := range status.Projects {
+		result.Projects = append(result.Projects, Project{
+			ProjectSlug:    project.ProjectSlug,
+			PipelineNumber: project.PipelineNumber,
+			PipelineID:     project.PipelineID,
+		})
+	}
+
+	for i, job := range status.FailedJobs {
+		tests := make([]FailedTest, len(job.FailedTests))
+		for j, test := range job.FailedTests {
+			tests[j] = FailedTest{
+				Name:           test.Name,
+				Classname:      test.Classname,
+				File:           test.File,
+				Message:        test.Message,
+				RunTimeSeconds: test.RunTime,
+				KnownFlake:     test.KnownFlake,
+			}
+		}
+		result.FailedJobs[i] = FailedJob{
+			Name:        job.Name,
+			Number:      job.JobNumber,
+			Workflow:    job.WorkflowName,
+			WebURL:      job.WebURL,
+			FailedTests: tests,
+			ProjectSlug: job.ProjectSlug,
+		}
+	}
+
+	return result
+}
+
+// NewCIFailure converts the failed steps of a CircleCI job to their structured form
+func NewCIFailure(projectSlug string, jobNumber int, steps []circleci.FailedStep) CIFailure {
+	result := CIFailure{
+		ProjectSlug: projectSlug,
+		JobNumber:   jobNumber,
+		Steps:       make([]FailedStep, len(steps)),
+	}
+
+	for i, step := range steps {
+		actions := make([]FailedAction, len(step.Actions))
+		for j, action := range step.Actions {
+			actions[j] = FailedAction{
+				Name:     action.Name,
+				Status:   action.Status,
+				ExitCode: action.ExitCode,
+				Output:   action.Output,
+			}
+			for _, f := range ci.ExtractFailures(action.Output) {
+				actions[j].Failures = append(actions[j].Failures, Failure{
+					Tool:    f.Tool,
+					File:    f.File,
+					Line:    f.Line,
+					Column:  f.Column,
+					Test:    f.Test,
+					Message: f.Message,
+				})
+			}
+		}
+		result.Steps[i] = FailedStep{Name: step.Name, Actions: actions}
+	}
+
+	return result
+}
+
+// NewIssue converts a GitHub issue to its structured form
+func NewIssue(issue *models.Issue) Issue {
+	result := Issue{
+		Number:    issue.Number,
+		Title:     issue.Title,
+		Body:      issue.Body,
+		State:     issue.State,
+		URL:       issue.URL,
+		Author:    issue.User.Login,
+		Assignees: make([]string, len(issue.Assignees)),
+		Labels:    make([]string, len(issue.Labels)),
+		Projects:  make([]string, len(issue.Projects)),
+		CreatedAt: issue.CreatedAt,
+		UpdatedAt: issue.UpdatedAt,
+		ClosedAt:  issue.ClosedAt,
+	}
+
+	if issue.Milestone != nil {
+		result.Milestone = issue.Milestone.Title
+	}
+	for i, assignee := range issue.Assignees {
+		result.Assignees[i] = assignee.Login
+	}
+	for i, label := range issue.Labels {
+		result.Labels[i] = label.Name
+	}
+	for i, project := range issue.Projects {
+		result.Projects[i] = project.Title
+	}
+	for _, comment := range issue.Comments {
+		result.Comments = append(result.Comments, IssueComment{
+			ID:        comment.ID,
+			Author:    comment.User.Login,
+			Body:      comment.Body,
+			CreatedAt: comment.CreatedAt,
+		})
+	}
+
+	return result
+}
+
+// NewIssues converts GitHub issues to their structured form
+func NewIssues(issues []*models.Issue) []Issue {
+	result := make([]Issue, len(issues))
+	for i, issue := range issues {
+		result[i] = NewIssue(issue)
+	}
+	return result
+}
+
+// NewReviewThreadList converts the review threads of a pull request to their structured form
+func NewReviewThreadList(prNumber int, threads []*models.ReviewThread) ReviewThreadList {
+	result := ReviewThreadList{
+		PRNumber: prNumber,
+		Threads:  make([]ReviewThread, len(threads)),
+	}
+
+	for i, thread := range threads {
+		result.Threads[i] = ReviewThread{
+			ID:         thread.ID,
+			Number:     thread.Number,
+			Path:       thread.Path,
+			Line:       thread.Line,
+			StartLine:  thread.StartLine,
+			DiffHunk:   thread.DiffHunk,
+			Resolved:   thread.IsResolved,
+			Outdated:   thread.IsOutdated,
+			ResolvedBy: thread.ResolvedBy,
+			Comments:   make([]ReviewComment, len(thread.Comments)),
+		}
+		for j, comment := range thread.Comments {
+			result.Threads[i].Comments[j] = ReviewComment{
+				ID:        comment.ID,
+				Author:    comment.Author,
+				Body:      comment.Body,
+				URL:       comment.URL,
+				CreatedAt: comment.CreatedAt,
+			}
+		}
+	}
+
+	return result
+}
+
+// NewFlakyTestList converts flaky test stats to their structured form
+func NewFlakyTestList(projectSlug, branch string, pipelines int, stats []circleci.FlakyTestStats) FlakyTestList {
+	result := FlakyTestList{
+		ProjectSlug: projectSlug,
+		Branch:      branch,
+		Pipelines:   pipelines,
+		Tests:       make([]FlakyTest, len(stats)),
+	}
+	for i, s := range stats {
+		result.Tests[i] = FlakyTest{
+			Name:        s.Name,
+			Classname:   s.Classname,
+			File:        s.File,
+			JobName:     s.JobName,
+			Runs:        s.Runs,
+			Failures:    s.Failures,
+			FlakeRate:   s.FlakeRate(),
+			SameCommit:  s.SameCommit,
+			TimesFlaked: s.TimesFlaked,
+		}
+	}
+	return result
+}
+
+// NewCIHistory converts pipeline runs and the Insights job metrics of their
+// workflows, keyed by workflow name, to their structured form
+func NewCIHistory(projectSlug, branch string, runs []circleci.PipelineRun, insights map[string][]circleci.JobInsights, now time.Time) CIHistory {
+	result := CIHistory{
+		ProjectSlug: projectSlug,
+		Branch:      branch,
+		Pipelines:   make([]PipelineRun, len(runs)),
+	}
+
+	for i, run := range runs {
+		wall, queued := run.Durations(now)
+		p := PipelineRun{
+			Number:        run.Pipeline.Number,
+			Revision:      run.Pipeline.VCS.Revision,
+			Actor:         run.Pipeline.Trigger.Actor.Login,
+			Trigger:       run.Pipeline.Trigger.Type,
+			CreatedAt:     run.Pipeline.CreatedAt,
+			Workflows:     make([]WorkflowResult, len(run.Workflows)),
+			WallSeconds:   int64(wall.Seconds()),
+			QueuedSeconds: int64(queued.Seconds()),
+		}
+		for j, w := range run.Workflows {
+			p.Workflows[j] = WorkflowResult{Name: w.Workflow.Name, Status: w.Workflow.Status}
+		}
+		result.Pipelines[i] = p
+	}
+
+	workflows := make([]string, 0, len(insights))
+	for name := range insights {
+		workflows = append(workflows, name)
+	}
+	sort.Strings(workflows)
+
+	for _, workflow := range workflows {
+		for _, job := range insights[workflow] {
+			result.JobDurations = append(result.JobDurations, JobDurationTrend{
+				Workflow:    workflow,
+				Job:         job.Name,
+				Runs:        job.Metrics.TotalRuns,
+				SuccessRate: job.Metrics.SuccessRate,
+				P50Seconds:  job.Metrics.DurationMetrics.Median,
+				P95Seconds:  job.Metrics.DurationMetrics.P95,
+			})
+		}
+	}
+
+	return result
+}
+
+// NewCIConfigValidation converts the issues found in a CircleCI config, and
+// the result of compiling it when compiled is set
+func NewCIConfigValidation(path string, issues []circleci.ConfigIssue, compiled *circleci.CompileConfigResponse) CIConfigValidation {
+	result := CIConfigValidation{
+		Path:   path,
+		Valid:  !circleci.HasErrors(issues),
+		Issues: make([]ConfigIssue, len(issues)),
+	}
+	for i, issue := range issues {
+		result.Issues[i] = ConfigIssue{
+			Severity: issue.Severity,
+			Path:     issue.Path,
+			Line:     issue.Line,
+			Message:  issue.Message,
+		}
+	}
+	if compiled != nil {
+		result.Compiled = true
+		result.Valid = result.Valid && compiled.Valid
+		for _, e := range compiled.Errors {
+			result.CompileErrors = append(result.CompileErrors, e.Message)
+		}
+	}
+	return result
+}
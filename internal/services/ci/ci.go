@@ -0,0 +1,158 @@
+// Package ci provides a provider-neutral interface to CI systems, with
+// implementations for CircleCI, GitHub Actions, and GitLab pipelines. All
+// providers report status with the CircleCI types, so the CI commands render
+// them the same way.
+package ci
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/rithyhuot/vibe/internal/services/circleci"
+)
+
+// Provider defines the CI operations shared by all providers
+type Provider interface {
+	// Name returns the display name of the provider, e.g. "CircleCI"
+	Name() string
+	// ProjectSlug identifies the project in the provider, e.g. gh/org/repo
+	ProjectSlug() string
+	// GetCIStatusForBranch returns the status of the latest pipeline for a
+	// branch, or nil if the branch has none
+	GetCIStatusForBranch(ctx context.Context, branch string) (*circleci.CIStatus, error)
+	// GetFailedSteps returns the output of the failed steps of a job
+	GetFailedSteps(ctx context.Context, jobNumber int) ([]circleci.FailedStep, error)
+}
+
+// FlakyTestReporter is implemented by providers that track which tests are flaky
+type FlakyTestReporter interface {
+	// FlakyTests returns the tests the provider has seen flake recently
+	FlakyTests(ctx context.Context) ([]circleci.FlakyTest, error)
+}
+
+// MultiProject is implemented by providers that span several projects, whose
+// job numbers are only unique within a project
+type MultiProject interface {
+	// Project returns the provider for one of the projects
+	Project(slug string) (Provider, error)
+}
+
+// CircleCI is the Provider for CircleCI projects
+type CircleCI struct {
+	client      circleci.Client
+	projectSlug string
+}
+
+// NewCircleCI creates a CircleCI provider for a project
+func NewCircleCI(client circleci.Client, projectSlug string) *CircleCI {
+	return &CircleCI{client: client, projectSlug: projectSlug}
+}
+
+// Name returns the display name of the provider
+func (c *CircleCI) Name() string {
+	return "CircleCI"
+}
+
+// ProjectSlug returns the CircleCI project slug
+func (c *CircleCI) ProjectSlug() string {
+	return c.projectSlug
+}
+
+// GetCIStatusForBranch returns the status of the latest pipeline for a branch
+func (c *CircleCI) GetCIStatusForBranch(ctx context.Context, branch string) (*circleci.CIStatus, error) {
+	return c.client.GetCIStatusForBranch(ctx, branch, c.projectSlug)
+}
+
+// GetFailedSteps returns the output of the failed steps of a job
+func (c *CircleCI) GetFailedSteps(ctx context.Context, jobNumber int) ([]circleci.FailedStep, error) {
+	return c.client.GetBuildDetails(ctx, c.projectSlug, jobNumber)
+}
+
+// FlakyTests returns the tests CircleCI Insights detected as flaky
+func (c *CircleCI) FlakyTests(ctx context.Context) ([]circleci.FlakyTest, error) {
+	return c.client.GetFlakyTests(ctx, c.projectSlug)
+}
+
+// CircleCIProjects is the Provider for a repository whose pipelines run in
+// several CircleCI projects. Statuses combine the latest pipeline of each,
+// and jobs are looked up in the first project.
+type CircleCIProjects struct {
+	client   circleci.Client
+	projects []*CircleCI
+}
+
+// NewCircleCIProjects creates a provider for several CircleCI projects. The
+// first is the main project.
+func NewCircleCIProjects(client circleci.Client, projectSlugs []string) *CircleCIProjects {
+	projects := make([]*CircleCI, len(projectSlugs))
+	for i, slug := range projectSlugs {
+		projects[i] = NewCircleCI(client, slug)
+	}
+	return &CircleCIProjects{client: client, projects: projects}
+}
+
+// Name returns the display name of the provider
+func (c *CircleCIProjects) Name() string {
+	return "CircleCI"
+}
+
+// ProjectSlug returns the slug of the main project
+func (c *CircleCIProjects) ProjectSlug() string {
+	return c.projects[0].ProjectSlug()
+}
+
+// Project returns the provider for one of the projects
+func (c *CircleCIProjects) Project(slug string) (Provider, error) {
+	slugs := make([]string, len(c.projects))
+	for i, project := range c.projects {
+		if project.ProjectSlug() == slug {
+			return project, nil
+		}
+		slugs[i] = project.ProjectSlug()
+	}
+	return nil, fmt.Errorf("unknown CircleCI project %q (configured: %s)", slug, strings.Join(slugs, ", "))
+}
+
+// GetCIStatusForBranch returns the latest pipelines of a branch in all the
+// projects, combined into one status
+func (c *CircleCIProjects) GetCIStatusForBranch(ctx context.Context, branch string) (*circleci.CIStatus, error) {
+	statuses := make([]*circleci.CIStatus, len(c.projects))
+	errs := make([]error, len(c.projects))
+
+	var wg sync.WaitGroup
+	for i, project := range c.projects {
+		wg.Add(1)
+		go func(i int, project *CircleCI) {
+			defer wg.Done()
+			statuses[i], errs[i] = project.GetCIStatusForBranch(ctx, branch)
+		}(i, project)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", c.projects[i].ProjectSlug(), err)
+		}
+	}
+	return circleci.MergeCIStatuses(statuses), nil
+}
+
+// GetFailedSteps returns the output of the failed steps of a job in the main project
+func (c *CircleCIProjects) GetFailedSteps(ctx context.Context, jobNumber int) ([]circleci.FailedStep, error) {
+	return c.projects[0].GetFailedSteps(ctx, jobNumber)
+}
+
+// FlakyTests returns the tests CircleCI Insights detected as flaky in any of the projects
+func (c *CircleCIProjects) FlakyTests(ctx context.Context) ([]circleci.FlakyTest, error) {
+	var flaky []circleci.FlakyTest
+	for _, project := range c.projects {
+		tests, err := project.FlakyTests(ctx)
+		if err != nil {
+			return nil, err
+		}
+		flaky = append(flaky, tests...)
+	}
+	return flaky, nil
+}
@@ -0,0 +1,432 @@
+package ci
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	goFailPattern      = regexp.MustCompile(`^(\s*)--- FAIL: (\S+)`)
+	goRunPattern       = regexp.MustCompile(`^=== (?:RUN|CONT|NAME)\s+(\S+)`)
+	goEndPattern       = regexp.MustCompile(`^\s*(?:--- (?:PASS|SKIP)|=== \w+|FAIL\s|ok\s|PASS$|FAIL$)`)
+	goLocationPattern  = regexp.MustCompile(`^\s+([\w./-]+\.go):(\d+): ?(.*)$`)
+	goPanicPattern     = regexp.MustCompile(`^panic: (.*?)(?: \[recovered\])?$`)
+	goFramePattern     = regexp.MustCompile(`^\s+(\S+\.go):(\d+)(?: \+0x[0-9a-f]+)?$`)
+	goBuildHeader      = regexp.MustCompile(`^# \S+`)
+	goBuildError       = regexp.MustCompile(`^(\S+\.go):(\d+):(\d+): (.*)$`)
+	golangciPattern    = regexp.MustCompile(`^(\S+\.go):(\d+)(?::(\d+))?: (.+) \(([\w-]+)\)$`)
+	jestFailPattern    = regexp.MustCompile(`^\s*FAIL\s+(\S+)(.*)$`)
+	jestTestPattern    = regexp.MustCompile(`^\s*● (.+)$`)
+	jestFramePattern   = regexp.MustCompile(`^\s*at .*?\(?([^\s()]+):(\d+):(\d+)\)?$`)
+	jestCodePattern    = regexp.MustCompile(`^\s*>?\s*\d+ \|`)
+	jestEndPattern     = regexp.MustCompile(`^\s*(?:PASS|FAIL)\s|^Test Suites:|^Tests:`)
+	vitestFramePattern = regexp.MustCompile(`^\s*❯ (?:.*? )?\(?([^\s()]+):(\d+):(\d+)\)?$`)
+	vitestEndPattern   = regexp.MustCompile(`^\s*⎯|^\s*Test Files\s`)
+	pytestRegion       = regexp.MustCompile(`^=+ (FAILURES|ERRORS) =+$`)
+	pytestRegionEnd    = regexp.MustCompile(`^=+ .* =+$`)
+	pytestSection      = regexp.MustCompile(`^_{3,} (.+?) _{3,}$`)
+	pytestErrorLine    = regexp.MustCompile(`^E\s+(.*)$`)
+	pytestLocation     = regexp.MustCompile(`^(\S+\.py):(\d+): (\w[\w.]*)$`)
+	pytestSummary      = regexp.MustCompile(`^(?:FAILED|ERROR) (\S+?\.py)(?:::(\S+))?(?: - (.*))?$`)
+	eslintFilePattern  = regexp.MustCompile(`^(\S+\.(?:[cm]?[jt]sx?|vue|svelte))$`)
+	eslintIssuePattern = regexp.MustCompile(`^\s+(\d+):(\d+)\s+error\s+(.+?)(?:\s{2,}(\S+))?$`)
+	tscPattern         = regexp.MustCompile(`^(\S+\.[cm]?tsx?)\((\d+),(\d+)\): error (TS\d+): (.*)$`)
+	tscPrettyPattern   = regexp.MustCompile(`^(\S+\.[cm]?tsx?):(\d+):(\d+) - error (TS\d+): (.*)$`)
+)
+
+// goRootMarkers identify stack frames in the Go installation or module cache
+var goRootMarkers = []string{"/usr/local/go/", "/hostedtoolcache/go/", "/src/runtime/", "/src/testing/", "/pkg/mod/"}
+
+// GoTestParser finds failed tests, panics, and build errors in go test output,
+// including go test -v and gotestsum
+type GoTestParser struct{}
+
+// Name returns the tool name
+func (GoTestParser) Name() string { return "go test" }
+
+// Parse returns the failures in go test output
+func (GoTestParser) Parse(output string) []Failure {
+	lines := strings.Split(output, "\n")
+
+	var failures []Failure
+	current, currentIndent := -1, 0
+	inBuild := false
+	running := ""
+	runOutput := make(map[string][]string) // Output logged before --- FAIL, as with -v
+
+	for i, line := range lines {
+		if m := goFailPattern.FindStringSubmatch(line); m != nil {
+			f := Failure{Test: m[2]}
+			for _, logged := range runOutput[f.Test] {
+				addGoTestOutput(&f, logged)
+			}
+			failures = append(failures, f)
+			current, currentIndent = len(failures)-1, len(m[1])
+			inBuild, running = false, ""
+			continue
+		}
+
+		if goBuildHeader.MatchString(line) {
+			inBuild, current, running = true, -1, ""
+			continue
+		}
+		if inBuild {
+			if m := goBuildError.FindStringSubmatch(line); m != nil {
+				failures = append(failures, Failure{File: m[1], Line: atoi(m[2]), Column: atoi(m[3]), Message: m[4]})
+				continue
+			}
+			inBuild = false
+		}
+
+		if m := goPanicPattern.FindStringSubmatch(line); m != nil {
+			f := Failure{Message: "panic: " + m[1]}
+			for _, frame := range lines[i+1:] {
+				if fm := goFramePattern.FindStringSubmatch(frame); fm != nil && !isGoRootFrame(fm[1]) {
+					f.File, f.Line = fm[1], atoi(fm[2])
+					break
+				}
+			}
+			if current >= 0 && failures[current].Message == "" {
+				f.Test = failures[current].Test
+				failures[current] = f
+			} else {
+				f.Test = running
+				failures = append(failures, f)
+			}
+			current, running = -1, ""
+			continue
+		}
+
+		if m := goRunPattern.FindStringSubmatch(line); m != nil {
+			current, running = -1, m[1]
+			continue
+		}
+		if goEndPattern.MatchString(line) {
+			current, running = -1, ""
+			continue
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		if running != "" && indentation(line) > 0 {
+			runOutput[running] = append(runOutput[running], line)
+			continue
+		}
+		if current >= 0 {
+			if indentation(line) <= currentIndent {
+				current = -1
+				continue
+			}
+			addGoTestOutput(&failures[current], line)
+		}
+	}
+
+	// Parents fail with their subtests; only keep them for their own output
+	var result []Failure
+	for _, f := range failures {
+		if f.Test != "" && f.Message == "" && hasSubtestFailure(failures, f.Test) {
+			continue
+		}
+		result = append(result, f)
+	}
+	return result
+}
+
+// addGoTestOutput adds a line a test logged to its failure. The first
+// file:line becomes the failure's location.
+func addGoTestOutput(f *Failure, line string) {
+	text := strings.TrimSpace(line)
+	if m := goLocationPattern.FindStringSubmatch(line); m != nil {
+		if f.File == "" {
+			f.File, f.Line = m[1], atoi(m[2])
+		}
+		text = m[3]
+	}
+	if f.Message != "" {
+		f.Message += "\n"
+	}
+	f.Message += text
+}
+
+// hasSubtestFailure reports whether a subtest of the test failed
+func hasSubtestFailure(failures []Failure, test string) bool {
+	for _, f := range failures {
+		if strings.HasPrefix(f.Test, test+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// isGoRootFrame reports whether a stack frame is outside the project
+func isGoRootFrame(path string) bool {
+	for _, marker := range goRootMarkers {
+		if strings.Contains(path, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// GolangciLintParser finds issues in golangci-lint's line-number output
+type GolangciLintParser struct{}
+
+// Name returns the tool name
+func (GolangciLintParser) Name() string { return "golangci-lint" }
+
+// Parse returns the issues in golangci-lint output
+func (GolangciLintParser) Parse(output string) []Failure {
+	var failures []Failure
+	for _, line := range strings.Split(output, "\n") {
+		if m := golangciPattern.FindStringSubmatch(line); m != nil {
+			failures = append(failures, Failure{
+				File:    m[1],
+				Line:    atoi(m[2]),
+				Column:  atoi(m[3]),
+				Message: m[4] + " (" + m[5] + ")",
+			})
+		}
+	}
+	return failures
+}
+
+// JestParser finds failed tests in Jest and Vitest output
+type JestParser struct{}
+
+// Name returns the tool name
+func (JestParser) Name() string { return "jest" }
+
+// Parse returns the failed tests in Jest or Vitest output
+func (JestParser) Parse(output string) []Failure {
+	lines := strings.Split(output, "\n")
+
+	var failures []Failure
+	var current *Failure
+	vitest := false
+	inFrame := false // Past the message, in the code frame or stack
+	file := ""
+
+	flush := func() {
+		if current != nil {
+			failures = append(failures, *current)
+			current = nil
+		}
+	}
+
+	for _, line := range lines {
+		if m := jestFailPattern.FindStringSubmatch(line); m != nil {
+			flush()
+			file = m[1]
+			// Vitest names the test on the FAIL line: FAIL  file > suite > test
+			if rest := strings.TrimSpace(m[2]); strings.HasPrefix(rest, "> ") {
+				current = &Failure{Tool: "vitest", File: file, Test: strings.TrimPrefix(rest, "> ")}
+				vitest, inFrame = true, false
+			}
+			continue
+		}
+		if m := jestTestPattern.FindStringSubmatch(line); m != nil {
+			flush()
+			// Console blocks hold logged output, not failures
+			if m[1] == "Console" {
+				continue
+			}
+			current = &Failure{File: file, Test: m[1]}
+			vitest, inFrame = false, false
+			continue
+		}
+		if current == nil {
+			continue
+		}
+
+		if (vitest && vitestEndPattern.MatchString(line)) || (!vitest && jestEndPattern.MatchString(line)) {
+			flush()
+			continue
+		}
+
+		framePattern := jestFramePattern
+		if vitest {
+			framePattern = vitestFramePattern
+		}
+		if m := framePattern.FindStringSubmatch(line); m != nil {
+			inFrame = true
+			if current.Line == 0 && !strings.Contains(m[1], "node_modules") {
+				current.File, current.Line, current.Column = m[1], atoi(m[2]), atoi(m[3])
+			}
+			continue
+		}
+		if jestCodePattern.MatchString(line) {
+			inFrame = true
+			continue
+		}
+		if inFrame {
+			continue
+		}
+
+		text := strings.TrimSpace(line)
+		if text == "" && current.Message == "" {
+			continue
+		}
+		if current.Message != "" {
+			current.Message += "\n"
+		}
+		current.Message += text
+	}
+	flush()
+
+	return failures
+}
+
+// PytestParser finds failed tests in pytest output, from the FAILURES section
+// and the short test summary
+type PytestParser struct{}
+
+// Name returns the tool name
+func (PytestParser) Name() string { return "pytest" }
+
+// Parse returns the failed tests in pytest output
+func (PytestParser) Parse(output string) []Failure {
+	var failures []Failure
+	var current *Failure
+	inRegion := false
+
+	flush := func() {
+		if current != nil {
+			failures = append(failures, *current)
+			current = nil
+		}
+	}
+
+	for _, line := range strings.Split(output, "\n") {
+		if pytestRegion.MatchString(line) {
+			flush()
+			inRegion = true
+			continue
+		}
+		if pytestRegionEnd.MatchString(line) {
+			flush()
+			inRegion = false
+			continue
+		}
+
+		if m := pytestSummary.FindStringSubmatch(line); m != nil && !inRegion {
+			test := strings.ReplaceAll(m[2], "::", ".")
+			if i := findPytestFailure(failures, test); i >= 0 {
+				if failures[i].File == "" {
+					failures[i].File = m[1]
+				}
+				continue
+			}
+			failures = append(failures, Failure{File: m[1], Test: test, Message: m[3]})
+			continue
+		}
+
+		if !inRegion {
+			continue
+		}
+		if m := pytestSection.FindStringSubmatch(line); m != nil {
+			flush()
+			current = &Failure{Test: strings.TrimPrefix(m[1], "ERROR at setup of ")}
+			continue
+		}
+		if current == nil {
+			continue
+		}
+		if m := pytestErrorLine.FindStringSubmatch(line); m != nil {
+			if current.Message != "" {
+				current.Message += "\n"
+			}
+			current.Message += m[1]
+			continue
+		}
+		// The last location is where the error was raised
+		if m := pytestLocation.FindStringSubmatch(line); m != nil {
+			current.File, current.Line = m[1], atoi(m[2])
+		}
+	}
+	flush()
+
+	return failures
+}
+
+// findPytestFailure returns the index of the failure of a test, or -1
+func findPytestFailure(failures []Failure, test string) int {
+	for i, f := range failures {
+		if f.Test == test {
+			return i
+		}
+	}
+	return -1
+}
+
+// ESLintParser finds errors in ESLint's default stylish output. Warnings are skipped.
+type ESLintParser struct{}
+
+// Name returns the tool name
+func (ESLintParser) Name() string { return "eslint" }
+
+// Parse returns the errors in ESLint output
+func (ESLintParser) Parse(output string) []Failure {
+	var failures []Failure
+	file := ""
+
+	for _, line := range strings.Split(output, "\n") {
+		if m := eslintFilePattern.FindStringSubmatch(line); m != nil {
+			file = m[1]
+			continue
+		}
+		if strings.TrimSpace(line) == "" {
+			file = ""
+			continue
+		}
+		if file == "" {
+			continue
+		}
+		if m := eslintIssuePattern.FindStringSubmatch(line); m != nil {
+			message := m[3]
+			if m[4] != "" {
+				message += " (" + m[4] + ")"
+			}
+			failures = append(failures, Failure{File: file, Line: atoi(m[1]), Column: atoi(m[2]), Message: message})
+		}
+	}
+	return failures
+}
+
+// TSCParser finds type errors in TypeScript compiler output, plain or --pretty
+type TSCParser struct{}
+
+// Name returns the tool name
+func (TSCParser) Name() string { return "tsc" }
+
+// Parse returns the errors in tsc output
+func (TSCParser) Parse(output string) []Failure {
+	var failures []Failure
+	current := -1
+
+	for _, line := range strings.Split(output, "\n") {
+		m := tscPattern.FindStringSubmatch(line)
+		if m == nil {
+			m = tscPrettyPattern.FindStringSubmatch(line)
+		}
+		if m != nil {
+			failures = append(failures, Failure{
+				File:    m[1],
+				Line:    atoi(m[2]),
+				Column:  atoi(m[3]),
+				Message: m[4] + ": " + m[5],
+			})
+			current = len(failures) - 1
+			continue
+		}
+
+		// Plain output continues a message on indented lines
+		if current >= 0 && indentation(line) > 0 && !jestCodePattern.MatchString(line) && strings.TrimSpace(line) != "" &&
+			!strings.HasPrefix(strings.TrimSpace(line), "~") {
+			failures[current].Message += "\n" + strings.TrimSpace(line)
+			continue
+		}
+		current = -1
+	}
+	return failures
+}
@@ -0,0 +1,106 @@
+package ci
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Failure is a failure found in the output of a CI step, such as a failed
+// test, a lint issue, or a compile error
+type Failure struct {
+	Tool    string // Tool that reported the failure, e.g. "go test"
+	File    string
+	Line    int
+	Column  int
+	Test    string // Test name, for test failures
+	Message string
+}
+
+// Location returns the failure's file:line:column, leaving out what isn't known
+func (f Failure) Location() string {
+	if f.File == "" {
+		return ""
+	}
+	loc := f.File
+	if f.Line > 0 {
+		loc += ":" + strconv.Itoa(f.Line)
+		if f.Column > 0 {
+			loc += ":" + strconv.Itoa(f.Column)
+		}
+	}
+	return loc
+}
+
+// FailureParser finds the failures of one tool in step output
+type FailureParser interface {
+	// Name identifies the tool, e.g. "go test"
+	Name() string
+	// Parse returns the failures in the output, in order
+	Parse(output string) []Failure
+}
+
+// FailureParsers are the parsers ExtractFailures runs, in order
+var FailureParsers = []FailureParser{
+	GoTestParser{},
+	GolangciLintParser{},
+	JestParser{},
+	PytestParser{},
+	ESLintParser{},
+	TSCParser{},
+}
+
+// ansiPattern matches terminal color and cursor sequences
+var ansiPattern = regexp.MustCompile(`\x1b\[[0-9;?]*[A-Za-z]`)
+
+// workdirPattern matches the checkout directories of CI executors
+var workdirPattern = regexp.MustCompile(`^(?:/home/circleci/project/|/root/project/|/home/runner/work/[^/]+/[^/]+/|/builds/[^/]+/[^/]+/)`)
+
+// ExtractFailures runs every parser over step output and returns the failures
+// they found. A failure reported by more than one parser is kept once.
+func ExtractFailures(output string) []Failure {
+	output = normalizeOutput(output)
+
+	seen := make(map[string]bool)
+	var failures []Failure
+	for _, parser := range FailureParsers {
+		for _, f := range parser.Parse(output) {
+			if f.Tool == "" {
+				f.Tool = parser.Name()
+			}
+			f.File = trimWorkdir(f.File)
+			f.Message = strings.TrimSpace(f.Message)
+
+			key := f.Location() + "\x00" + f.Test + "\x00" + f.Message
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			failures = append(failures, f)
+		}
+	}
+	return failures
+}
+
+// normalizeOutput strips colors and carriage returns from step output
+func normalizeOutput(output string) string {
+	output = ansiPattern.ReplaceAllString(output, "")
+	output = strings.ReplaceAll(output, "\r\n", "\n")
+	return strings.ReplaceAll(output, "\r", "\n")
+}
+
+// trimWorkdir makes an absolute path in a CI checkout relative to the repository
+func trimWorkdir(path string) string {
+	return workdirPattern.ReplaceAllString(path, "")
+}
+
+// atoi converts a captured number, returning 0 for an empty capture
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+// indentation returns the number of leading spaces and tabs of a line
+func indentation(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " \t"))
+}
@@ -0,0 +1,109 @@
+package ci
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readFixture(t *testing.T, name string) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", "failures", name))
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestExtractFailures(t *testing.T) {
+	tests := []struct {
+		fixture string
+		want    []Failure
+	}{
+		{
+			fixture: "go_test.log",
+			want: []Failure{
+				{Tool: "go test", File: "math_test.go", Line: 21, Test: "TestAdd/negative", Message: "Add(-1, -2) = -4, want -3"},
+				{Tool: "go test", File: "parse_test.go", Line: 42, Test: "TestParse", Message: "unexpected error:\ninvalid character 'x' looking for beginning of value"},
+				// The first frame outside the Go installation, relative to the checkout
+				{Tool: "go test", File: "internal/math/calc.go", Line: 17, Test: "TestPanic", Message: "panic: runtime error: invalid memory address or nil pointer dereference"},
+				{Tool: "go test", File: "internal/api/handler.go", Line: 33, Column: 9, Message: "undefined: renderJSON"},
+				{Tool: "go test", File: "internal/api/handler.go", Line: 48, Column: 2, Message: "declared and not used: err"},
+			},
+		},
+		{
+			fixture: "go_test_verbose.log",
+			want: []Failure{
+				{Tool: "go test", File: "users_test.go", Line: 58, Test: "TestDeleteUser", Message: "expected status 204, got 500\nbody: {\"error\":\"locked\"}"},
+				{Tool: "go test", File: "users_test.go", Line: 91, Test: "TestList/paged", Message: "got 10 users, want 20"},
+			},
+		},
+		{
+			fixture: "golangci_lint.log",
+			want: []Failure{
+				{Tool: "golangci-lint", File: "internal/api/handler.go", Line: 27, Column: 12, Message: "Error return value of `w.Write` is not checked (errcheck)"},
+				{Tool: "golangci-lint", File: "internal/config/load.go", Line: 88, Column: 1, Message: "cognitive complexity 42 of func `Load` is high (> 30) (gocognit)"},
+				{Tool: "golangci-lint", File: "cmd/app/main.go", Line: 14, Message: "File is not `goimports`-ed (goimports)"},
+			},
+		},
+		{
+			fixture: "jest.log",
+			want: []Failure{
+				{Tool: "jest", File: "src/utils/math.test.ts", Line: 11, Column: 23, Test: "math › adds numbers", Message: "expect(received).toBe(expected) // Object.is equality\n\nExpected: 3\nReceived: 4"},
+				{Tool: "jest", File: "src/utils/math.ts", Line: 7, Column: 15, Test: "math › divides by zero", Message: "TypeError: Cannot read properties of undefined (reading 'value')"},
+			},
+		},
+		{
+			fixture: "vitest.log",
+			want: []Failure{
+				{Tool: "vitest", File: "src/cart.test.ts", Line: 24, Column: 31, Test: "cart > applies discount", Message: "AssertionError: expected 90 to be 85 // Object.is equality\n\n- Expected\n+ Received\n\n- 85\n+ 90"},
+			},
+		},
+		{
+			fixture: "pytest.log",
+			want: []Failure{
+				{Tool: "pytest", File: "tests/conftest.py", Line: 8, Test: "test_get", Message: "KeyError: 'API_URL'"},
+				{Tool: "pytest", File: "tests/test_math.py", Line: 10, Test: "test_divide", Message: "assert 2.0 == 3\n+  where 2.0 = divide(4, 2)"},
+				{Tool: "pytest", File: "app/math.py", Line: 31, Test: "TestRounding.test_half", Message: "TypeError: unsupported operand type(s) for +: 'NoneType' and 'float'"},
+				// Only in the short test summary, as with --tb=no
+				{Tool: "pytest", File: "tests/test_slow.py", Test: "test_timeout", Message: "Failed: Timeout >30.0s"},
+			},
+		},
+		{
+			fixture: "eslint.log",
+			want: []Failure{
+				{Tool: "eslint", File: "src/app.tsx", Line: 12, Column: 7, Message: "'user' is assigned a value but never used (@typescript-eslint/no-unused-vars)"},
+				{Tool: "eslint", File: "src/app.tsx", Line: 41, Column: 15, Message: "Parsing error: Unexpected token"},
+				{Tool: "eslint", File: "src/hooks/useCart.ts", Line: 8, Column: 3, Message: "React Hook useEffect has a missing dependency: 'id' (react-hooks/exhaustive-deps)"},
+			},
+		},
+		{
+			fixture: "tsc.log",
+			want: []Failure{
+				{Tool: "tsc", File: "src/cart.ts", Line: 14, Column: 5, Message: "TS2322: Type 'string' is not assignable to type 'number'."},
+				{Tool: "tsc", File: "src/api/client.ts", Line: 88, Column: 22, Message: "TS2345: Argument of type '{ id: string; }' is not assignable to parameter of type 'Request'.\nProperty 'method' is missing in type '{ id: string; }' but required in type 'Request'."},
+				{Tool: "tsc", File: "src/index.tsx", Line: 3, Column: 8, Message: "TS2307: Cannot find module './App' or its corresponding type declarations."},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			assert.Equal(t, tt.want, ExtractFailures(readFixture(t, tt.fixture)))
+		})
+	}
+}
+
+func TestExtractFailures_ColorsAndDuplicates(t *testing.T) {
+	output := "\x1b[31msrc/cart.ts(14,5): error TS2322: Bad type.\x1b[0m\r\n" +
+		"src/cart.ts(14,5): error TS2322: Bad type.\r\n"
+
+	failures := ExtractFailures(output)
+	require.Len(t, failures, 1)
+	assert.Equal(t, "src/cart.ts:14:5", failures[0].Location())
+}
+
+func TestExtractFailures_NoMatches(t *testing.T) {
+	assert.Empty(t, ExtractFailures("npm ERR! code ELIFECYCLE\nExited with code exit status 1\n"))
+}
@@ -0,0 +1,290 @@
+package ci
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/rithyhuot/vibe/internal/services/circleci"
+	"github.com/rithyhuot/vibe/internal/services/github"
+)
+
+// maxArtifactSize is the largest artifact downloaded to look for JUnit reports
+const maxArtifactSize = 50 << 20
+
+var (
+	// logTimestampPattern matches the timestamp GitHub prefixes to every log line
+	logTimestampPattern = regexp.MustCompile(`^\x{FEFF}?\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?Z ?`)
+
+	// testArtifactPattern matches the names of artifacts likely to hold test reports
+	testArtifactPattern = regexp.MustCompile(`(?i)junit|test|report`)
+)
+
+// GitHubActions is the Provider for GitHub Actions. The workflow runs of a
+// branch's latest commit are shown as workflows, and job IDs as job numbers.
+type GitHubActions struct {
+	client github.ActionsClient
+}
+
+// NewGitHubActions creates a GitHub Actions provider
+func NewGitHubActions(client github.ActionsClient) *GitHubActions {
+	return &GitHubActions{client: client}
+}
+
+// Name returns the display name of the provider
+func (a *GitHubActions) Name() string {
+	return "GitHub Actions"
+}
+
+// ProjectSlug returns the owner/repo of the repository
+func (a *GitHubActions) ProjectSlug() string {
+	return a.client.Repository()
+}
+
+// GetCIStatusForBranch returns the status of the workflow runs for the
+// latest commit on a branch that ran CI
+func (a *GitHubActions) GetCIStatusForBranch(ctx context.Context, branch string) (*circleci.CIStatus, error) {
+	runs, err := a.client.ListWorkflowRuns(ctx, branch)
+	if err != nil {
+		return nil, err
+	}
+	runs = latestWorkflowRuns(runs)
+	if len(runs) == 0 {
+		return nil, nil
+	}
+
+	status := &circleci.CIStatus{
+		Branch:         branch,
+		ProjectSlug:    a.client.Repository(),
+		PipelineNumber: runs[len(runs)-1].RunNumber,
+		PipelineID:     runs[0].HeadSHA,
+		Revision:       runs[0].HeadSHA,
+	}
+
+	for _, run := range runs {
+		jobs, err := a.client.GetWorkflowRunJobs(ctx, run.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		workflow := circleci.WorkflowStatus{
+			ID:     strconv.FormatInt(run.ID, 10),
+			Name:   run.Name,
+			Status: actionsStatus(run.Status, run.Conclusion),
+		}
+
+		var failedJobs []circleci.FailedJob
+		for _, job := range jobs {
+			jobStatus := actionsStatus(job.Status, job.Conclusion)
+			workflow.Jobs = append(workflow.Jobs, circleci.Job{
+				ID:        strconv.FormatInt(job.ID, 10),
+				Name:      job.Name,
+				Status:    jobStatus,
+				JobNumber: int(job.ID),
+				StartedAt: job.StartedAt,
+				StoppedAt: job.CompletedAt,
+			})
+
+			if jobStatus == "failed" {
+				failedJobs = append(failedJobs, circleci.FailedJob{
+					Name:         job.Name,
+					JobNumber:    int(job.ID),
+					WebURL:       job.HTMLURL,
+					WorkflowName: run.Name,
+				})
+			}
+		}
+
+		if len(failedJobs) > 0 {
+			a.attachFailedTests(ctx, run.ID, failedJobs)
+		}
+
+		status.Workflows = append(status.Workflows, workflow)
+		status.FailedJobs = append(status.FailedJobs, failedJobs...)
+	}
+
+	return status, nil
+}
+
+// attachFailedTests adds the failed tests from a run's JUnit artifacts to its
+// failed jobs. Artifacts belong to runs rather than jobs, so each artifact's
+// tests go to the failed job named in the artifact name, or to the first
+// failed job. Test reports are best effort; download errors are ignored.
+func (a *GitHubActions) attachFailedTests(ctx context.Context, runID int64, failedJobs []circleci.FailedJob) {
+	artifacts, err := a.client.ListRunArtifacts(ctx, runID)
+	if err != nil {
+		return
+	}
+
+	for _, artifact := range artifacts {
+		if artifact.Expired || artifact.SizeInBytes > maxArtifactSize || !testArtifactPattern.MatchString(artifact.Name) {
+			continue
+		}
+
+		archive, err := a.client.DownloadArtifact(ctx, artifact.ID)
+		if err != nil {
+			continue
+		}
+		tests, err := ParseJUnitArchive(archive)
+		if err != nil || len(tests) == 0 {
+			continue
+		}
+
+		target := &failedJobs[0]
+		for i := range failedJobs {
+			if strings.Contains(strings.ToLower(artifact.Name), strings.ToLower(failedJobs[i].Name)) {
+				target = &failedJobs[i]
+				break
+			}
+		}
+		target.FailedTests = append(target.FailedTests, tests...)
+	}
+}
+
+// GetFailedSteps returns the log output of the failed steps of a job
+func (a *GitHubActions) GetFailedSteps(ctx context.Context, jobNumber int) ([]circleci.FailedStep, error) {
+	job, err := a.client.GetWorkflowJob(ctx, int64(jobNumber))
+	if err != nil {
+		return nil, err
+	}
+	if actionsStatus(job.Status, job.Conclusion) != "failed" {
+		return nil, nil
+	}
+
+	logs, err := a.client.GetJobLogs(ctx, job.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var failedSteps []github.WorkflowStep
+	for _, step := range job.Steps {
+		if step.Conclusion == "failure" {
+			failedSteps = append(failedSteps, step)
+		}
+	}
+
+	// Jobs that time out or fail to start may have no failed step
+	sections := errorLogSections(logs)
+	if len(failedSteps) == 0 || len(sections) == 0 {
+		return []circleci.FailedStep{{
+			Name:    job.Name,
+			Actions: []circleci.FailedAction{{Name: job.Name, Status: "failed", Output: cleanActionsLog(logs)}},
+		}}, nil
+	}
+
+	// Log sections aren't labeled with step names; failing steps stop the
+	// job, so the sections with errors line up with the failed steps
+	result := make([]circleci.FailedStep, len(failedSteps))
+	for i, step := range failedSteps {
+		output := ""
+		if i < len(sections) {
+			output = sections[i]
+		}
+		result[i] = circleci.FailedStep{
+			Name:    step.Name,
+			Actions: []circleci.FailedAction{{Name: step.Name, Status: "failed", Output: output}},
+		}
+	}
+
+	return result, nil
+}
+
+// latestWorkflowRuns returns the non-skipped runs for the newest commit that
+// has any, one per workflow, oldest first. Runs are listed newest first.
+func latestWorkflowRuns(runs []github.WorkflowRunResponse) []github.WorkflowRunResponse {
+	headSHA := ""
+	seen := make(map[string]bool)
+	var latest []github.WorkflowRunResponse
+	for _, run := range runs {
+		if run.Conclusion == "skipped" {
+			continue
+		}
+		if headSHA == "" {
+			headSHA = run.HeadSHA
+		}
+		if run.HeadSHA != headSHA || seen[run.Name] {
+			continue
+		}
+		seen[run.Name] = true
+		latest = append([]github.WorkflowRunResponse{run}, latest...)
+	}
+	return latest
+}
+
+// actionsStatus normalizes the status and conclusion of a GitHub Actions run
+// or job to the CircleCI statuses used by the CI commands
+func actionsStatus(status, conclusion string) string {
+	switch status {
+	case "completed":
+		switch conclusion {
+		case "success":
+			return "success"
+		case "failure", "timed_out", "startup_failure":
+			return "failed"
+		case "cancelled":
+			return "canceled"
+		case "action_required":
+			return "on_hold"
+		default:
+			// skipped, neutral, and stale
+			return "skipped"
+		}
+	case "in_progress":
+		return "running"
+	case "waiting":
+		// Waiting for an environment's required reviewers
+		return "on_hold"
+	default:
+		// queued, requested, and pending
+		return "queued"
+	}
+}
+
+// errorLogSections splits a job log into step sections and returns the
+// cleaned sections that contain errors. Each step starts with a "Run" group.
+func errorLogSections(logs string) []string {
+	var sections []string
+	var current []string
+	hasError := false
+
+	flush := func() {
+		if hasError {
+			sections = append(sections, cleanActionsLog(strings.Join(current, "\n")))
+		}
+		current, hasError = nil, false
+	}
+
+	for _, line := range strings.Split(logs, "\n") {
+		text := logTimestampPattern.ReplaceAllString(strings.TrimRight(line, "\r"), "")
+		if strings.HasPrefix(text, "##[group]Run ") {
+			flush()
+		}
+		if strings.HasPrefix(text, "##[error]") {
+			hasError = true
+		}
+		current = append(current, line)
+	}
+	flush()
+
+	return sections
+}
+
+// cleanActionsLog strips timestamps and workflow command markers from a log
+func cleanActionsLog(logs string) string {
+	lines := strings.Split(strings.ReplaceAll(logs, "\r\n", "\n"), "\n")
+	result := lines[:0]
+	for _, line := range lines {
+		line = logTimestampPattern.ReplaceAllString(line, "")
+		switch {
+		case strings.HasPrefix(line, "##[endgroup]"):
+			continue
+		case strings.HasPrefix(line, "##[group]"):
+			line = strings.TrimPrefix(line, "##[group]")
+		case strings.HasPrefix(line, "##[error]"):
+			line = "Error: " + strings.TrimPrefix(line, "##[error]")
+		}
+		result = append(result, line)
+	}
+	return strings.TrimRight(strings.Join(result, "\n"), "\n")
+}
@@ -0,0 +1,185 @@
+package ci
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rithyhuot/vibe/internal/services/github"
+)
+
+// fakeActionsClient serves canned GitHub Actions responses
+type fakeActionsClient struct {
+	runs      []github.WorkflowRunResponse
+	jobs      map[int64][]github.WorkflowJobResponse
+	artifacts map[int64][]github.ArtifactResponse
+	archives  map[int64][]byte
+	logs      string
+}
+
+func (f *fakeActionsClient) Repository() string {
+	return "org/repo"
+}
+
+func (f *fakeActionsClient) ListWorkflowRuns(_ context.Context, _ string) ([]github.WorkflowRunResponse, error) {
+	return f.runs, nil
+}
+
+func (f *fakeActionsClient) GetWorkflowRunJobs(_ context.Context, runID int64) ([]github.WorkflowJobResponse, error) {
+	return f.jobs[runID], nil
+}
+
+func (f *fakeActionsClient) GetWorkflowJob(_ context.Context, jobID int64) (*github.WorkflowJobResponse, error) {
+	for _, jobs := range f.jobs {
+		for _, job := range jobs {
+			if job.ID == jobID {
+				return &job, nil
+			}
+		}
+	}
+	return nil, assert.AnError
+}
+
+func (f *fakeActionsClient) GetJobLogs(_ context.Context, _ int64) (string, error) {
+	return f.logs, nil
+}
+
+func (f *fakeActionsClient) ListRunArtifacts(_ context.Context, runID int64) ([]github.ArtifactResponse, error) {
+	return f.artifacts[runID], nil
+}
+
+func (f *fakeActionsClient) DownloadArtifact(_ context.Context, artifactID int64) ([]byte, error) {
+	return f.archives[artifactID], nil
+}
+
+// zipArchive builds a zip archive holding the given files
+func zipArchive(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestGitHubActions_GetCIStatusForBranch(t *testing.T) {
+	client := &fakeActionsClient{
+		runs: []github.WorkflowRunResponse{
+			{ID: 30, Name: "Lint", RunNumber: 41, HeadSHA: "new", Status: "completed", Conclusion: "success"},
+			{ID: 20, Name: "Test", RunNumber: 88, HeadSHA: "new", Status: "completed", Conclusion: "failure"},
+			{ID: 15, Name: "Docs", HeadSHA: "new", Status: "completed", Conclusion: "skipped"},
+			{ID: 10, Name: "Test", RunNumber: 87, HeadSHA: "old", Status: "completed", Conclusion: "success"},
+		},
+		jobs: map[int64][]github.WorkflowJobResponse{
+			20: {
+				{ID: 201, Name: "unit", Status: "completed", Conclusion: "failure", HTMLURL: "https://github.com/org/repo/actions/runs/20/job/201"},
+				{ID: 202, Name: "integration", Status: "in_progress"},
+				{ID: 203, Name: "e2e", Status: "queued"},
+			},
+			30: {
+				{ID: 301, Name: "golangci", Status: "completed", Conclusion: "success"},
+			},
+		},
+		artifacts: map[int64][]github.ArtifactResponse{
+			20: {
+				{ID: 1, Name: "unit-test-results", SizeInBytes: 100},
+				{ID: 2, Name: "binaries", SizeInBytes: 100},
+			},
+		},
+		archives: map[int64][]byte{
+			1: zipArchive(t, map[string]string{
+				"report.xml": `<testsuite name="pkg"><testcase name="TestA"/><testcase name="TestB" classname="pkg"><failure message="boom">want 1, got 2</failure></testcase></testsuite>`,
+				"README.md":  "not a report",
+			}),
+		},
+	}
+
+	status, err := NewGitHubActions(client).GetCIStatusForBranch(context.Background(), "feature")
+	require.NoError(t, err)
+	require.NotNil(t, status)
+
+	assert.Equal(t, "org/repo", status.ProjectSlug)
+	assert.Equal(t, "new", status.PipelineID)
+
+	// Skipped runs and runs of older commits are left out; oldest run first
+	require.Len(t, status.Workflows, 2)
+	assert.Equal(t, "Test", status.Workflows[0].Name)
+	assert.Equal(t, "failed", status.Workflows[0].Status)
+	assert.Equal(t, "Lint", status.Workflows[1].Name)
+	assert.Equal(t, "success", status.Workflows[1].Status)
+
+	jobs := status.Workflows[0].Jobs
+	require.Len(t, jobs, 3)
+	assert.Equal(t, []string{"failed", "running", "queued"}, []string{jobs[0].Status, jobs[1].Status, jobs[2].Status})
+
+	require.Len(t, status.FailedJobs, 1)
+	failed := status.FailedJobs[0]
+	assert.Equal(t, 201, failed.JobNumber)
+	assert.Equal(t, "Test", failed.WorkflowName)
+	require.Len(t, failed.FailedTests, 1)
+	assert.Equal(t, "TestB", failed.FailedTests[0].Name)
+	assert.Equal(t, "boom\nwant 1, got 2", failed.FailedTests[0].Message)
+}
+
+func TestGitHubActions_GetCIStatusForBranch_NoRuns(t *testing.T) {
+	status, err := NewGitHubActions(&fakeActionsClient{}).GetCIStatusForBranch(context.Background(), "feature")
+	require.NoError(t, err)
+	assert.Nil(t, status)
+}
+
+func TestGitHubActions_GetFailedSteps(t *testing.T) {
+	client := &fakeActionsClient{
+		jobs: map[int64][]github.WorkflowJobResponse{
+			20: {{
+				ID:         201,
+				Name:       "unit",
+				Status:     "completed",
+				Conclusion: "failure",
+				Steps: []github.WorkflowStep{
+					{Name: "Set up job", Conclusion: "success"},
+					{Name: "Run tests", Conclusion: "failure"},
+				},
+			}},
+		},
+		logs: "\ufeff2024-05-01T10:00:00.0000000Z ##[group]Run actions/checkout@v4\n" +
+			"2024-05-01T10:00:01.0000000Z Checked out\n" +
+			"2024-05-01T10:00:02.0000000Z ##[endgroup]\n" +
+			"2024-05-01T10:00:03.0000000Z ##[group]Run go test ./...\n" +
+			"2024-05-01T10:00:03.1000000Z go test ./...\n" +
+			"2024-05-01T10:00:03.2000000Z ##[endgroup]\n" +
+			"2024-05-01T10:00:04.0000000Z --- FAIL: TestB\n" +
+			"2024-05-01T10:00:05.0000000Z ##[error]Process completed with exit code 1.\n",
+	}
+
+	steps, err := NewGitHubActions(client).GetFailedSteps(context.Background(), 201)
+	require.NoError(t, err)
+	require.Len(t, steps, 1)
+
+	assert.Equal(t, "Run tests", steps[0].Name)
+	assert.Equal(t, "Run go test ./...\ngo test ./...\n--- FAIL: TestB\nError: Process completed with exit code 1.", steps[0].Actions[0].Output)
+}
+
+func TestActionsStatus(t *testing.T) {
+	tests := []struct {
+		status, conclusion, want string
+	}{
+		{"completed", "success", "success"},
+		{"completed", "timed_out", "failed"},
+		{"completed", "cancelled", "canceled"},
+		{"completed", "neutral", "skipped"},
+		{"waiting", "", "on_hold"},
+		{"in_progress", "", "running"},
+		{"pending", "", "queued"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, actionsStatus(tt.status, tt.conclusion), "%s/%s", tt.status, tt.conclusion)
+	}
+}
@@ -0,0 +1,165 @@
+package ci
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/rithyhuot/vibe/internal/services/circleci"
+	"github.com/rithyhuot/vibe/internal/services/gitlab"
+)
+
+// GitLab is the Provider for GitLab pipelines. Pipeline stages are shown as
+// workflows and job IDs as job numbers.
+type GitLab struct {
+	client gitlab.Client
+}
+
+// NewGitLab creates a GitLab CI provider
+func NewGitLab(client gitlab.Client) *GitLab {
+	return &GitLab{client: client}
+}
+
+// Name returns the display name of the provider
+func (c *GitLab) Name() string {
+	return "GitLab CI"
+}
+
+// ProjectSlug returns the GitLab project path
+func (c *GitLab) ProjectSlug() string {
+	return c.client.Project()
+}
+
+// GetCIStatusForBranch returns the status of the latest pipeline for a branch
+func (c *GitLab) GetCIStatusForBranch(ctx context.Context, branch string) (*circleci.CIStatus, error) {
+	pipeline, err := c.client.GetPipelineStatus(ctx, branch)
+	if err != nil || pipeline == nil {
+		return nil, err
+	}
+	return gitlabCIStatus(pipeline), nil
+}
+
+// GetFailedSteps returns the log of a failed job as a single step
+func (c *GitLab) GetFailedSteps(ctx context.Context, jobNumber int) ([]circleci.FailedStep, error) {
+	job, err := c.client.GetJob(ctx, jobNumber)
+	if err != nil {
+		return nil, err
+	}
+	if job.Status != "failed" {
+		return nil, nil
+	}
+
+	trace, err := c.client.GetJobTrace(ctx, jobNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	// GitLab doesn't split job logs into steps, so the whole trace is one step
+	return []circleci.FailedStep{{
+		Name: job.Name,
+		Actions: []circleci.FailedAction{{
+			Name:   job.Stage,
+			Status: job.Status,
+			Output: trace,
+		}},
+	}}, nil
+}
+
+// gitlabCIStatus converts a GitLab pipeline to the CI status shown by ci-status
+func gitlabCIStatus(pipeline *gitlab.PipelineStatus) *circleci.CIStatus {
+	status := &circleci.CIStatus{
+		Branch:         pipeline.Branch,
+		ProjectSlug:    pipeline.Project,
+		PipelineNumber: pipeline.Pipeline.IID,
+		PipelineID:     strconv.Itoa(pipeline.Pipeline.ID),
+		Revision:       pipeline.Pipeline.SHA,
+	}
+
+	stages := make(map[string]int)
+	for _, job := range pipeline.Jobs {
+		i, ok := stages[job.Stage]
+		if !ok {
+			i = len(status.Workflows)
+			stages[job.Stage] = i
+			status.Workflows = append(status.Workflows, circleci.WorkflowStatus{Name: job.Stage})
+		}
+
+		status.Workflows[i].Jobs = append(status.Workflows[i].Jobs, circleci.Job{
+			ID:        strconv.Itoa(job.ID),
+			Name:      job.Name,
+			Status:    gitlabJobStatus(job),
+			JobNumber: job.ID,
+			StartedAt: job.StartedAt,
+			StoppedAt: job.FinishedAt,
+		})
+
+		if gitlab.IsBlockingFailure(job) {
+			failedJob := circleci.FailedJob{
+				Name:         job.Name,
+				JobNumber:    job.ID,
+				WebURL:       job.WebURL,
+				WorkflowName: job.Stage,
+			}
+			for _, tc := range pipeline.FailedTests[job.Name] {
+				failedJob.FailedTests = append(failedJob.FailedTests, circleci.TestMetadata{
+					Name:      tc.Name,
+					Classname: tc.Classname,
+					File:      tc.File,
+					Result:    "failure",
+					Message:   tc.SystemOutput,
+					RunTime:   tc.ExecutionTime,
+				})
+			}
+			status.FailedJobs = append(status.FailedJobs, failedJob)
+		}
+	}
+
+	for i := range status.Workflows {
+		status.Workflows[i].Status = gitlabStageStatus(status.Workflows[i].Jobs)
+	}
+
+	return status
+}
+
+// gitlabJobStatus normalizes a GitLab job status to the CircleCI job statuses
+// used by the CI commands
+func gitlabJobStatus(job gitlab.JobResponse) string {
+	switch job.Status {
+	case "failed":
+		if job.AllowFailure {
+			return "allowed_failure"
+		}
+		return "failed"
+	case "created", "pending", "preparing", "waiting_for_resource", "scheduled":
+		return "queued"
+	case "manual":
+		// Optional manual jobs don't hold up the pipeline
+		if job.AllowFailure {
+			return "manual"
+		}
+		return "on_hold"
+	default:
+		// success, running, canceled, and skipped match
+		return job.Status
+	}
+}
+
+// gitlabStageStatus summarizes the normalized statuses of a stage's jobs
+func gitlabStageStatus(jobs []circleci.Job) string {
+	counts := make(map[string]int)
+	for _, job := range jobs {
+		counts[job.Status]++
+	}
+
+	switch {
+	case counts["running"] > 0 || counts["queued"] > 0:
+		return "running"
+	case counts["failed"] > 0:
+		return "failed"
+	case counts["canceled"] > 0:
+		return "canceled"
+	case counts["on_hold"] > 0:
+		return "on_hold"
+	default:
+		return "success"
+	}
+}
@@ -0,0 +1,44 @@
+package ci
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rithyhuot/vibe/internal/services/gitlab"
+)
+
+func TestGitLabCIStatus(t *testing.T) {
+	pipeline := &gitlab.PipelineStatus{
+		Branch:   "feature",
+		Project:  "group/repo",
+		Pipeline: gitlab.PipelineResponse{ID: 100, IID: 12},
+		Jobs: []gitlab.JobResponse{
+			{ID: 1, Name: "build", Stage: "build", Status: "success"},
+			{ID: 2, Name: "lint", Stage: "test", Status: "failed", AllowFailure: true},
+			{ID: 3, Name: "unit", Stage: "test", Status: "failed"},
+			{ID: 4, Name: "deploy", Stage: "deploy", Status: "manual", AllowFailure: true},
+		},
+		FailedTests: map[string][]gitlab.TestCase{
+			"unit": {{Name: "TestB", Status: "failed", SystemOutput: "boom"}},
+		},
+	}
+
+	status := gitlabCIStatus(pipeline)
+
+	assert.Equal(t, 12, status.PipelineNumber)
+	require.Len(t, status.Workflows, 3)
+	assert.Equal(t, []string{"build", "test", "deploy"}, []string{status.Workflows[0].Name, status.Workflows[1].Name, status.Workflows[2].Name})
+	assert.Equal(t, "failed", status.Workflows[1].Status)
+	assert.Equal(t, "allowed_failure", status.Workflows[1].Jobs[0].Status)
+
+	// Optional manual jobs don't hold up the pipeline
+	assert.Equal(t, "success", status.Workflows[2].Status)
+
+	// Jobs allowed to fail aren't reported as failures
+	require.Len(t, status.FailedJobs, 1)
+	assert.Equal(t, "unit", status.FailedJobs[0].Name)
+	require.Len(t, status.FailedJobs[0].FailedTests, 1)
+	assert.Equal(t, "boom", status.FailedJobs[0].FailedTests[0].Message)
+}
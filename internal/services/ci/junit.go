@@ -0,0 +1,143 @@
+package ci
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/rithyhuot/vibe/internal/services/circleci"
+)
+
+// junitSuite is a <testsuite>, or the <testsuites> element wrapping them
+type junitSuite struct {
+	Name   string       `xml:"name,attr"`
+	File   string       `xml:"file,attr"`
+	Suites []junitSuite `xml:"testsuite"`
+	Cases  []junitCase  `xml:"testcase"`
+}
+
+// junitCase is a single <testcase>
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	File      string        `xml:"file,attr"`
+	Time      string        `xml:"time,attr"` // Parsed leniently, since some reporters format it
+	Failure   *junitFailure `xml:"failure"`
+	Error     *junitFailure `xml:"error"`
+}
+
+// junitFailure is the <failure> or <error> of a failed test case
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// ParseJUnit returns the failed test cases of a JUnit XML report. Both
+// <testsuites> and bare <testsuite> documents are accepted.
+func ParseJUnit(data []byte) ([]circleci.TestMetadata, error) {
+	var root junitSuite
+	if err := xml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("failed to parse JUnit report: %w", err)
+	}
+
+	var failed []circleci.TestMetadata
+	collectFailedCases(root, &failed)
+	return failed, nil
+}
+
+// collectFailedCases appends the failed test cases of a suite and its nested suites
+func collectFailedCases(suite junitSuite, failed *[]circleci.TestMetadata) {
+	for _, tc := range suite.Cases {
+		failure := tc.Failure
+		if failure == nil {
+			failure = tc.Error
+		}
+		if failure == nil {
+			continue
+		}
+
+		file := tc.File
+		if file == "" {
+			file = suite.File
+		}
+		runTime, _ := strconv.ParseFloat(strings.ReplaceAll(tc.Time, ",", ""), 64)
+
+		*failed = append(*failed, circleci.TestMetadata{
+			Name:      tc.Name,
+			Classname: tc.Classname,
+			File:      file,
+			Result:    "failure",
+			Message:   failureMessage(failure),
+			RunTime:   runTime,
+		})
+	}
+
+	for _, nested := range suite.Suites {
+		collectFailedCases(nested, failed)
+	}
+}
+
+// failureMessage combines the message attribute and body of a failure,
+// which reporters fill inconsistently
+func failureMessage(f *junitFailure) string {
+	message := strings.TrimSpace(f.Message)
+	text := strings.TrimSpace(f.Text)
+
+	switch {
+	case text == "":
+		return message
+	case message == "" || strings.Contains(text, message):
+		return text
+	default:
+		return message + "\n" + text
+	}
+}
+
+// ParseJUnitArchive returns the failed test cases of every JUnit XML report
+// in a zip archive. Files that aren't valid JUnit reports are skipped.
+func ParseJUnitArchive(data []byte) ([]circleci.TestMetadata, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open archive: %w", err)
+	}
+
+	var failed []circleci.TestMetadata
+	for _, file := range archive.File {
+		if file.FileInfo().IsDir() || !strings.EqualFold(path.Ext(file.Name), ".xml") {
+			continue
+		}
+
+		content, err := readZipFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		tests, err := ParseJUnit(content)
+		if err != nil {
+			continue
+		}
+		failed = append(failed, tests...)
+	}
+
+	return failed, nil
+}
+
+// readZipFile reads the contents of a file in a zip archive
+func readZipFile(file *zip.File) ([]byte, error) {
+	r, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", file.Name, err)
+	}
+	defer r.Close() //nolint:errcheck // Close error on a read-only file is acceptable
+
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", file.Name, err)
+	}
+	return content, nil
+}
@@ -0,0 +1,44 @@
+package ci
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseJUnit(t *testing.T) {
+	report := `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="api" file="api/handler_test.go">
+    <testcase name="TestCreate" classname="api" time="0.01"/>
+    <testcase name="TestDelete" classname="api" time="1,204.5">
+      <failure message="expected 204">handler_test.go:42: expected 204, got 500</failure>
+    </testcase>
+    <testsuite name="nested">
+      <testcase name="TestPanic" classname="api.nested" file="api/nested_test.go">
+        <error message="panic: nil map"/>
+      </testcase>
+    </testsuite>
+  </testsuite>
+</testsuites>`
+
+	failed, err := ParseJUnit([]byte(report))
+	require.NoError(t, err)
+	require.Len(t, failed, 2)
+
+	assert.Equal(t, "TestDelete", failed[0].Name)
+	assert.Equal(t, "api/handler_test.go", failed[0].File)
+	// The message is already part of the body
+	assert.Equal(t, "handler_test.go:42: expected 204, got 500", failed[0].Message)
+	assert.InDelta(t, 1204.5, failed[0].RunTime, 0.001)
+
+	assert.Equal(t, "TestPanic", failed[1].Name)
+	assert.Equal(t, "api/nested_test.go", failed[1].File)
+	assert.Equal(t, "panic: nil map", failed[1].Message)
+}
+
+func TestParseJUnit_Invalid(t *testing.T) {
+	_, err := ParseJUnit([]byte("not xml <"))
+	assert.Error(t, err)
+}
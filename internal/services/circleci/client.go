@@ -4,9 +4,9 @@ package circleci
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/http"
 	"net/url"
-	"os/exec"
-	"regexp"
 	"strings"
 
 	"github.com/rithyhuot/vibe/internal/utils"
@@ -20,12 +20,24 @@ const (
 // Client interface defines CircleCI operations
 type Client interface {
 	GetPipelinesByBranch(ctx context.Context, projectSlug, branch string) ([]Pipeline, error)
+	ListPipelines(ctx context.Context, projectSlug, branch string, limit int) ([]Pipeline, error)
 	GetWorkflows(ctx context.Context, pipelineID string) ([]Workflow, error)
 	GetJobs(ctx context.Context, workflowID string) ([]Job, error)
 	GetJobDetail(ctx context.Context, projectSlug string, jobNumber int) (*JobDetail, error)
 	GetTestMetadata(ctx context.Context, projectSlug string, jobNumber int) ([]TestMetadata, error)
+	GetJobArtifacts(ctx context.Context, projectSlug string, jobNumber int) ([]Artifact, error)
+	DownloadArtifact(ctx context.Context, artifactURL string, w io.Writer) (int64, error)
 	GetBuildDetails(ctx context.Context, projectSlug string, buildNumber int) ([]FailedStep, error)
 	GetCIStatusForBranch(ctx context.Context, branch, projectSlug string) (*CIStatus, error)
+	GetFlakyTests(ctx context.Context, projectSlug string) ([]FlakyTest, error)
+	GetJobInsights(ctx context.Context, projectSlug, workflowName, branch string) ([]JobInsights, error)
+	CompileConfig(ctx context.Context, configYAML string) (*CompileConfigResponse, error)
+
+	// Pipeline and workflow actions
+	TriggerPipeline(ctx context.Context, projectSlug string, req *TriggerPipelineRequest) (*Pipeline, error)
+	RerunWorkflow(ctx context.Context, workflowID string, req *RerunRequest) (string, error)
+	CancelWorkflow(ctx context.Context, workflowID string) error
+	ApproveJob(ctx context.Context, workflowID, approvalRequestID string) error
 }
 
 // HTTPClient implements the Client interface using HTTP
@@ -42,6 +54,18 @@ func NewClient(apiToken string) *HTTPClient {
 	}
 }
 
+// WithRetryPolicy sets the retry budget for requests made by this client
+func (c *HTTPClient) WithRetryPolicy(policy utils.RetryPolicy) *HTTPClient {
+	c.httpClient.WithRetryPolicy(policy)
+	return c
+}
+
+// WithCache enables on-disk caching of GET responses made by this client
+func (c *HTTPClient) WithCache(cache *utils.ResponseCache) *HTTPClient {
+	c.httpClient.WithCache(cache)
+	return c
+}
+
 // headers returns the common headers for CircleCI API requests
 func (c *HTTPClient) headers() map[string]string {
 	return map[string]string{
@@ -50,31 +74,6 @@ func (c *HTTPClient) headers() map[string]string {
 	}
 }
 
-// GetProjectSlug extracts the project slug from git remote
-func GetProjectSlug() (string, error) {
-	cmd := exec.Command("git", "remote", "get-url", "origin")
-	output, err := cmd.Output()
-	if err != nil {
-		return "", fmt.Errorf("failed to get git remote: %w", err)
-	}
-
-	urlStr := strings.TrimSpace(string(output))
-
-	// Handle SSH format: git@github.com:org/repo.git
-	sshRegex := regexp.MustCompile(`git@github\.com:([^/]+)/([^.]+)`)
-	if matches := sshRegex.FindStringSubmatch(urlStr); len(matches) == 3 {
-		return fmt.Sprintf("gh/%s/%s", matches[1], matches[2]), nil
-	}
-
-	// Handle HTTPS format: https://github.com/org/repo.git
-	httpsRegex := regexp.MustCompile(`github\.com/([^/]+)/([^.]+)`)
-	if matches := httpsRegex.FindStringSubmatch(urlStr); len(matches) == 3 {
-		return fmt.Sprintf("gh/%s/%s", matches[1], matches[2]), nil
-	}
-
-	return "", fmt.Errorf("could not parse git remote URL: %s", urlStr)
-}
-
 // GetPipelinesByBranch retrieves pipelines for a specific branch
 func (c *HTTPClient) GetPipelinesByBranch(ctx context.Context, projectSlug, branch string) ([]Pipeline, error) {
 	u := fmt.Sprintf("%s/project/%s/pipeline?branch=%s", baseURL, projectSlug, url.QueryEscape(branch))
@@ -88,6 +87,37 @@ func (c *HTTPClient) GetPipelinesByBranch(ctx context.Context, projectSlug, bran
 	return resp.Items, nil
 }
 
+// ListPipelines retrieves up to limit of the most recent pipelines for a
+// branch, following pagination
+func (c *HTTPClient) ListPipelines(ctx context.Context, projectSlug, branch string, limit int) ([]Pipeline, error) {
+	var pipelines []Pipeline
+	pageToken := ""
+
+	for len(pipelines) < limit {
+		u := fmt.Sprintf("%s/project/%s/pipeline?branch=%s", baseURL, projectSlug, url.QueryEscape(branch))
+		if pageToken != "" {
+			u += "&page-token=" + url.QueryEscape(pageToken)
+		}
+
+		var resp PipelineResponse
+		err := c.httpClient.DoJSONRequest(ctx, "GET", u, nil, &resp, c.headers())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pipelines: %w", err)
+		}
+
+		pipelines = append(pipelines, resp.Items...)
+		if resp.NextPageToken == nil || *resp.NextPageToken == "" {
+			break
+		}
+		pageToken = *resp.NextPageToken
+	}
+
+	if len(pipelines) > limit {
+		pipelines = pipelines[:limit]
+	}
+	return pipelines, nil
+}
+
 // GetWorkflows retrieves workflows for a pipeline
 func (c *HTTPClient) GetWorkflows(ctx context.Context, pipelineID string) ([]Workflow, error) {
 	u := fmt.Sprintf("%s/pipeline/%s/workflow", baseURL, pipelineID)
@@ -140,27 +170,125 @@ func (c *HTTPClient) GetTestMetadata(ctx context.Context, projectSlug string, jo
 	return resp.Items, nil
 }
 
-// GetBuildDetails retrieves build details including step output (v1.1 API)
-func (c *HTTPClient) GetBuildDetails(ctx context.Context, projectSlug string, buildNumber int) ([]FailedStep, error) {
-	// Convert project slug from "gh/org/repo" to "github/org/repo" for v1.1 API
-	parts := strings.Split(projectSlug, "/")
-	if len(parts) != 3 {
-		return nil, fmt.Errorf("invalid project slug: %s", projectSlug)
+// GetFlakyTests retrieves the tests CircleCI Insights detected as flaky in
+// the project's recent history
+func (c *HTTPClient) GetFlakyTests(ctx context.Context, projectSlug string) ([]FlakyTest, error) {
+	u := fmt.Sprintf("%s/insights/%s/flaky-tests", baseURL, projectSlug)
+
+	var resp FlakyTestsResponse
+	err := c.httpClient.DoJSONRequest(ctx, "GET", u, nil, &resp, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get flaky tests: %w", err)
 	}
 
-	vcsShort := parts[0]
-	vcsType := vcsShort
-	if vcsShort == "gh" {
-		vcsType = "github"
+	return resp.FlakyTests, nil
+}
+
+// GetJobInsights retrieves the Insights duration and success metrics of each
+// job of a workflow on a branch over the last 30 days
+func (c *HTTPClient) GetJobInsights(ctx context.Context, projectSlug, workflowName, branch string) ([]JobInsights, error) {
+	var jobs []JobInsights
+	pageToken := ""
+
+	for {
+		u := fmt.Sprintf("%s/insights/%s/workflows/%s/jobs?branch=%s&reporting-window=last-30-days",
+			baseURL, projectSlug, url.PathEscape(workflowName), url.QueryEscape(branch))
+		if pageToken != "" {
+			u += "&page-token=" + url.QueryEscape(pageToken)
+		}
+
+		var resp JobInsightsResponse
+		err := c.httpClient.DoJSONRequest(ctx, "GET", u, nil, &resp, c.headers())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get job insights: %w", err)
+		}
+
+		jobs = append(jobs, resp.Items...)
+		if resp.NextPageToken == nil || *resp.NextPageToken == "" {
+			return jobs, nil
+		}
+		pageToken = *resp.NextPageToken
 	}
+}
 
-	org := parts[1]
-	repo := parts[2]
+// GetJobArtifacts retrieves all artifacts stored by a job
+func (c *HTTPClient) GetJobArtifacts(ctx context.Context, projectSlug string, jobNumber int) ([]Artifact, error) {
+	var artifacts []Artifact
+	pageToken := ""
 
-	u := fmt.Sprintf("%s/project/%s/%s/%s/%d", baseURLV1, vcsType, org, repo, buildNumber)
+	for {
+		u := fmt.Sprintf("%s/project/%s/%d/artifacts", baseURL, projectSlug, jobNumber)
+		if pageToken != "" {
+			u += "?page-token=" + url.QueryEscape(pageToken)
+		}
+
+		var resp ArtifactResponse
+		err := c.httpClient.DoJSONRequest(ctx, "GET", u, nil, &resp, c.headers())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get artifacts: %w", err)
+		}
+
+		artifacts = append(artifacts, resp.Items...)
+		if resp.NextPageToken == nil || *resp.NextPageToken == "" {
+			return artifacts, nil
+		}
+		pageToken = *resp.NextPageToken
+	}
+}
+
+// DownloadArtifact streams an artifact to w and returns its size. The token
+// is only sent to CircleCI hosts, since artifact URLs may point elsewhere.
+func (c *HTTPClient) DownloadArtifact(ctx context.Context, artifactURL string, w io.Writer) (int64, error) {
+	u, err := url.Parse(artifactURL)
+	if err != nil {
+		return 0, fmt.Errorf("invalid artifact URL: %w", err)
+	}
+
+	headers := map[string]string{}
+	if isCircleCIHost(u.Hostname()) {
+		headers["Circle-Token"] = c.apiToken
+	}
+
+	resp, err := c.httpClient.DoRequest(ctx, "GET", artifactURL, nil, headers)
+	if err != nil {
+		return 0, fmt.Errorf("failed to download artifact: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("failed to download artifact: %s", resp.Status)
+	}
+
+	n, err := io.Copy(w, resp.Body)
+	if err != nil {
+		return n, fmt.Errorf("failed to download artifact: %w", err)
+	}
+	return n, nil
+}
+
+// isCircleCIHost reports whether host belongs to CircleCI, including its
+// artifact domain
+func isCircleCIHost(host string) bool {
+	for _, domain := range []string{"circleci.com", "circle-artifacts.com"} {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetBuildDetails retrieves build details including step output (v1.1 API)
+func (c *HTTPClient) GetBuildDetails(ctx context.Context, projectSlug string, buildNumber int) ([]FailedStep, error) {
+	// The v1.1 API spells out the VCS, e.g. github/org/repo for gh/org/repo
+	projectPath, err := v1ProjectPath(projectSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("%s/project/%s/%d", baseURLV1, projectPath, buildNumber)
 
 	var details BuildDetails
-	err := c.httpClient.DoJSONRequest(ctx, "GET", u, nil, &details, c.headers())
+	err = c.httpClient.DoJSONRequest(ctx, "GET", u, nil, &details, c.headers())
 	if err != nil {
 		return nil, fmt.Errorf("failed to get build details: %w", err)
 	}
@@ -303,7 +431,72 @@ func (c *HTTPClient) GetCIStatusForBranch(ctx context.Context, branch, projectSl
 		ProjectSlug:    projectSlug,
 		PipelineNumber: pipeline.Number,
 		PipelineID:     pipeline.ID,
+		Revision:       pipeline.VCS.Revision,
 		Workflows:      workflowStatuses,
 		FailedJobs:     failedJobs,
 	}, nil
 }
+
+// TriggerPipeline starts a pipeline for a branch with optional pipeline parameters
+func (c *HTTPClient) TriggerPipeline(ctx context.Context, projectSlug string, req *TriggerPipelineRequest) (*Pipeline, error) {
+	u := fmt.Sprintf("%s/project/%s/pipeline", baseURL, projectSlug)
+
+	var pipeline Pipeline
+	err := c.httpClient.DoJSONRequest(ctx, "POST", u, req, &pipeline, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to trigger pipeline: %w", err)
+	}
+
+	return &pipeline, nil
+}
+
+// RerunWorkflow reruns a workflow and returns the ID of the new workflow
+func (c *HTTPClient) RerunWorkflow(ctx context.Context, workflowID string, req *RerunRequest) (string, error) {
+	u := fmt.Sprintf("%s/workflow/%s/rerun", baseURL, workflowID)
+
+	var resp RerunResponse
+	err := c.httpClient.DoJSONRequest(ctx, "POST", u, req, &resp, c.headers())
+	if err != nil {
+		return "", fmt.Errorf("failed to rerun workflow: %w", err)
+	}
+
+	return resp.WorkflowID, nil
+}
+
+// CancelWorkflow cancels a running workflow
+func (c *HTTPClient) CancelWorkflow(ctx context.Context, workflowID string) error {
+	u := fmt.Sprintf("%s/workflow/%s/cancel", baseURL, workflowID)
+
+	err := c.httpClient.DoJSONRequest(ctx, "POST", u, nil, nil, c.headers())
+	if err != nil {
+		return fmt.Errorf("failed to cancel workflow: %w", err)
+	}
+
+	return nil
+}
+
+// ApproveJob approves a pending approval job, letting its workflow continue
+func (c *HTTPClient) ApproveJob(ctx context.Context, workflowID, approvalRequestID string) error {
+	u := fmt.Sprintf("%s/workflow/%s/approve/%s", baseURL, workflowID, approvalRequestID)
+
+	err := c.httpClient.DoJSONRequest(ctx, "POST", u, nil, nil, c.headers())
+	if err != nil {
+		return fmt.Errorf("failed to approve job: %w", err)
+	}
+
+	return nil
+}
+
+// CompileConfig compiles a config with CircleCI, which resolves orbs and
+// reports errors the local checks can't find
+func (c *HTTPClient) CompileConfig(ctx context.Context, configYAML string) (*CompileConfigResponse, error) {
+	u := fmt.Sprintf("%s/compile-config-with-defaults", baseURL)
+
+	var resp CompileConfigResponse
+	err := c.httpClient.DoJSONRequest(ctx, "POST", u, &CompileConfigRequest{ConfigYAML: configYAML}, &resp, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile config: %w", err)
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,370 @@
+package circleci
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// ConfigPath is where a repository keeps its CircleCI config, relative to its root
+const ConfigPath = ".circleci/config.yml"
+
+// Config is the part of a CircleCI config file that vibe reads
+type Config struct {
+	Version    string                       `yaml:"version"`
+	Parameters map[string]PipelineParameter `yaml:"parameters"`
+	Orbs       map[string]OrbRef            `yaml:"orbs"`
+	Commands   map[string]ConfigCommand     `yaml:"commands"`
+	Executors  map[string]interface{}       `yaml:"executors"`
+	Jobs       map[string]ConfigJob         `yaml:"jobs"`
+	Workflows  ConfigWorkflows              `yaml:"workflows"`
+
+	// raw is the file's contents, for checks on << >> references
+	raw []byte
+}
+
+// PipelineParameter is a parameter declared in the top-level parameters
+// block of a config, or in the parameters of a job or command
+type PipelineParameter struct {
+	Type        string      `yaml:"type"`
+	Description string      `yaml:"description"`
+	Default     interface{} `yaml:"default"`
+	Enum        []string    `yaml:"enum"`
+}
+
+// OrbRef is an entry of the orbs block: a registry reference such as
+// circleci/node@5.1, or an orb defined inline
+type OrbRef struct {
+	Ref    string
+	Inline bool
+	Line   int
+}
+
+// UnmarshalYAML reads an orb reference or inline orb
+func (o *OrbRef) UnmarshalYAML(node *yaml.Node) error {
+	o.Line = node.Line
+	if node.Kind == yaml.ScalarNode {
+		o.Ref = node.Value
+		return nil
+	}
+	o.Inline = true
+	return nil
+}
+
+// ConfigCommand is a reusable command declared in the commands block
+type ConfigCommand struct {
+	Parameters map[string]PipelineParameter `yaml:"parameters"`
+	Steps      []ConfigStep                 `yaml:"steps"`
+}
+
+// ConfigJob is a job declared in the jobs block
+type ConfigJob struct {
+	Type       string                       `yaml:"type"`
+	Parameters map[string]PipelineParameter `yaml:"parameters"`
+	Steps      []ConfigStep                 `yaml:"steps"`
+	Docker     []interface{}                `yaml:"docker"`
+	Machine    interface{}                  `yaml:"machine"`
+	Macos      interface{}                  `yaml:"macos"`
+	Executor   interface{}                  `yaml:"executor"`
+	Line       int                          `yaml:"-"`
+}
+
+// UnmarshalYAML reads a job, recording where it's declared
+func (j *ConfigJob) UnmarshalYAML(node *yaml.Node) error {
+	type plain ConfigJob
+	if err := node.Decode((*plain)(j)); err != nil {
+		return err
+	}
+	j.Line = node.Line
+	return nil
+}
+
+// ConfigStep is a step of a job or command: a built-in step such as run, a
+// command, or an orb command, with the arguments it's called with
+type ConfigStep struct {
+	Name string
+	Args map[string]interface{}
+	Line int
+}
+
+// UnmarshalYAML reads a step written as a bare name or as a single-key map
+func (s *ConfigStep) UnmarshalYAML(node *yaml.Node) error {
+	s.Line = node.Line
+	switch node.Kind {
+	case yaml.ScalarNode:
+		s.Name = node.Value
+	case yaml.MappingNode:
+		if len(node.Content) < 2 {
+			return fmt.Errorf("line %d: empty step", node.Line)
+		}
+		s.Name = node.Content[0].Value
+		if node.Content[1].Kind == yaml.MappingNode {
+			return node.Content[1].Decode(&s.Args)
+		}
+	default:
+		return fmt.Errorf("line %d: a step must be a name or a map", node.Line)
+	}
+	return nil
+}
+
+// ConfigWorkflows are the workflows of a config by name
+type ConfigWorkflows map[string]ConfigWorkflow
+
+// UnmarshalYAML reads the workflows block, skipping the version key of
+// version 2.0 configs
+func (w *ConfigWorkflows) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("line %d: workflows must be a map", node.Line)
+	}
+
+	*w = make(ConfigWorkflows)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		name := node.Content[i].Value
+		if name == "version" {
+			continue
+		}
+		var workflow ConfigWorkflow
+		if err := node.Content[i+1].Decode(&workflow); err != nil {
+			return err
+		}
+		workflow.Line = node.Content[i].Line
+		(*w)[name] = workflow
+	}
+	return nil
+}
+
+// ConfigWorkflow is a workflow declared in the workflows block
+type ConfigWorkflow struct {
+	Jobs []WorkflowJob `yaml:"jobs"`
+	Line int           `yaml:"-"`
+}
+
+// WorkflowJob is a job invoked by a workflow. Parameters are the arguments
+// passed to the job, and MatrixParameters the ones its matrix expands.
+type WorkflowJob struct {
+	Job              string
+	Name             string
+	Type             string
+	Requires         []string
+	Parameters       map[string]interface{}
+	MatrixParameters map[string]interface{}
+	Line             int
+}
+
+// workflowJobKeys are the keys of a workflow job that aren't job arguments
+var workflowJobKeys = map[string]bool{
+	"requires": true, "name": true, "context": true, "filters": true, "matrix": true,
+	"type": true, "pre-steps": true, "post-steps": true, "serial-group": true, "override-with": true,
+}
+
+// UnmarshalYAML reads a workflow job written as a bare job name or as a
+// single-key map of the job name to its settings
+func (j *WorkflowJob) UnmarshalYAML(node *yaml.Node) error {
+	j.Line = node.Line
+	if node.Kind == yaml.ScalarNode {
+		j.Job = node.Value
+		return nil
+	}
+	if node.Kind != yaml.MappingNode || len(node.Content) != 2 {
+		return fmt.Errorf("line %d: a workflow job must be a job name or a map with one job name", node.Line)
+	}
+
+	j.Job = node.Content[0].Value
+	settings := node.Content[1]
+	if settings.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(settings.Content); i += 2 {
+		key, value := settings.Content[i].Value, settings.Content[i+1]
+		var err error
+		switch key {
+		case "name":
+			j.Name = value.Value
+		case "type":
+			j.Type = value.Value
+		case "requires":
+			j.Requires, err = decodeRequires(value)
+		case "matrix":
+			var matrix struct {
+				Parameters map[string]interface{} `yaml:"parameters"`
+			}
+			err = value.Decode(&matrix)
+			j.MatrixParameters = matrix.Parameters
+		default:
+			if workflowJobKeys[key] {
+				continue
+			}
+			if j.Parameters == nil {
+				j.Parameters = make(map[string]interface{})
+			}
+			var arg interface{}
+			err = value.Decode(&arg)
+			j.Parameters[key] = arg
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ID is the name other jobs of the workflow require this job by
+func (j WorkflowJob) ID() string {
+	if j.Name != "" {
+		return j.Name
+	}
+	return j.Job
+}
+
+// decodeRequires reads the job names of a requires list. Entries are names,
+// or maps of a name to the statuses it's required with.
+func decodeRequires(node *yaml.Node) ([]string, error) {
+	if node.Kind == yaml.ScalarNode {
+		return []string{node.Value}, nil
+	}
+	if node.Kind != yaml.SequenceNode {
+		return nil, fmt.Errorf("line %d: requires must be a list of job names", node.Line)
+	}
+
+	var names []string
+	for _, entry := range node.Content {
+		switch entry.Kind {
+		case yaml.ScalarNode:
+			names = append(names, entry.Value)
+		case yaml.MappingNode:
+			for i := 0; i < len(entry.Content); i += 2 {
+				names = append(names, entry.Content[i].Value)
+			}
+		default:
+			return nil, fmt.Errorf("line %d: requires must be a list of job names", entry.Line)
+		}
+	}
+	return names, nil
+}
+
+// LoadConfig reads a CircleCI config file
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := ParseConfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// ParseConfig parses the contents of a CircleCI config file
+func ParseConfig(data []byte) (*Config, error) {
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	cfg.raw = data
+	return &cfg, nil
+}
+
+// ParsePipelineParameters converts key=value arguments to pipeline parameters
+// for a trigger request. Values are checked against the declared parameters,
+// and declared parameters without a default must be set. When declared is
+// nil, booleans and integers are inferred from the values.
+func ParsePipelineParameters(args []string, declared map[string]PipelineParameter) (map[string]interface{}, error) {
+	params := make(map[string]interface{}, len(args))
+
+	for _, arg := range args {
+		name, value, ok := strings.Cut(arg, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid parameter %q, expected name=value", arg)
+		}
+
+		if declared == nil {
+			params[name] = inferParameterValue(value)
+			continue
+		}
+
+		param, ok := declared[name]
+		if !ok && len(declared) == 0 {
+			return nil, fmt.Errorf("unknown pipeline parameter %q (the config declares no parameters)", name)
+		}
+		if !ok {
+			return nil, fmt.Errorf("unknown pipeline parameter %q (declared: %s)", name, strings.Join(parameterNames(declared), ", "))
+		}
+
+		parsed, err := parseParameterValue(name, param, value)
+		if err != nil {
+			return nil, err
+		}
+		params[name] = parsed
+	}
+
+	for _, name := range parameterNames(declared) {
+		if _, ok := params[name]; !ok && declared[name].Default == nil {
+			return nil, fmt.Errorf("pipeline parameter %q has no default and must be set", name)
+		}
+	}
+
+	return params, nil
+}
+
+// parseParameterValue converts a value to the declared type of a parameter
+func parseParameterValue(name string, param PipelineParameter, value string) (interface{}, error) {
+	switch param.Type {
+	case "string":
+		return value, nil
+	case "boolean":
+		switch strings.ToLower(value) {
+		case "true", "yes", "on":
+			return true, nil
+		case "false", "no", "off":
+			return false, nil
+		}
+		return nil, fmt.Errorf("pipeline parameter %q is a boolean, got %q", name, value)
+	case "integer":
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("pipeline parameter %q is an integer, got %q", name, value)
+		}
+		return n, nil
+	case "enum":
+		for _, allowed := range param.Enum {
+			if value == allowed {
+				return value, nil
+			}
+		}
+		return nil, fmt.Errorf("pipeline parameter %q must be one of %s, got %q", name, strings.Join(param.Enum, ", "), value)
+	default:
+		return nil, fmt.Errorf("pipeline parameter %q has type %q, which can't be set when triggering", name, param.Type)
+	}
+}
+
+// inferParameterValue converts a value to a boolean or integer when it looks like one
+func inferParameterValue(value string) interface{} {
+	switch value {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	if n, err := strconv.Atoi(value); err == nil {
+		return n
+	}
+	return value
+}
+
+// parameterNames returns the names of the declared parameters in order
+func parameterNames(declared map[string]PipelineParameter) []string {
+	names := make([]string, 0, len(declared))
+	for name := range declared {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
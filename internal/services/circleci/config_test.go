@@ -0,0 +1,98 @@
+package circleci
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfig = `version: 2.1
+parameters:
+  run_e2e:
+    type: boolean
+    default: false
+  deploy_env:
+    type: enum
+    enum: [staging, production]
+    default: staging
+  shards:
+    type: integer
+    default: 4
+  release_tag:
+    type: string
+workflows:
+  build:
+    jobs: [test]
+`
+
+func loadTestConfig(t *testing.T) *Config {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yml")
+	require.NoError(t, os.WriteFile(path, []byte(testConfig), 0o600))
+
+	cfg, err := LoadConfig(path)
+	require.NoError(t, err)
+	return cfg
+}
+
+func TestLoadConfig(t *testing.T) {
+	cfg := loadTestConfig(t)
+
+	require.Len(t, cfg.Parameters, 4)
+	assert.Equal(t, "enum", cfg.Parameters["deploy_env"].Type)
+	assert.Equal(t, []string{"staging", "production"}, cfg.Parameters["deploy_env"].Enum)
+	assert.Equal(t, false, cfg.Parameters["run_e2e"].Default)
+	assert.Nil(t, cfg.Parameters["release_tag"].Default)
+}
+
+func TestParsePipelineParameters(t *testing.T) {
+	declared := loadTestConfig(t).Parameters
+
+	params, err := ParsePipelineParameters([]string{
+		"run_e2e=true",
+		"deploy_env=production",
+		"shards=8",
+		"release_tag=v1.2=rc",
+	}, declared)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"run_e2e":     true,
+		"deploy_env":  "production",
+		"shards":      8,
+		"release_tag": "v1.2=rc",
+	}, params)
+}
+
+func TestParsePipelineParameters_Invalid(t *testing.T) {
+	declared := loadTestConfig(t).Parameters
+
+	tests := []struct {
+		name string
+		args []string
+		err  string
+	}{
+		{"missing value", []string{"run_e2e"}, "expected name=value"},
+		{"unknown name", []string{"release_tag=v1", "nightly=true"}, `unknown pipeline parameter "nightly"`},
+		{"bad boolean", []string{"release_tag=v1", "run_e2e=maybe"}, "is a boolean"},
+		{"bad integer", []string{"release_tag=v1", "shards=many"}, "is an integer"},
+		{"bad enum", []string{"release_tag=v1", "deploy_env=dev"}, "must be one of staging, production"},
+		{"required without default", []string{"run_e2e=true"}, `"release_tag" has no default`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParsePipelineParameters(tt.args, declared)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestParsePipelineParameters_Undeclared(t *testing.T) {
+	params, err := ParsePipelineParameters([]string{"run_e2e=true", "shards=3", "env=staging"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"run_e2e": true, "shards": 3, "env": "staging"}, params)
+}
@@ -0,0 +1,175 @@
+package circleci
+
+import (
+	"sort"
+)
+
+// TestRun is one result of a test, from the test metadata of a job
+type TestRun struct {
+	PipelineNumber int
+	Revision       string
+	JobName        string
+	Test           TestMetadata
+}
+
+// FlakyTestStats summarizes the history of a test that both passed and failed
+type FlakyTestStats struct {
+	Name        string
+	Classname   string
+	File        string
+	JobName     string
+	Runs        int  // Runs that passed or failed in the analyzed pipelines
+	Failures    int  // Failed runs in the analyzed pipelines
+	SameCommit  bool // Passed and failed on the same commit
+	TimesFlaked int  // Flakes reported by Insights
+}
+
+// FlakeRate returns the share of analyzed runs that failed
+func (s FlakyTestStats) FlakeRate() float64 {
+	if s.Runs == 0 {
+		return 0
+	}
+	return float64(s.Failures) / float64(s.Runs)
+}
+
+// testKey identifies a test across runs of the same job
+type testKey struct {
+	jobName   string
+	classname string
+	name      string
+}
+
+// DetectFlakyTests finds the tests that both passed and failed on the same
+// commit, or that flipped between passing and failing and back across
+// consecutive runs. Runs may be in any order; they're compared by pipeline.
+func DetectFlakyTests(runs []TestRun) []FlakyTestStats {
+	byTest := make(map[testKey][]TestRun)
+	var keys []testKey
+	for _, run := range runs {
+		if !isTestPassed(run.Test) && !isTestFailed(run.Test) {
+			continue
+		}
+		key := testKey{jobName: run.JobName, classname: run.Test.Classname, name: run.Test.Name}
+		if _, ok := byTest[key]; !ok {
+			keys = append(keys, key)
+		}
+		byTest[key] = append(byTest[key], run)
+	}
+
+	var flaky []FlakyTestStats
+	for _, key := range keys {
+		history := byTest[key]
+		sort.SliceStable(history, func(i, j int) bool {
+			return history[i].PipelineNumber < history[j].PipelineNumber
+		})
+
+		stats := FlakyTestStats{
+			Name:      key.name,
+			Classname: key.classname,
+			JobName:   key.jobName,
+			Runs:      len(history),
+		}
+
+		outcomes := make(map[string][2]bool) // Revision -> passed, failed
+		flips := 0
+		for i, run := range history {
+			failed := isTestFailed(run.Test)
+			if failed {
+				stats.Failures++
+			}
+			if stats.File == "" {
+				stats.File = run.Test.File
+			}
+			if i > 0 && failed != isTestFailed(history[i-1].Test) {
+				flips++
+			}
+			if run.Revision != "" {
+				seen := outcomes[run.Revision]
+				if failed {
+					seen[1] = true
+				} else {
+					seen[0] = true
+				}
+				outcomes[run.Revision] = seen
+			}
+		}
+
+		for _, seen := range outcomes {
+			if seen[0] && seen[1] {
+				stats.SameCommit = true
+			}
+		}
+
+		// A single flip may be a real breakage or fix; flipping back isn't
+		if stats.SameCommit || flips >= 2 {
+			flaky = append(flaky, stats)
+		}
+	}
+
+	return flaky
+}
+
+// MergeFlakyTests adds the tests Insights reports as flaky to the stats from
+// history, and ranks them by flake rate, then by how often Insights saw them flake
+func MergeFlakyTests(stats []FlakyTestStats, insights []FlakyTest) []FlakyTestStats {
+	merged := append([]FlakyTestStats(nil), stats...)
+
+	for _, test := range insights {
+		found := false
+		for i := range merged {
+			if merged[i].Name == test.TestName && merged[i].Classname == test.Classname &&
+				(test.JobName == "" || merged[i].JobName == test.JobName) {
+				merged[i].TimesFlaked += test.TimesFlaked
+				found = true
+			}
+		}
+		if !found {
+			merged = append(merged, FlakyTestStats{
+				Name:        test.TestName,
+				Classname:   test.Classname,
+				File:        test.File,
+				JobName:     test.JobName,
+				TimesFlaked: test.TimesFlaked,
+			})
+		}
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		if ri, rj := merged[i].FlakeRate(), merged[j].FlakeRate(); ri != rj {
+			return ri > rj
+		}
+		if merged[i].TimesFlaked != merged[j].TimesFlaked {
+			return merged[i].TimesFlaked > merged[j].TimesFlaked
+		}
+		return merged[i].Name < merged[j].Name
+	})
+
+	return merged
+}
+
+// MarkKnownFlakes marks the failed tests of a pipeline that Insights reports as flaky
+func MarkKnownFlakes(status *CIStatus, flaky []FlakyTest) {
+	for i := range status.FailedJobs {
+		job := &status.FailedJobs[i]
+		for j := range job.FailedTests {
+			test := &job.FailedTests[j]
+			for _, f := range flaky {
+				if f.TestName == test.Name && f.Classname == test.Classname &&
+					(f.JobName == "" || f.JobName == job.Name) {
+					test.KnownFlake = true
+					break
+				}
+			}
+		}
+	}
+}
+
+// isTestFailed reports whether a test result is a failure
+func isTestFailed(test TestMetadata) bool {
+	return test.Result == "failure" || test.Result == "error"
+}
+
+// isTestPassed reports whether a test result is a pass
+func isTestPassed(test TestMetadata) bool {
+	return test.Result == "success"
+}
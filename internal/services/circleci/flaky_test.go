@@ -0,0 +1,84 @@
+package circleci
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testRun(pipeline int, revision, name, result string) TestRun {
+	return TestRun{
+		PipelineNumber: pipeline,
+		Revision:       revision,
+		JobName:        "test",
+		Test:           TestMetadata{Name: name, Classname: "pkg", File: "pkg/a_test.go", Result: result},
+	}
+}
+
+func TestDetectFlakyTests(t *testing.T) {
+	runs := []TestRun{
+		// Passed and failed on the same commit after a rerun
+		testRun(1, "aaa", "TestRetry", "failure"),
+		testRun(2, "aaa", "TestRetry", "success"),
+		testRun(3, "bbb", "TestRetry", "success"),
+		// Failed between passing runs, out of order
+		testRun(3, "ccc", "TestTiming", "success"),
+		testRun(1, "aaa", "TestTiming", "success"),
+		testRun(2, "bbb", "TestTiming", "failure"),
+		// Broken and then fixed once isn't flaky
+		testRun(1, "aaa", "TestFixed", "failure"),
+		testRun(3, "bbb", "TestFixed", "success"),
+		// Skips don't count
+		testRun(1, "aaa", "TestSkipped", "skipped"),
+		testRun(2, "aaa", "TestSkipped", "failure"),
+		testRun(3, "bbb", "TestSkipped", "skipped"),
+	}
+
+	flaky := DetectFlakyTests(runs)
+	require.Len(t, flaky, 2)
+
+	assert.Equal(t, "TestRetry", flaky[0].Name)
+	assert.True(t, flaky[0].SameCommit)
+	assert.Equal(t, 3, flaky[0].Runs)
+	assert.Equal(t, 1, flaky[0].Failures)
+	assert.Equal(t, "pkg/a_test.go", flaky[0].File)
+
+	assert.Equal(t, "TestTiming", flaky[1].Name)
+	assert.False(t, flaky[1].SameCommit)
+}
+
+func TestMergeFlakyTests(t *testing.T) {
+	stats := []FlakyTestStats{
+		{Name: "TestA", Classname: "pkg", JobName: "test", Runs: 10, Failures: 1},
+		{Name: "TestB", Classname: "pkg", JobName: "test", Runs: 4, Failures: 2},
+	}
+	insights := []FlakyTest{
+		{TestName: "TestA", Classname: "pkg", JobName: "test", TimesFlaked: 3},
+		{TestName: "TestC", Classname: "pkg", JobName: "e2e", TimesFlaked: 5},
+	}
+
+	merged := MergeFlakyTests(stats, insights)
+	require.Len(t, merged, 3)
+	assert.Equal(t, []string{"TestB", "TestA", "TestC"}, []string{merged[0].Name, merged[1].Name, merged[2].Name})
+	assert.Equal(t, 3, merged[1].TimesFlaked)
+	assert.Equal(t, 5, merged[2].TimesFlaked)
+}
+
+func TestMarkKnownFlakes(t *testing.T) {
+	status := &CIStatus{FailedJobs: []FailedJob{{
+		Name: "test",
+		FailedTests: []TestMetadata{
+			{Name: "TestA", Classname: "pkg"},
+			{Name: "TestB", Classname: "pkg"},
+		},
+	}}}
+
+	MarkKnownFlakes(status, []FlakyTest{
+		{TestName: "TestA", Classname: "pkg", JobName: "test"},
+		{TestName: "TestB", Classname: "pkg", JobName: "e2e"},
+	})
+
+	assert.True(t, status.FailedJobs[0].FailedTests[0].KnownFlake)
+	assert.False(t, status.FailedJobs[0].FailedTests[1].KnownFlake)
+}
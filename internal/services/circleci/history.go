@@ -0,0 +1,89 @@
+package circleci
+
+import (
+	"sort"
+	"time"
+)
+
+// PipelineRun is a pipeline with its workflows and their jobs
+type PipelineRun struct {
+	Pipeline  Pipeline
+	Workflows []WorkflowRun
+}
+
+// WorkflowRun is a workflow with its jobs
+type WorkflowRun struct {
+	Workflow Workflow
+	Jobs     []Job
+}
+
+// Durations returns how long the pipeline took from creation until its last
+// workflow stopped, and how much of that no job was running, such as waiting
+// for an executor or on a hold. Unfinished work counts until now.
+func (r PipelineRun) Durations(now time.Time) (wall, queued time.Duration) {
+	if len(r.Workflows) == 0 {
+		return 0, 0
+	}
+
+	start := r.Pipeline.CreatedAt
+	end := time.Time{}
+	for _, w := range r.Workflows {
+		if start.IsZero() || w.Workflow.CreatedAt.Before(start) {
+			start = w.Workflow.CreatedAt
+		}
+		stopped := now
+		if w.Workflow.StoppedAt != nil {
+			stopped = *w.Workflow.StoppedAt
+		}
+		if stopped.After(end) {
+			end = stopped
+		}
+	}
+	if !end.After(start) {
+		return 0, 0
+	}
+	wall = end.Sub(start)
+
+	var intervals [][2]time.Time
+	for _, w := range r.Workflows {
+		for _, job := range w.Jobs {
+			if job.Type == "approval" || job.StartedAt == nil {
+				continue
+			}
+			stopped := end
+			if job.StoppedAt != nil {
+				stopped = *job.StoppedAt
+			}
+			intervals = append(intervals, [2]time.Time{*job.StartedAt, stopped})
+		}
+	}
+
+	return wall, wall - unionDuration(intervals)
+}
+
+// unionDuration returns the total time covered by the intervals, counting
+// overlapping time once
+func unionDuration(intervals [][2]time.Time) time.Duration {
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i][0].Before(intervals[j][0])
+	})
+
+	var total time.Duration
+	var curStart, curEnd time.Time
+	for i, iv := range intervals {
+		if i > 0 && !iv[0].After(curEnd) {
+			if iv[1].After(curEnd) {
+				curEnd = iv[1]
+			}
+			continue
+		}
+		if i > 0 {
+			total += curEnd.Sub(curStart)
+		}
+		curStart, curEnd = iv[0], iv[1]
+	}
+	if len(intervals) > 0 {
+		total += curEnd.Sub(curStart)
+	}
+	return total
+}
@@ -0,0 +1,66 @@
+package circleci
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPipelineRunDurations(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	at := func(minutes int) *time.Time {
+		ts := t0.Add(time.Duration(minutes) * time.Minute)
+		return &ts
+	}
+
+	run := PipelineRun{
+		Pipeline: Pipeline{CreatedAt: t0},
+		Workflows: []WorkflowRun{
+			{
+				Workflow: Workflow{CreatedAt: t0, StoppedAt: at(30)},
+				Jobs: []Job{
+					// Waited 2 minutes for an executor
+					{Name: "build", StartedAt: at(2), StoppedAt: at(8)},
+					// Overlapping jobs count once
+					{Name: "test", StartedAt: at(8), StoppedAt: at(15)},
+					{Name: "lint", StartedAt: at(9), StoppedAt: at(12)},
+					// Holds count as queued
+					{Name: "hold", Type: "approval", StartedAt: at(15), StoppedAt: at(25)},
+					{Name: "deploy", StartedAt: at(25), StoppedAt: at(30)},
+				},
+			},
+		},
+	}
+
+	wall, queued := run.Durations(t0.Add(time.Hour))
+	assert.Equal(t, 30*time.Minute, wall)
+	assert.Equal(t, 12*time.Minute, queued)
+}
+
+func TestPipelineRunDurations_Running(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	started := t0.Add(time.Minute)
+
+	run := PipelineRun{
+		Pipeline: Pipeline{CreatedAt: t0},
+		Workflows: []WorkflowRun{
+			{
+				Workflow: Workflow{CreatedAt: t0},
+				Jobs:     []Job{{Name: "test", StartedAt: &started}, {Name: "deploy"}},
+			},
+		},
+	}
+
+	wall, queued := run.Durations(t0.Add(10 * time.Minute))
+	assert.Equal(t, 10*time.Minute, wall)
+	assert.Equal(t, time.Minute, queued)
+}
+
+func TestPipelineRunDurations_NoWorkflows(t *testing.T) {
+	run := PipelineRun{Pipeline: Pipeline{CreatedAt: time.Now()}}
+
+	wall, queued := run.Durations(time.Now())
+	assert.Zero(t, wall)
+	assert.Zero(t, queued)
+}
@@ -0,0 +1,38 @@
+package circleci
+
+// MergeCIStatuses combines the latest pipelines of several projects into one
+// status. The first status provides the project, pipeline, and revision, and
+// workflows and failed jobs are tagged with their project. Nil statuses, for
+// projects without a pipeline, are skipped; returns nil if all are.
+func MergeCIStatuses(statuses []*CIStatus) *CIStatus {
+	var merged *CIStatus
+	for _, status := range statuses {
+		if status == nil {
+			continue
+		}
+		if merged == nil {
+			merged = &CIStatus{
+				Branch:         status.Branch,
+				ProjectSlug:    status.ProjectSlug,
+				PipelineNumber: status.PipelineNumber,
+				PipelineID:     status.PipelineID,
+				Revision:       status.Revision,
+			}
+		}
+
+		merged.Projects = append(merged.Projects, ProjectPipeline{
+			ProjectSlug:    status.ProjectSlug,
+			PipelineNumber: status.PipelineNumber,
+			PipelineID:     status.PipelineID,
+		})
+		for _, workflow := range status.Workflows {
+			workflow.ProjectSlug = status.ProjectSlug
+			merged.Workflows = append(merged.Workflows, workflow)
+		}
+		for _, job := range status.FailedJobs {
+			job.ProjectSlug = status.ProjectSlug
+			merged.FailedJobs = append(merged.FailedJobs, job)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,52 @@
+package circleci
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeCIStatuses(t *testing.T) {
+	app := &CIStatus{
+		Branch:         "feature",
+		ProjectSlug:    "gh/acme/app",
+		PipelineNumber: 12,
+		PipelineID:     "p-app",
+		Revision:       "abc123",
+		Workflows:      []WorkflowStatus{{ID: "w1", Name: "build", Status: "success"}},
+	}
+	deploy := &CIStatus{
+		Branch:         "feature",
+		ProjectSlug:    "gh/acme/deploy",
+		PipelineNumber: 40,
+		PipelineID:     "p-deploy",
+		Revision:       "abc123",
+		Workflows:      []WorkflowStatus{{ID: "w2", Name: "deploy", Status: "failed"}},
+		FailedJobs:     []FailedJob{{Name: "terraform", JobNumber: 812, WorkflowName: "deploy"}},
+	}
+
+	merged := MergeCIStatuses([]*CIStatus{app, nil, deploy})
+	require.NotNil(t, merged)
+
+	assert.Equal(t, "gh/acme/app", merged.ProjectSlug)
+	assert.Equal(t, 12, merged.PipelineNumber)
+	assert.Equal(t, "abc123", merged.Revision)
+	assert.Equal(t, []ProjectPipeline{
+		{ProjectSlug: "gh/acme/app", PipelineNumber: 12, PipelineID: "p-app"},
+		{ProjectSlug: "gh/acme/deploy", PipelineNumber: 40, PipelineID: "p-deploy"},
+	}, merged.Projects)
+
+	require.Len(t, merged.Workflows, 2)
+	assert.Equal(t, "gh/acme/app", merged.Workflows[0].ProjectSlug)
+	assert.Equal(t, "gh/acme/deploy", merged.Workflows[1].ProjectSlug)
+	require.Len(t, merged.FailedJobs, 1)
+	assert.Equal(t, "gh/acme/deploy", merged.FailedJobs[0].ProjectSlug)
+
+	// The statuses being merged aren't changed
+	assert.Empty(t, deploy.FailedJobs[0].ProjectSlug)
+}
+
+func TestMergeCIStatuses_NoPipelines(t *testing.T) {
+	assert.Nil(t, MergeCIStatuses([]*CIStatus{nil, nil}))
+}
@@ -0,0 +1,72 @@
+package circleci
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/rithyhuot/vibe/internal/utils"
+)
+
+// vcsSlugPrefixes map the VCS prefixes of project slugs to the names the
+// v1.1 API uses
+var vcsSlugPrefixes = map[string]string{
+	"gh":        "github",
+	"github":    "github",
+	"bb":        "bitbucket",
+	"bitbucket": "bitbucket",
+	"circleci":  "circleci",
+}
+
+// uuidPattern matches the organization and project IDs of standalone projects
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// GetProjectSlug extracts the project slug from git remote
+func GetProjectSlug() (string, error) {
+	remote, err := utils.GetOriginRemote()
+	if err != nil {
+		return "", err
+	}
+	return ProjectSlugFromRemote(remote)
+}
+
+// ProjectSlugFromRemote returns the project slug of a GitHub or Bitbucket
+// remote. Projects on other hosts, and standalone projects, need a
+// configured slug.
+func ProjectSlugFromRemote(remote *utils.GitRemote) (string, error) {
+	switch remote.Host {
+	case "github.com":
+		return fmt.Sprintf("gh/%s/%s", remote.Owner, remote.Repo), nil
+	case "bitbucket.org":
+		return fmt.Sprintf("bb/%s/%s", remote.Owner, remote.Repo), nil
+	default:
+		return "", fmt.Errorf("unsupported git host for CircleCI: %s", remote.Host)
+	}
+}
+
+// ValidateProjectSlug checks that a slug is gh/<org>/<repo>,
+// bb/<workspace>/<repo>, or circleci/<org-id>/<project-id> for standalone
+// projects. The long forms github/ and bitbucket/ are accepted too.
+func ValidateProjectSlug(slug string) error {
+	parts := strings.Split(slug, "/")
+	if len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+		return fmt.Errorf("invalid CircleCI project slug %q (expected gh/<org>/<repo>, bb/<workspace>/<repo>, or circleci/<org-id>/<project-id>)", slug)
+	}
+	if _, ok := vcsSlugPrefixes[parts[0]]; !ok {
+		return fmt.Errorf("invalid CircleCI project slug %q: unknown prefix %q (expected gh, bb, or circleci)", slug, parts[0])
+	}
+	if parts[0] == "circleci" && (!uuidPattern.MatchString(parts[1]) || !uuidPattern.MatchString(parts[2])) {
+		return fmt.Errorf("invalid CircleCI project slug %q: standalone projects use circleci/<org-id>/<project-id>, with the IDs from the project settings", slug)
+	}
+	return nil
+}
+
+// v1ProjectPath converts a project slug to the vcs-type/org/project path of
+// the v1.1 API, e.g. gh/org/repo to github/org/repo
+func v1ProjectPath(slug string) (string, error) {
+	if err := ValidateProjectSlug(slug); err != nil {
+		return "", err
+	}
+	prefix, rest, _ := strings.Cut(slug, "/")
+	return vcsSlugPrefixes[prefix] + "/" + rest, nil
+}
@@ -0,0 +1,61 @@
+package circleci
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rithyhuot/vibe/internal/utils"
+)
+
+func TestProjectSlugFromRemote(t *testing.T) {
+	slug, err := ProjectSlugFromRemote(&utils.GitRemote{Host: "github.com", Owner: "acme", Repo: "app"})
+	require.NoError(t, err)
+	assert.Equal(t, "gh/acme/app", slug)
+
+	slug, err = ProjectSlugFromRemote(&utils.GitRemote{Host: "bitbucket.org", Owner: "acme", Repo: "app"})
+	require.NoError(t, err)
+	assert.Equal(t, "bb/acme/app", slug)
+
+	_, err = ProjectSlugFromRemote(&utils.GitRemote{Host: "git.example.com", Owner: "acme", Repo: "app"})
+	assert.Error(t, err)
+}
+
+func TestValidateProjectSlug(t *testing.T) {
+	valid := []string{
+		"gh/acme/app",
+		"github/acme/app",
+		"bb/acme/app",
+		"bitbucket/acme/app",
+		"circleci/9a8b7c6d-1234-4f00-8e2d-0123456789ab/0f1e2d3c-4b5a-4697-8877-665544332211",
+	}
+	for _, slug := range valid {
+		assert.NoError(t, ValidateProjectSlug(slug), slug)
+	}
+
+	invalid := []string{
+		"acme/app",
+		"gh/acme/app/extra",
+		"gl/acme/app",
+		"gh//app",
+		"circleci/acme/app",
+	}
+	for _, slug := range invalid {
+		assert.Error(t, ValidateProjectSlug(slug), slug)
+	}
+}
+
+func TestV1ProjectPath(t *testing.T) {
+	tests := map[string]string{
+		"gh/acme/app":        "github/acme/app",
+		"bb/acme/app":        "bitbucket/acme/app",
+		"bitbucket/acme/app": "bitbucket/acme/app",
+		"circleci/9a8b7c6d-1234-4f00-8e2d-0123456789ab/0f1e2d3c-4b5a-4697-8877-665544332211": "circleci/9a8b7c6d-1234-4f00-8e2d-0123456789ab/0f1e2d3c-4b5a-4697-8877-665544332211",
+	}
+	for slug, want := range tests {
+		got, err := v1ProjectPath(slug)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+}
@@ -9,6 +9,26 @@ type Pipeline struct {
 	State     string    `json:"state"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+	VCS       VCS       `json:"vcs"`
+	Trigger   Trigger   `json:"trigger"`
+}
+
+// Trigger represents what started a pipeline
+type Trigger struct {
+	Type       string    `json:"type"` // webhook, api, or schedule
+	ReceivedAt time.Time `json:"received_at"`
+	Actor      Actor     `json:"actor"`
+}
+
+// Actor represents the user who started a pipeline
+type Actor struct {
+	Login string `json:"login"`
+}
+
+// VCS represents the commit a pipeline was triggered for
+type VCS struct {
+	Revision string `json:"revision"`
+	Branch   string `json:"branch,omitempty"`
 }
 
 // Workflow represents a CircleCI workflow
@@ -23,14 +43,15 @@ type Workflow struct {
 
 // Job represents a CircleCI job
 type Job struct {
-	ID         string     `json:"id"`
-	Name       string     `json:"name"`
-	Status     string     `json:"status"`
-	JobNumber  int        `json:"job_number"`
-	Type       string     `json:"type"`
-	StartedAt  *time.Time `json:"started_at"`
-	StoppedAt  *time.Time `json:"stopped_at"`
-	ApprovedBy string     `json:"approved_by,omitempty"`
+	ID                string     `json:"id"`
+	Name              string     `json:"name"`
+	Status            string     `json:"status"`
+	JobNumber         int        `json:"job_number"`
+	Type              string     `json:"type"`
+	StartedAt         *time.Time `json:"started_at"`
+	StoppedAt         *time.Time `json:"stopped_at"`
+	ApprovedBy        string     `json:"approved_by,omitempty"`
+	ApprovalRequestID string     `json:"approval_request_id,omitempty"` // Set on approval (hold) jobs
 }
 
 // JobDetail represents detailed information about a job
@@ -52,6 +73,71 @@ type TestMetadata struct {
 	Result    string  `json:"result"`
 	Message   string  `json:"message"`
 	RunTime   float64 `json:"run_time"`
+
+	KnownFlake bool `json:"-"` // Set when Insights reports the test as flaky
+}
+
+// Artifact represents a file a job stored with store_artifacts
+type Artifact struct {
+	Path      string `json:"path"`
+	NodeIndex int    `json:"node_index"`
+	URL       string `json:"url"`
+}
+
+// ArtifactResponse represents the artifact list response
+type ArtifactResponse struct {
+	Items         []Artifact `json:"items"`
+	NextPageToken *string    `json:"next_page_token"`
+}
+
+// FlakyTest represents a test CircleCI Insights detected as flaky
+type FlakyTest struct {
+	TestName       string `json:"test-name"`
+	Classname      string `json:"classname"`
+	File           string `json:"file"`
+	JobName        string `json:"job-name"`
+	WorkflowName   string `json:"workflow-name"`
+	TimesFlaked    int    `json:"times-flaked"`
+	PipelineNumber int    `json:"pipeline-number"`
+	JobNumber      int    `json:"job-number"`
+}
+
+// FlakyTestsResponse represents the Insights flaky tests response
+type FlakyTestsResponse struct {
+	FlakyTests      []FlakyTest `json:"flaky-tests"`
+	TotalFlakyTests int         `json:"total-flaky-tests"`
+}
+
+// JobInsights represents the Insights metrics of a workflow's job
+type JobInsights struct {
+	Name        string     `json:"name"`
+	Metrics     JobMetrics `json:"metrics"`
+	WindowStart time.Time  `json:"window_start"`
+	WindowEnd   time.Time  `json:"window_end"`
+}
+
+// JobMetrics represents the aggregated runs of a job over the reporting window
+type JobMetrics struct {
+	TotalRuns       int             `json:"total_runs"`
+	SuccessfulRuns  int             `json:"successful_runs"`
+	FailedRuns      int             `json:"failed_runs"`
+	SuccessRate     float64         `json:"success_rate"`
+	DurationMetrics DurationMetrics `json:"duration_metrics"`
+}
+
+// DurationMetrics represents job durations in seconds. Median is the p50.
+type DurationMetrics struct {
+	Min    int64 `json:"min"`
+	Mean   int64 `json:"mean"`
+	Median int64 `json:"median"`
+	P95    int64 `json:"p95"`
+	Max    int64 `json:"max"`
+}
+
+// JobInsightsResponse represents the Insights job metrics response
+type JobInsightsResponse struct {
+	Items         []JobInsights `json:"items"`
+	NextPageToken *string       `json:"next_page_token"`
 }
 
 // BuildStep represents a build step in v1.1 API
@@ -100,14 +186,16 @@ type FailedJob struct {
 	WebURL       string
 	WorkflowName string
 	FailedTests  []TestMetadata
+	ProjectSlug  string // Set when the status spans several projects
 }
 
 // WorkflowStatus represents the status of a workflow with its jobs
 type WorkflowStatus struct {
-	ID     string
-	Name   string
-	Status string
-	Jobs   []Job
+	ID          string
+	Name        string
+	Status      string
+	Jobs        []Job
+	ProjectSlug string // Set when the status spans several projects
 }
 
 // CIStatus represents comprehensive CI status for a branch
@@ -116,8 +204,18 @@ type CIStatus struct {
 	ProjectSlug    string
 	PipelineNumber int
 	PipelineID     string
+	Revision       string // Commit SHA the pipeline ran on, if known
 	Workflows      []WorkflowStatus
 	FailedJobs     []FailedJob
+	Projects       []ProjectPipeline // The pipeline of each project, when the status spans several
+}
+
+// ProjectPipeline identifies the pipeline of one project in a status that
+// spans several projects
+type ProjectPipeline struct {
+	ProjectSlug    string
+	PipelineNumber int
+	PipelineID     string
 }
 
 // PaginatedResponse represents a paginated API response
@@ -149,3 +247,39 @@ type TestMetadataResponse struct {
 	Items         []TestMetadata `json:"items"`
 	NextPageToken *string        `json:"next_page_token"`
 }
+
+// TriggerPipelineRequest represents the options for triggering a pipeline
+type TriggerPipelineRequest struct {
+	Branch     string                 `json:"branch,omitempty"`
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// RerunRequest represents the options for rerunning a workflow. FromFailed
+// can't be combined with Jobs, and EnableSSH requires Jobs.
+type RerunRequest struct {
+	FromFailed bool     `json:"from_failed,omitempty"`
+	EnableSSH  bool     `json:"enable_ssh,omitempty"`
+	Jobs       []string `json:"jobs,omitempty"` // Job IDs to rerun
+}
+
+// RerunResponse represents the response to a workflow rerun
+type RerunResponse struct {
+	WorkflowID string `json:"workflow_id"`
+}
+
+// CompileConfigRequest represents a config to compile with the CircleCI config compiler
+type CompileConfigRequest struct {
+	ConfigYAML string `json:"config_yaml"`
+}
+
+// CompileConfigResponse represents the result of compiling a config
+type CompileConfigResponse struct {
+	Valid      bool           `json:"valid"`
+	OutputYAML string         `json:"output-yaml"`
+	Errors     []CompileError `json:"errors"`
+}
+
+// CompileError represents an error found by the CircleCI config compiler
+type CompileError struct {
+	Message string `json:"message"`
+}
@@ -0,0 +1,450 @@
+package circleci
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Issue severities
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+// ConfigIssue is a problem found in a CircleCI config. Path locates it in the
+// config, such as workflows.build.jobs.deploy, and Line is 0 when unknown.
+type ConfigIssue struct {
+	Severity string
+	Path     string
+	Line     int
+	Message  string
+}
+
+// builtinSteps are the steps CircleCI provides without a commands entry
+var builtinSteps = map[string]bool{
+	"run": true, "checkout": true, "setup_remote_docker": true, "save_cache": true,
+	"restore_cache": true, "store_artifacts": true, "store_test_results": true,
+	"persist_to_workspace": true, "attach_workspace": true, "add_ssh_keys": true,
+	"when": true, "unless": true, "deploy": true, "steps": true,
+}
+
+// Parameter types by where they're declared
+var (
+	pipelineParameterTypes = map[string]bool{"string": true, "boolean": true, "integer": true, "enum": true}
+	jobParameterTypes      = map[string]bool{
+		"string": true, "boolean": true, "integer": true, "enum": true,
+		"executor": true, "steps": true, "env_var_name": true,
+	}
+)
+
+var (
+	orbRefPattern         = regexp.MustCompile(`^([a-z0-9][a-z0-9-]*)/([a-z0-9][a-z0-9-]*)@(.+)$`)
+	orbVersionPattern     = regexp.MustCompile(`^\d+(\.\d+){0,2}$`)
+	envVarNamePattern     = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	pipelineParamRefRegex = regexp.MustCompile(`<<\s*pipeline\.parameters\.([A-Za-z0-9_-]+)\s*>>`)
+)
+
+// Validate checks the structure of a config without contacting CircleCI:
+// the version, orb references, that jobs, executors, and commands referenced
+// are declared, that requires names jobs of the same workflow without
+// cycles, and that parameters have valid types and are passed valid values.
+// Issues are sorted by line.
+func (c *Config) Validate() []ConfigIssue {
+	var issues []ConfigIssue
+	issues = append(issues, c.validateVersion()...)
+	issues = append(issues, c.validateOrbs()...)
+	issues = append(issues, validateParameterDeclarations("parameters", c.Parameters, pipelineParameterTypes)...)
+	issues = append(issues, c.validatePipelineParameterRefs()...)
+	issues = append(issues, c.validateCommands()...)
+	issues = append(issues, c.validateJobs()...)
+	issues = append(issues, c.validateWorkflows()...)
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		return issues[i].Line < issues[j].Line
+	})
+	return issues
+}
+
+// HasErrors reports whether any of the issues is an error
+func HasErrors(issues []ConfigIssue) bool {
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Config) validateVersion() []ConfigIssue {
+	switch c.Version {
+	case "":
+		return []ConfigIssue{configError("version", 0, "version is missing")}
+	case "2.1":
+		return nil
+	case "2", "2.0":
+		if len(c.Orbs) > 0 || len(c.Commands) > 0 || len(c.Executors) > 0 || len(c.Parameters) > 0 {
+			return []ConfigIssue{configError("version", 0, "orbs, commands, executors, and parameters need version 2.1")}
+		}
+		return nil
+	default:
+		return []ConfigIssue{configError("version", 0, fmt.Sprintf("unsupported version %q (expected 2.1)", c.Version))}
+	}
+}
+
+func (c *Config) validateOrbs() []ConfigIssue {
+	var issues []ConfigIssue
+	for _, alias := range sortedKeys(c.Orbs) {
+		orb := c.Orbs[alias]
+		if orb.Inline || strings.Contains(orb.Ref, "<<") {
+			continue
+		}
+
+		path := "orbs." + alias
+		match := orbRefPattern.FindStringSubmatch(orb.Ref)
+		if match == nil {
+			issues = append(issues, configError(path, orb.Line, fmt.Sprintf("invalid orb reference %q (expected namespace/name@version)", orb.Ref)))
+			continue
+		}
+
+		version := match[3]
+		switch {
+		case orbVersionPattern.MatchString(version):
+		case version == "volatile" || strings.HasPrefix(version, "dev:"):
+			issues = append(issues, configWarning(path, orb.Line, fmt.Sprintf("orb %s isn't pinned to a released version", orb.Ref)))
+		default:
+			issues = append(issues, configError(path, orb.Line, fmt.Sprintf("invalid orb version %q in %s", version, orb.Ref)))
+		}
+	}
+	return issues
+}
+
+// validatePipelineParameterRefs finds << pipeline.parameters.x >> references
+// to parameters that aren't declared
+func (c *Config) validatePipelineParameterRefs() []ConfigIssue {
+	var issues []ConfigIssue
+	reported := make(map[string]bool)
+	for _, loc := range pipelineParamRefRegex.FindAllSubmatchIndex(c.raw, -1) {
+		name := string(c.raw[loc[2]:loc[3]])
+		if _, ok := c.Parameters[name]; ok || reported[name] {
+			continue
+		}
+		reported[name] = true
+		line := bytes.Count(c.raw[:loc[0]], []byte("\n")) + 1
+		issues = append(issues, configError("parameters", line, fmt.Sprintf("pipeline parameter %q is used but not declared", name)))
+	}
+	return issues
+}
+
+func (c *Config) validateCommands() []ConfigIssue {
+	var issues []ConfigIssue
+	for _, name := range sortedKeys(c.Commands) {
+		command := c.Commands[name]
+		path := "commands." + name
+		issues = append(issues, validateParameterDeclarations(path+".parameters", command.Parameters, jobParameterTypes)...)
+		issues = append(issues, c.validateSteps(path+".steps", command.Steps)...)
+	}
+	return issues
+}
+
+func (c *Config) validateJobs() []ConfigIssue {
+	var issues []ConfigIssue
+	for _, name := range sortedKeys(c.Jobs) {
+		job := c.Jobs[name]
+		path := "jobs." + name
+		issues = append(issues, validateParameterDeclarations(path+".parameters", job.Parameters, jobParameterTypes)...)
+
+		// Approval, no-op, and other special job types run no steps
+		if job.Type != "" && job.Type != "build" {
+			continue
+		}
+
+		if len(job.Steps) == 0 {
+			issues = append(issues, configError(path, job.Line, fmt.Sprintf("job %q has no steps", name)))
+		}
+		if len(job.Docker) == 0 && job.Machine == nil && job.Macos == nil && job.Executor == nil {
+			issues = append(issues, configError(path, job.Line, fmt.Sprintf("job %q has no executor (docker, machine, macos, or executor)", name)))
+		}
+		if executor := executorName(job.Executor); executor != "" {
+			_, declared := c.Executors[executor]
+			issues = append(issues, c.validateReference(path+".executor", job.Line, "executor", executor, declared)...)
+		}
+		issues = append(issues, c.validateSteps(path+".steps", job.Steps)...)
+	}
+	return issues
+}
+
+// validateSteps checks that the steps of a job or command are built-in
+// steps, declared commands, or commands of declared orbs
+func (c *Config) validateSteps(path string, steps []ConfigStep) []ConfigIssue {
+	var issues []ConfigIssue
+	for _, step := range steps {
+		if builtinSteps[step.Name] || strings.Contains(step.Name, "<<") {
+			continue
+		}
+		if command, ok := c.Commands[step.Name]; ok {
+			issues = append(issues, validateArguments(path, step.Line, "command "+step.Name, command.Parameters, step.Args, nil)...)
+			continue
+		}
+		issues = append(issues, c.validateReference(path, step.Line, "command", step.Name, false)...)
+	}
+	return issues
+}
+
+// validateReference checks that a job, executor, or command name is declared,
+// or belongs to a declared orb when it's written as orb/name
+func (c *Config) validateReference(path string, line int, kind, name string, declared bool) []ConfigIssue {
+	if alias, _, ok := strings.Cut(name, "/"); ok {
+		if _, ok := c.Orbs[alias]; !ok {
+			return []ConfigIssue{configError(path, line, fmt.Sprintf("%s %q uses orb %q, which isn't in orbs", kind, name, alias))}
+		}
+		return nil
+	}
+	if !declared {
+		return []ConfigIssue{configError(path, line, fmt.Sprintf("%s %q isn't declared", kind, name))}
+	}
+	return nil
+}
+
+func (c *Config) validateWorkflows() []ConfigIssue {
+	var issues []ConfigIssue
+	for _, name := range sortedKeys(c.Workflows) {
+		workflow := c.Workflows[name]
+		path := "workflows." + name
+		if len(workflow.Jobs) == 0 {
+			issues = append(issues, configError(path, workflow.Line, fmt.Sprintf("workflow %q has no jobs", name)))
+			continue
+		}
+
+		ids := make(map[string]bool, len(workflow.Jobs))
+		for _, wj := range workflow.Jobs {
+			jobPath := path + ".jobs." + wj.ID()
+			if ids[wj.ID()] {
+				issues = append(issues, configError(jobPath, wj.Line, fmt.Sprintf("job %q is used more than once in workflow %q; give each use a unique name", wj.ID(), name)))
+			}
+			ids[wj.ID()] = true
+
+			if wj.Type == "approval" || strings.Contains(wj.Job, "<<") {
+				continue
+			}
+			if job, ok := c.Jobs[wj.Job]; ok {
+				issues = append(issues, validateArguments(jobPath, wj.Line, "job "+wj.Job, job.Parameters, wj.Parameters, wj.MatrixParameters)...)
+				continue
+			}
+			issues = append(issues, c.validateReference(jobPath, wj.Line, "job", wj.Job, false)...)
+		}
+
+		for _, wj := range workflow.Jobs {
+			for _, required := range wj.Requires {
+				if !ids[required] && !strings.Contains(required, "<<") {
+					issues = append(issues, configError(path+".jobs."+wj.ID(), wj.Line, fmt.Sprintf("job %q requires %q, which isn't in workflow %q", wj.ID(), required, name)))
+				}
+			}
+		}
+
+		if cycle := findRequiresCycle(workflow.Jobs); cycle != nil {
+			issues = append(issues, configError(path, workflow.Line, fmt.Sprintf("workflow %q has a requires cycle: %s", name, strings.Join(cycle, " → "))))
+		}
+	}
+	return issues
+}
+
+// findRequiresCycle returns the job names of a requires cycle in a
+// workflow, starting and ending with the same job, or nil if there's none
+func findRequiresCycle(jobs []WorkflowJob) []string {
+	requires := make(map[string][]string, len(jobs))
+	var order []string
+	for _, wj := range jobs {
+		if _, ok := requires[wj.ID()]; !ok {
+			order = append(order, wj.ID())
+		}
+		requires[wj.ID()] = append(requires[wj.ID()], wj.Requires...)
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[string]int, len(order))
+	var stack []string
+
+	var visit func(id string) []string
+	visit = func(id string) []string {
+		state[id] = visiting
+		stack = append(stack, id)
+		for _, next := range requires[id] {
+			switch state[next] {
+			case visiting:
+				for i, onStack := range stack {
+					if onStack == next {
+						return append(append([]string{}, stack[i:]...), next)
+					}
+				}
+			case unvisited:
+				if _, ok := requires[next]; !ok {
+					continue
+				}
+				if cycle := visit(next); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[id] = done
+		return nil
+	}
+
+	for _, id := range order {
+		if state[id] == unvisited {
+			if cycle := visit(id); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
+
+// validateParameterDeclarations checks parameter types, and that defaults
+// match them
+func validateParameterDeclarations(path string, params map[string]PipelineParameter, types map[string]bool) []ConfigIssue {
+	var issues []ConfigIssue
+	for _, name := range sortedKeys(params) {
+		param := params[name]
+		paramPath := path + "." + name
+		if !types[param.Type] {
+			issues = append(issues, configError(paramPath, 0, fmt.Sprintf("parameter %q has invalid type %q", name, param.Type)))
+			continue
+		}
+		if param.Type == "enum" && len(param.Enum) == 0 {
+			issues = append(issues, configError(paramPath, 0, fmt.Sprintf("enum parameter %q has no enum values", name)))
+			continue
+		}
+		if param.Default != nil {
+			if err := checkParameterValue(param, param.Default); err != nil {
+				issues = append(issues, configError(paramPath, 0, fmt.Sprintf("default of parameter %q %v", name, err)))
+			}
+		}
+	}
+	return issues
+}
+
+// validateArguments checks the arguments passed to a job or command against
+// its parameters: every argument is declared with a matching type, and every
+// parameter without a default is passed, directly or by a matrix
+func validateArguments(path string, line int, target string, params map[string]PipelineParameter, args, matrix map[string]interface{}) []ConfigIssue {
+	var issues []ConfigIssue
+	for _, name := range sortedKeys(args) {
+		param, ok := params[name]
+		if !ok {
+			issues = append(issues, configError(path, line, fmt.Sprintf("%s has no parameter %q", target, name)))
+			continue
+		}
+		if err := checkParameterValue(param, args[name]); err != nil {
+			issues = append(issues, configError(path, line, fmt.Sprintf("parameter %q of %s %v", name, target, err)))
+		}
+	}
+	for _, name := range sortedKeys(matrix) {
+		if _, ok := params[name]; !ok {
+			issues = append(issues, configError(path, line, fmt.Sprintf("matrix parameter %q isn't a parameter of %s", name, target)))
+		}
+	}
+	for _, name := range sortedKeys(params) {
+		_, passed := args[name]
+		_, expanded := matrix[name]
+		if !passed && !expanded && params[name].Default == nil {
+			issues = append(issues, configError(path, line, fmt.Sprintf("%s needs parameter %q, which has no default", target, name)))
+		}
+	}
+	return issues
+}
+
+// checkParameterValue checks a parameter's default or argument against its
+// type. Values with << >> references are resolved by CircleCI, so they pass.
+func checkParameterValue(param PipelineParameter, value interface{}) error {
+	if s, ok := value.(string); ok && strings.Contains(s, "<<") {
+		return nil
+	}
+
+	switch param.Type {
+	case "string":
+		switch value.(type) {
+		case map[string]interface{}, []interface{}:
+			return fmt.Errorf("is a string, got a %s", yamlKind(value))
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("is a boolean, got %v", value)
+		}
+	case "integer":
+		if _, ok := value.(int); !ok {
+			return fmt.Errorf("is an integer, got %v", value)
+		}
+	case "enum":
+		s := fmt.Sprint(value)
+		for _, allowed := range param.Enum {
+			if s == allowed {
+				return nil
+			}
+		}
+		return fmt.Errorf("must be one of %s, got %v", strings.Join(param.Enum, ", "), value)
+	case "env_var_name":
+		if s, ok := value.(string); !ok || !envVarNamePattern.MatchString(s) {
+			return fmt.Errorf("must be an environment variable name, got %v", value)
+		}
+	case "steps":
+		if _, ok := value.([]interface{}); !ok {
+			return fmt.Errorf("must be a list of steps, got a %s", yamlKind(value))
+		}
+	}
+	return nil
+}
+
+// executorName returns the executor a job names, written as a string or as
+// a map with a name key
+func executorName(executor interface{}) string {
+	switch e := executor.(type) {
+	case string:
+		if strings.Contains(e, "<<") {
+			return ""
+		}
+		return e
+	case map[string]interface{}:
+		if name, ok := e["name"].(string); ok && !strings.Contains(name, "<<") {
+			return name
+		}
+	}
+	return ""
+}
+
+// yamlKind describes the type of a decoded YAML value for messages
+func yamlKind(value interface{}) string {
+	switch value.(type) {
+	case map[string]interface{}:
+		return "map"
+	case []interface{}:
+		return "list"
+	default:
+		return "scalar"
+	}
+}
+
+func configError(path string, line int, message string) ConfigIssue {
+	return ConfigIssue{Severity: SeverityError, Path: path, Line: line, Message: message}
+}
+
+func configWarning(path string, line int, message string) ConfigIssue {
+	return ConfigIssue{Severity: SeverityWarning, Path: path, Line: line, Message: message}
+}
+
+// sortedKeys returns the keys of a map in order
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
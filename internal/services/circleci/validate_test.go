@@ -0,0 +1,306 @@
+package circleci
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const validConfig = `version: 2.1
+orbs:
+  node: circleci/node@5.1.0
+parameters:
+  run_e2e:
+    type: boolean
+    default: false
+executors:
+  go:
+    docker:
+      - image: cimg/go:1.22
+commands:
+  install:
+    parameters:
+      cache:
+        type: boolean
+        default: true
+    steps:
+      - run: go mod download
+jobs:
+  test:
+    executor: go
+    parameters:
+      race:
+        type: boolean
+        default: false
+      shard:
+        type: integer
+    steps:
+      - checkout
+      - install:
+          cache: false
+      - run: go test ./...
+  e2e:
+    docker:
+      - image: cimg/node:20.0
+    steps:
+      - node/install-packages
+      - run: npm run e2e
+workflows:
+  build:
+    jobs:
+      - test:
+          matrix:
+            parameters:
+              shard: [1, 2, 3]
+      - hold:
+          type: approval
+          requires: [test]
+      - e2e:
+          requires:
+            - hold
+          filters:
+            branches:
+              only: main
+      - node/test:
+          name: node-test
+  nightly:
+    when: << pipeline.parameters.run_e2e >>
+    jobs:
+      - test:
+          shard: 1
+          race: true
+`
+
+func parseTestConfig(t *testing.T, data string) *Config {
+	t.Helper()
+	cfg, err := ParseConfig([]byte(data))
+	require.NoError(t, err)
+	return cfg
+}
+
+func TestParseConfig(t *testing.T) {
+	cfg := parseTestConfig(t, validConfig)
+
+	assert.Equal(t, "2.1", cfg.Version)
+	assert.Equal(t, "circleci/node@5.1.0", cfg.Orbs["node"].Ref)
+	require.Len(t, cfg.Workflows["build"].Jobs, 4)
+
+	hold := cfg.Workflows["build"].Jobs[1]
+	assert.Equal(t, "approval", hold.Type)
+	assert.Equal(t, []string{"test"}, hold.Requires)
+
+	nodeTest := cfg.Workflows["build"].Jobs[3]
+	assert.Equal(t, "node/test", nodeTest.Job)
+	assert.Equal(t, "node-test", nodeTest.ID())
+
+	nightly := cfg.Workflows["nightly"].Jobs[0]
+	assert.Equal(t, map[string]interface{}{"shard": 1, "race": true}, nightly.Parameters)
+	assert.Equal(t, 31, cfg.Jobs["test"].Steps[1].Line)
+}
+
+func TestValidate_Valid(t *testing.T) {
+	assert.Empty(t, parseTestConfig(t, validConfig).Validate())
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		message string
+	}{
+		{
+			name: "missing job",
+			config: `version: 2.1
+workflows:
+  build:
+    jobs: [lint]
+`,
+			message: `job "lint" isn't declared`,
+		},
+		{
+			name: "undeclared orb",
+			config: `version: 2.1
+workflows:
+  build:
+    jobs: [node/test]
+`,
+			message: `job "node/test" uses orb "node", which isn't in orbs`,
+		},
+		{
+			name: "invalid orb reference",
+			config: `version: 2.1
+orbs:
+  node: circleci/node
+`,
+			message: `invalid orb reference "circleci/node"`,
+		},
+		{
+			name: "missing requires",
+			config: `version: 2.1
+jobs:
+  test:
+    docker: [{image: cimg/base:current}]
+    steps: [checkout]
+workflows:
+  build:
+    jobs:
+      - test:
+          requires: [build]
+`,
+			message: `job "test" requires "build", which isn't in workflow "build"`,
+		},
+		{
+			name: "requires cycle",
+			config: `version: 2.1
+jobs:
+  a:
+    docker: [{image: cimg/base:current}]
+    steps: [checkout]
+workflows:
+  build:
+    jobs:
+      - a:
+          name: one
+          requires: [three]
+      - a:
+          name: two
+          requires: [one]
+      - a:
+          name: three
+          requires: [two]
+`,
+			message: `workflow "build" has a requires cycle: one → three → two → one`,
+		},
+		{
+			name: "duplicate job",
+			config: `version: 2.1
+jobs:
+  test:
+    docker: [{image: cimg/base:current}]
+    steps: [checkout]
+workflows:
+  build:
+    jobs: [test, test]
+`,
+			message: `job "test" is used more than once`,
+		},
+		{
+			name: "wrong argument type",
+			config: `version: 2.1
+jobs:
+  test:
+    docker: [{image: cimg/base:current}]
+    parameters:
+      race:
+        type: boolean
+        default: false
+    steps: [checkout]
+workflows:
+  build:
+    jobs:
+      - test:
+          race: "yes"
+`,
+			message: `parameter "race" of job test is a boolean, got yes`,
+		},
+		{
+			name: "missing required argument",
+			config: `version: 2.1
+commands:
+  release:
+    parameters:
+      env:
+        type: enum
+        enum: [staging, production]
+    steps:
+      - run: ./deploy.sh
+jobs:
+  ship:
+    docker: [{image: cimg/base:current}]
+    steps:
+      - release
+`,
+			message: `command release needs parameter "env", which has no default`,
+		},
+		{
+			name: "invalid default",
+			config: `version: 2.1
+parameters:
+  shards:
+    type: integer
+    default: many
+`,
+			message: `default of parameter "shards" is an integer, got many`,
+		},
+		{
+			name: "undeclared pipeline parameter",
+			config: `version: 2.1
+workflows:
+  deploy:
+    when: << pipeline.parameters.deploy >>
+    jobs: [ship]
+`,
+			message: `pipeline parameter "deploy" is used but not declared`,
+		},
+		{
+			name: "unknown command",
+			config: `version: 2.1
+jobs:
+  test:
+    docker: [{image: cimg/base:current}]
+    steps:
+      - chekout
+`,
+			message: `command "chekout" isn't declared`,
+		},
+		{
+			name: "no executor",
+			config: `version: 2.1
+jobs:
+  test:
+    steps: [checkout]
+`,
+			message: `job "test" has no executor`,
+		},
+		{
+			name: "2.1 features in 2.0",
+			config: `version: 2
+orbs:
+  node: circleci/node@5.1.0
+`,
+			message: "need version 2.1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := parseTestConfig(t, tt.config).Validate()
+			require.True(t, HasErrors(issues), "expected an error in %v", issues)
+
+			var messages []string
+			for _, issue := range issues {
+				messages = append(messages, issue.Message)
+			}
+			assert.Contains(t, strings.Join(messages, "\n"), tt.message)
+		})
+	}
+}
+
+func TestValidate_Lines(t *testing.T) {
+	issues := parseTestConfig(t, `version: 2.1
+orbs:
+  node: circleci/node@volatile
+workflows:
+  build:
+    jobs:
+      - lint
+`).Validate()
+
+	require.Len(t, issues, 2)
+	assert.Equal(t, ConfigIssue{Severity: SeverityWarning, Path: "orbs.node", Line: 3, Message: "orb circleci/node@volatile isn't pinned to a released version"}, issues[0])
+	assert.Equal(t, ConfigIssue{Severity: SeverityError, Path: "workflows.build.jobs.lint", Line: 7, Message: `job "lint" isn't declared`}, issues[1])
+	assert.True(t, HasErrors(issues))
+	assert.False(t, HasErrors(issues[:1]))
+}
@@ -22,10 +22,39 @@ type Client interface {
 	GenerateDescription(ctx context.Context, title string) (string, error)
 	EnhancePRDescription(ctx context.Context, title, changes string) (string, error)
 	SummarizeChanges(ctx context.Context, diff string) (string, error)
+	ExplainCIFailure(ctx context.Context, failureLog, diff string) (string, error)
+	ExtractLogErrors(ctx context.Context, logChunk string) (string, error)
 	Generate(ctx context.Context, prompt string) (string, error)
 	GenerateWithContext(ctx context.Context, prompt, context string) (string, error)
 }
 
+// explainCIFailurePrompt asks for a diagnosis of a failed CI job from its log
+// and the branch's changes, both passed as context
+const explainCIFailurePrompt = `A CI job failed. The context has the failure log of the job and the diff of the
+branch against its base branch. Diagnose the failure.
+
+Respond in markdown with exactly these sections:
+
+## Root cause
+The most likely cause in 2-4 sentences. Say whether the branch's changes caused
+it, or whether it looks unrelated (flaky test, infrastructure, dependency).
+
+## Implicated files
+A bulleted list of file paths (with line numbers when known) and one short
+reason each.
+
+## Suggested fix
+Concrete steps or a short code change that would fix it.
+
+Be specific and don't repeat the log back.`
+
+// extractLogErrorsPrompt asks for the relevant part of a chunk of a log too
+// large to diagnose at once
+const extractLogErrorsPrompt = `The context is one part of a failed CI job's log. Copy out the lines that
+explain the failure: error messages, failed assertions, stack traces, and the
+commands that failed, with their file:line locations. Leave out everything
+else. If nothing in this part is relevant, respond with "none".`
+
 // HTTPClient implements the Client interface using Anthropic API
 type HTTPClient struct {
 	httpClient *utils.HTTPClient
@@ -42,6 +71,12 @@ func NewClient(apiKey string) *HTTPClient {
 	}
 }
 
+// WithRetryPolicy sets the retry budget for requests made by this client
+func (c *HTTPClient) WithRetryPolicy(policy utils.RetryPolicy) *HTTPClient {
+	c.httpClient.WithRetryPolicy(policy)
+	return c
+}
+
 // headers returns the common headers for Claude API requests
 func (c *HTTPClient) headers() map[string]string {
 	return map[string]string{
@@ -157,6 +192,16 @@ Keep the summary to 3-5 bullet points.`
 	return c.GenerateWithContext(ctx, prompt, fmt.Sprintf("Git diff:\n%s", diff))
 }
 
+// ExplainCIFailure diagnoses a failed CI job from its failure log and the branch diff
+func (c *HTTPClient) ExplainCIFailure(ctx context.Context, failureLog, diff string) (string, error) {
+	return c.GenerateWithContext(ctx, explainCIFailurePrompt, fmt.Sprintf("Failure log:\n%s\n\nBranch diff:\n%s", failureLog, diff))
+}
+
+// ExtractLogErrors extracts the lines that explain a failure from part of a CI log
+func (c *HTTPClient) ExtractLogErrors(ctx context.Context, logChunk string) (string, error) {
+	return c.GenerateWithContext(ctx, extractLogErrorsPrompt, fmt.Sprintf("Log part:\n%s", logChunk))
+}
+
 // CLIClient implements the Client interface using claude CLI
 type CLIClient struct{}
 
@@ -247,6 +292,16 @@ Keep the summary to 3-5 bullet points.`
 	return c.GenerateWithContext(ctx, prompt, fmt.Sprintf("Git diff:\n%s", diff))
 }
 
+// ExplainCIFailure diagnoses a failed CI job from its failure log and the branch diff
+func (c *CLIClient) ExplainCIFailure(ctx context.Context, failureLog, diff string) (string, error) {
+	return c.GenerateWithContext(ctx, explainCIFailurePrompt, fmt.Sprintf("Failure log:\n%s\n\nBranch diff:\n%s", failureLog, diff))
+}
+
+// ExtractLogErrors extracts the lines that explain a failure from part of a CI log
+func (c *CLIClient) ExtractLogErrors(ctx context.Context, logChunk string) (string, error) {
+	return c.GenerateWithContext(ctx, extractLogErrorsPrompt, fmt.Sprintf("Log part:\n%s", logChunk))
+}
+
 // NewClientAuto creates a Claude client, preferring CLI if available, otherwise API
 func NewClientAuto(apiKey string) Client {
 	if IsClaudeAvailable() {
@@ -22,6 +22,9 @@ type Client interface {
 	UpdateTask(ctx context.Context, taskID string, req *models.TaskUpdateRequest) (*models.Task, error)
 	AddComment(ctx context.Context, taskID string, commentText string) (*models.Comment, error)
 	GetFolders(ctx context.Context, spaceID string) ([]*models.Folder, error)
+	GetLists(ctx context.Context, folderID string) ([]*models.Folder, error)
+	GetListMembers(ctx context.Context, listID string) ([]models.User, error)
+	GetListCustomFields(ctx context.Context, listID string) ([]*models.CustomFieldDefinition, error)
 	SearchTeamTasks(ctx context.Context, teamID string, searchTerm string) ([]*models.Task, error)
 }
 
@@ -40,6 +43,18 @@ func NewClient(apiToken string) *HTTPClient {
 	}
 }
 
+// WithRetryPolicy sets the retry budget for requests made by this client
+func (c *HTTPClient) WithRetryPolicy(policy utils.RetryPolicy) *HTTPClient {
+	c.httpClient.WithRetryPolicy(policy)
+	return c
+}
+
+// WithCache enables on-disk caching of GET responses made by this client
+func (c *HTTPClient) WithCache(cache *utils.ResponseCache) *HTTPClient {
+	c.httpClient.WithCache(cache)
+	return c
+}
+
 // headers returns the common headers for ClickUp API requests
 func (c *HTTPClient) headers() map[string]string {
 	return map[string]string{
@@ -171,6 +186,69 @@ func (c *HTTPClient) GetFolders(ctx context.Context, spaceID string) ([]*models.
 	return folders, nil
 }
 
+// GetLists retrieves the lists in a folder. ClickUp sprints are lists inside a
+// sprint folder, so they are returned as models.Folder for sprint detection.
+func (c *HTTPClient) GetLists(ctx context.Context, folderID string) ([]*models.Folder, error) {
+	url := fmt.Sprintf("%s/folder/%s/list", baseURL, folderID)
+
+	var resp ListsResponse
+	err := c.httpClient.DoJSONRequest(ctx, "GET", url, nil, &resp, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lists: %w", err)
+	}
+
+	lists := make([]*models.Folder, len(resp.Lists))
+	for i, l := range resp.Lists {
+		lists[i] = &models.Folder{
+			ID:   l.ID,
+			Name: l.Name,
+		}
+	}
+
+	return lists, nil
+}
+
+// GetListMembers retrieves the users who have access to a list
+func (c *HTTPClient) GetListMembers(ctx context.Context, listID string) ([]models.User, error) {
+	url := fmt.Sprintf("%s/list/%s/member", baseURL, listID)
+
+	var resp MembersResponse
+	err := c.httpClient.DoJSONRequest(ctx, "GET", url, nil, &resp, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get list members: %w", err)
+	}
+
+	members := make([]models.User, len(resp.Members))
+	for i, m := range resp.Members {
+		members[i] = models.User{
+			ID:       m.ID,
+			Username: m.Username,
+			Email:    m.Email,
+			Color:    m.Color,
+		}
+	}
+
+	return members, nil
+}
+
+// GetListCustomFields retrieves the custom fields available on a list
+func (c *HTTPClient) GetListCustomFields(ctx context.Context, listID string) ([]*models.CustomFieldDefinition, error) {
+	url := fmt.Sprintf("%s/list/%s/field", baseURL, listID)
+
+	var resp FieldsResponse
+	err := c.httpClient.DoJSONRequest(ctx, "GET", url, nil, &resp, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get custom fields: %w", err)
+	}
+
+	fields := make([]*models.CustomFieldDefinition, len(resp.Fields))
+	for i := range resp.Fields {
+		fields[i] = resp.Fields[i].ToCustomFieldDefinition()
+	}
+
+	return fields, nil
+}
+
 // SearchTeamTasks searches for tasks across a team/workspace
 func (c *HTTPClient) SearchTeamTasks(ctx context.Context, teamID string, searchTerm string) ([]*models.Task, error) {
 	u := fmt.Sprintf("%s/team/%s/task", baseURL, teamID)
@@ -4,6 +4,14 @@ import (
 	"github.com/rithyhuot/vibe/internal/models"
 )
 
+// Priorities maps ClickUp priority names to their numeric values
+var Priorities = map[string]int{
+	"urgent": 1,
+	"high":   2,
+	"normal": 3,
+	"low":    4,
+}
+
 // API response structures
 
 // TaskResponse wraps a single task response
@@ -104,6 +112,55 @@ type FoldersResponse struct {
 	Folders []FolderResponse `json:"folders"`
 }
 
+// ListsResponse wraps a list of lists
+type ListsResponse struct {
+	Lists []ListResponse `json:"lists"`
+}
+
+// MembersResponse wraps the members of a list
+type MembersResponse struct {
+	Members []UserResponse `json:"members"`
+}
+
+// FieldsResponse wraps the custom fields available on a list
+type FieldsResponse struct {
+	Fields []FieldResponse `json:"fields"`
+}
+
+// FieldResponse represents a custom field definition in API responses
+type FieldResponse struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	TypeConfig struct {
+		Options []FieldOptionResponse `json:"options"`
+	} `json:"type_config"`
+}
+
+// FieldOptionResponse represents a drop_down or labels option in API responses
+type FieldOptionResponse struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Label string `json:"label"`
+}
+
+// ToCustomFieldDefinition converts FieldResponse to models.CustomFieldDefinition
+func (fr *FieldResponse) ToCustomFieldDefinition() *models.CustomFieldDefinition {
+	def := &models.CustomFieldDefinition{
+		ID:   fr.ID,
+		Name: fr.Name,
+		Type: fr.Type,
+	}
+	for _, opt := range fr.TypeConfig.Options {
+		name := opt.Name
+		if name == "" {
+			name = opt.Label // labels fields use "label" instead of "name"
+		}
+		def.Options = append(def.Options, models.CustomFieldOption{ID: opt.ID, Name: name})
+	}
+	return def
+}
+
 // ToTask converts TaskResponse to models.Task
 func (tr *TaskResponse) ToTask() *models.Task {
 	task := &models.Task{
@@ -14,6 +14,7 @@ import (
 // Repository interface defines Git operations
 type Repository interface {
 	CurrentBranch() (string, error)
+	RemoteCommit(branch string) (string, error)
 	CreateBranch(name string) error
 	Checkout(branch string) error
 	Status() (map[string]string, error)
@@ -22,6 +23,9 @@ type Repository interface {
 	BranchExists(name string) (bool, error)
 	GetRemoteBranch(branch string) (string, error)
 	GetRootPath() (string, error)
+	LoadStack() (Stack, error)
+	SaveStackBranch(branch *StackBranch) error
+	RemoveStackBranch(name string) error
 }
 
 // GitRepository implements Repository using go-git
@@ -216,6 +220,16 @@ func (r *GitRepository) BranchExists(name string) (bool, error) {
 	return true, nil
 }
 
+// RemoteCommit returns the hash of the commit origin's copy of a branch
+// pointed to when it was last fetched or pushed
+func (r *GitRepository) RemoteCommit(branch string) (string, error) {
+	ref, err := r.repo.Reference(plumbing.NewRemoteReferenceName("origin", branch), true)
+	if err != nil {
+		return "", fmt.Errorf("failed to get remote branch: %w", err)
+	}
+	return ref.Hash().String(), nil
+}
+
 // GetRemoteBranch returns the remote tracking branch for a local branch
 func (r *GitRepository) GetRemoteBranch(branch string) (string, error) {
 	_, err := r.repo.Reference(plumbing.NewBranchReferenceName(branch), true)
@@ -0,0 +1,211 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Stack config keys, stored per branch as branch.<name>.<key> in the local
+// git config
+const (
+	stackParentKey = "vibe-parent"
+	stackBaseKey   = "vibe-base"
+	stackPRKey     = "vibe-pr"
+)
+
+// StackBranch is a branch in a stack of dependent branches
+type StackBranch struct {
+	Name   string
+	Parent string // Branch it's based on
+	Base   string // Commit of Parent it was last rebased onto, empty when unknown
+	PR     int    // Number of its PR, 0 until one is created
+}
+
+// Stack holds the stacked branches of a repository by name. Branches whose
+// parent isn't stacked, like main, are the bottoms of their stacks.
+type Stack map[string]*StackBranch
+
+// LoadStack reads the stacked branches from the local git config. go-git
+// rewrites the whole config file on save, so the stack is read and written
+// with git config instead.
+func (r *GitRepository) LoadStack() (Stack, error) {
+	output, err := r.gitConfig("--get-regexp", `^branch\..*\.vibe-(parent|base|pr)$`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stack: %w", err)
+	}
+	return ParseStackConfig(output), nil
+}
+
+// SaveStackBranch records a branch's place in its stack
+func (r *GitRepository) SaveStackBranch(branch *StackBranch) error {
+	values := map[string]string{
+		stackParentKey: branch.Parent,
+		stackBaseKey:   branch.Base,
+	}
+	if branch.PR > 0 {
+		values[stackPRKey] = strconv.Itoa(branch.PR)
+	}
+
+	for _, key := range []string{stackParentKey, stackBaseKey, stackPRKey} {
+		name := fmt.Sprintf("branch.%s.%s", branch.Name, key)
+		var err error
+		if value := values[key]; value != "" {
+			_, err = r.gitConfig(name, value)
+		} else {
+			_, err = r.gitConfig("--unset-all", name)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to save stack branch %s: %w", branch.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// RemoveStackBranch forgets a branch's place in its stack
+func (r *GitRepository) RemoveStackBranch(name string) error {
+	for _, key := range []string{stackParentKey, stackBaseKey, stackPRKey} {
+		if _, err := r.gitConfig("--unset-all", fmt.Sprintf("branch.%s.%s", name, key)); err != nil {
+			return fmt.Errorf("failed to remove stack branch %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// gitConfig runs git config on the repository's local config. Missing keys
+// aren't errors: git config exits with 1 when a key isn't found and 5 when
+// unsetting a key that isn't set.
+func (r *GitRepository) gitConfig(args ...string) (string, error) {
+	cmd := exec.Command("git", append([]string{"-C", r.path, "config", "--local"}, args...)...)
+	output, err := cmd.Output()
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && (exitErr.ExitCode() == 1 || exitErr.ExitCode() == 5) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return string(output), nil
+}
+
+// ParseStackConfig parses the stack keys listed by git config --get-regexp,
+// one "branch.<name>.<key> <value>" per line
+func ParseStackConfig(output string) Stack {
+	stack := make(Stack)
+
+	for _, line := range strings.Split(output, "\n") {
+		key, value, ok := strings.Cut(strings.TrimSpace(line), " ")
+		if !ok || !strings.HasPrefix(key, "branch.") {
+			continue
+		}
+
+		// Branch names may contain dots, so the name is everything between
+		// the section and the key
+		dot := strings.LastIndex(key, ".")
+		name, option := key[len("branch."):dot], key[dot+1:]
+		if name == "" {
+			continue
+		}
+
+		branch := stack[name]
+		if branch == nil {
+			branch = &StackBranch{Name: name}
+			stack[name] = branch
+		}
+
+		switch option {
+		case stackParentKey:
+			branch.Parent = value
+		case stackBaseKey:
+			branch.Base = value
+		case stackPRKey:
+			branch.PR, _ = strconv.Atoi(value)
+		}
+	}
+
+	// A branch without a parent isn't stacked
+	for name, branch := range stack {
+		if branch.Parent == "" {
+			delete(stack, name)
+		}
+	}
+
+	return stack
+}
+
+// Bottom returns the branch a stack is based on, like main, by following the
+// parents of a branch down to the first one that isn't stacked
+func (s Stack) Bottom(name string) string {
+	seen := make(map[string]bool)
+	for s[name] != nil && !seen[name] {
+		seen[name] = true
+		name = s[name].Parent
+	}
+	return name
+}
+
+// IsStackedOn reports whether a branch is stacked on ancestor, directly or
+// through other stacked branches
+func (s Stack) IsStackedOn(name, ancestor string) bool {
+	seen := make(map[string]bool)
+	for s[name] != nil && !seen[name] {
+		seen[name] = true
+		name = s[name].Parent
+		if name == ancestor {
+			return true
+		}
+	}
+	return false
+}
+
+// Lineage returns a stacked branch and the stacked branches below it, from
+// the one on the bottom up
+func (s Stack) Lineage(name string) []*StackBranch {
+	var lineage []*StackBranch
+	seen := make(map[string]bool)
+	for s[name] != nil && !seen[name] {
+		seen[name] = true
+		lineage = append([]*StackBranch{s[name]}, lineage...)
+		name = s[name].Parent
+	}
+	return lineage
+}
+
+// Children returns the branches stacked directly on a branch, by name
+func (s Stack) Children(name string) []*StackBranch {
+	var children []*StackBranch
+	for _, branch := range s {
+		if branch.Parent == name {
+			children = append(children, branch)
+		}
+	}
+	sort.Slice(children, func(i, j int) bool { return children[i].Name < children[j].Name })
+	return children
+}
+
+// Branches returns the stacked branches that share a bottom with a branch,
+// parents before their children
+func (s Stack) Branches(name string) []*StackBranch {
+	var branches []*StackBranch
+	seen := make(map[string]bool)
+
+	var walk func(parent string)
+	walk = func(parent string) {
+		for _, child := range s.Children(parent) {
+			if seen[child.Name] {
+				continue
+			}
+			seen[child.Name] = true
+			branches = append(branches, child)
+			walk(child.Name)
+		}
+	}
+	walk(s.Bottom(name))
+
+	return branches
+}
@@ -0,0 +1,83 @@
+package git
+
+import (
+	"testing"
+)
+
+const testStackConfig = `branch.feat/api.vibe-parent main
+branch.feat/api.vibe-base 1111111
+branch.feat/api.vibe-pr 12
+branch.feat/ui.v2.vibe-parent feat/api
+branch.feat/ui.v2.vibe-pr 13
+branch.feat/docs.vibe-parent feat/api
+branch.fix/other.vibe-parent develop
+branch.orphan.vibe-pr 99
+`
+
+func TestParseStackConfig(t *testing.T) {
+	stack := ParseStackConfig(testStackConfig)
+
+	if len(stack) != 4 {
+		t.Fatalf("Expected 4 stacked branches, got %d: %v", len(stack), stack)
+	}
+
+	api := stack["feat/api"]
+	if api == nil || api.Parent != "main" || api.Base != "1111111" || api.PR != 12 {
+		t.Errorf("Unexpected feat/api: %+v", api)
+	}
+	if ui := stack["feat/ui.v2"]; ui == nil || ui.Parent != "feat/api" || ui.PR != 13 {
+		t.Errorf("Expected feat/ui.v2 on feat/api with PR 13, got %+v", ui)
+	}
+	if _, ok := stack["orphan"]; ok {
+		t.Error("Expected a branch without a parent to be left out")
+	}
+}
+
+func TestStack_Branches(t *testing.T) {
+	stack := ParseStackConfig(testStackConfig)
+
+	if bottom := stack.Bottom("feat/ui.v2"); bottom != "main" {
+		t.Errorf("Expected bottom main, got %q", bottom)
+	}
+	if bottom := stack.Bottom("main"); bottom != "main" {
+		t.Errorf("Expected main to be its own bottom, got %q", bottom)
+	}
+	if !stack.IsStackedOn("feat/ui.v2", "main") || stack.IsStackedOn("feat/api", "feat/ui.v2") {
+		t.Error("Expected feat/ui.v2 to be stacked on main, and feat/api not on feat/ui.v2")
+	}
+
+	if lineage := stack.Lineage("feat/ui.v2"); len(lineage) != 2 || lineage[0].Name != "feat/api" || lineage[1].Name != "feat/ui.v2" {
+		t.Errorf("Expected lineage feat/api, feat/ui.v2, got %v", lineage)
+	}
+
+	var names []string
+	for _, branch := range stack.Branches("feat/docs") {
+		names = append(names, branch.Name)
+	}
+	want := []string{"feat/api", "feat/docs", "feat/ui.v2"}
+	if len(names) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, names)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Errorf("Expected %v, got %v", want, names)
+			break
+		}
+	}
+}
+
+func TestStack_Cycle(t *testing.T) {
+	stack := Stack{
+		"a": {Name: "a", Parent: "b"},
+		"b": {Name: "b", Parent: "a"},
+	}
+
+	// Following a cycle stops instead of looping forever
+	bottom := stack.Bottom("a")
+	if bottom != "a" && bottom != "b" {
+		t.Errorf("Expected a branch of the cycle, got %q", bottom)
+	}
+	if branches := stack.Branches("a"); len(branches) > 2 {
+		t.Errorf("Expected at most 2 branches, got %d", len(branches))
+	}
+}
@@ -0,0 +1,122 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	neturl "net/url"
+	"os/exec"
+	"strings"
+)
+
+// ActionsClient defines GitHub Actions operations
+type ActionsClient interface {
+	// Repository returns the owner/repo the client operates on
+	Repository() string
+	ListWorkflowRuns(ctx context.Context, branch string) ([]WorkflowRunResponse, error)
+	GetWorkflowRunJobs(ctx context.Context, runID int64) ([]WorkflowJobResponse, error)
+	GetWorkflowJob(ctx context.Context, jobID int64) (*WorkflowJobResponse, error)
+	GetJobLogs(ctx context.Context, jobID int64) (string, error)
+	ListRunArtifacts(ctx context.Context, runID int64) ([]ArtifactResponse, error)
+	DownloadArtifact(ctx context.Context, artifactID int64) ([]byte, error)
+}
+
+// Repository returns the owner/repo the client operates on
+func (c *HTTPClient) Repository() string {
+	return c.owner + "/" + c.repo
+}
+
+// ListWorkflowRuns retrieves the most recent workflow runs for a branch, newest first
+func (c *HTTPClient) ListWorkflowRuns(ctx context.Context, branch string) ([]WorkflowRunResponse, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/actions/runs?branch=%s&per_page=50", c.baseURL, c.owner, c.repo, neturl.QueryEscape(branch))
+
+	var resp struct {
+		WorkflowRuns []WorkflowRunResponse `json:"workflow_runs"`
+	}
+	err := c.httpClient.DoJSONRequest(ctx, "GET", url, nil, &resp, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflow runs: %w", err)
+	}
+
+	return resp.WorkflowRuns, nil
+}
+
+// GetWorkflowRunJobs retrieves the jobs of the latest attempt of a workflow run
+func (c *HTTPClient) GetWorkflowRunJobs(ctx context.Context, runID int64) ([]WorkflowJobResponse, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/actions/runs/%d/jobs?filter=latest&per_page=100", c.baseURL, c.owner, c.repo, runID)
+
+	var resp struct {
+		Jobs []WorkflowJobResponse `json:"jobs"`
+	}
+	err := c.httpClient.DoJSONRequest(ctx, "GET", url, nil, &resp, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflow jobs: %w", err)
+	}
+
+	return resp.Jobs, nil
+}
+
+// GetWorkflowJob retrieves a workflow job by ID
+func (c *HTTPClient) GetWorkflowJob(ctx context.Context, jobID int64) (*WorkflowJobResponse, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/actions/jobs/%d", c.baseURL, c.owner, c.repo, jobID)
+
+	var job WorkflowJobResponse
+	err := c.httpClient.DoJSONRequest(ctx, "GET", url, nil, &job, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflow job: %w", err)
+	}
+
+	return &job, nil
+}
+
+// GetJobLogs downloads the plain text log of a workflow job. The API
+// redirects to a short-lived download URL, which is followed without the
+// Authorization header.
+func (c *HTTPClient) GetJobLogs(ctx context.Context, jobID int64) (string, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/actions/jobs/%d/logs", c.baseURL, c.owner, c.repo, jobID)
+
+	var logs []byte
+	err := c.httpClient.DoJSONRequest(ctx, "GET", url, nil, &logs, c.headers())
+	if err != nil {
+		return "", fmt.Errorf("failed to get job logs: %w", err)
+	}
+
+	return string(logs), nil
+}
+
+// ListRunArtifacts retrieves the artifacts uploaded by a workflow run
+func (c *HTTPClient) ListRunArtifacts(ctx context.Context, runID int64) ([]ArtifactResponse, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/actions/runs/%d/artifacts?per_page=100", c.baseURL, c.owner, c.repo, runID)
+
+	var resp struct {
+		Artifacts []ArtifactResponse `json:"artifacts"`
+	}
+	err := c.httpClient.DoJSONRequest(ctx, "GET", url, nil, &resp, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list artifacts: %w", err)
+	}
+
+	return resp.Artifacts, nil
+}
+
+// DownloadArtifact downloads an artifact as a zip archive
+func (c *HTTPClient) DownloadArtifact(ctx context.Context, artifactID int64) ([]byte, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/actions/artifacts/%d/zip", c.baseURL, c.owner, c.repo, artifactID)
+
+	var archive []byte
+	err := c.httpClient.DoJSONRequest(ctx, "GET", url, nil, &archive, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to download artifact: %w", err)
+	}
+
+	return archive, nil
+}
+
+// GHAuthToken returns the token of the gh CLI's logged in account, so the
+// Actions API can be used in CLI mode without a token in config
+func GHAuthToken() (string, error) {
+	output, err := exec.Command("gh", "auth", "token").Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to get gh CLI token: %w", err)
+	}
+	return strings.TrimSpace(string(output)), nil
+}
@@ -0,0 +1,57 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestListWorkflowRuns(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/test-owner/test-repo/actions/runs" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("branch"); got != "user/abc/fix" {
+			t.Errorf("branch = %q", got)
+		}
+		_, _ = w.Write([]byte(`{"total_count": 1, "workflow_runs": [{"id": 9000000001, "name": "CI", "status": "completed", "conclusion": "failure", "head_sha": "abc"}]}`))
+	}))
+	defer server.Close()
+
+	runs, err := createTestClient(server.URL).ListWorkflowRuns(context.Background(), "user/abc/fix")
+	if err != nil {
+		t.Fatalf("ListWorkflowRuns() error = %v", err)
+	}
+	if len(runs) != 1 || runs[0].ID != 9000000001 || runs[0].Conclusion != "failure" {
+		t.Errorf("unexpected runs: %+v", runs)
+	}
+}
+
+func TestGetJobLogs_FollowsRedirect(t *testing.T) {
+	logs := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			t.Errorf("token sent to log storage: %q", auth)
+		}
+		_, _ = w.Write([]byte("2024-05-01T10:00:00.0000000Z hello\n"))
+	}))
+	defer logs.Close()
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/test-owner/test-repo/actions/jobs/42/logs" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		// A different hostname, like the blob storage GitHub redirects to
+		http.Redirect(w, r, strings.Replace(logs.URL, "127.0.0.1", "localhost", 1)+"/signed", http.StatusFound)
+	}))
+	defer api.Close()
+
+	got, err := createTestClient(api.URL).GetJobLogs(context.Background(), 42)
+	if err != nil {
+		t.Fatalf("GetJobLogs() error = %v", err)
+	}
+	if got != "2024-05-01T10:00:00.0000000Z hello\n" {
+		t.Errorf("GetJobLogs() = %q", got)
+	}
+}
@@ -50,6 +50,35 @@ func (c *CLIClient) runGH(ctx context.Context, args ...string) (string, error) {
 	return strings.TrimSpace(string(output)), nil
 }
 
+// runGHAPI executes a gh api command. gh api takes the repository from the
+// path rather than --repo.
+func (c *CLIClient) runGHAPI(ctx context.Context, args ...string) (string, error) {
+	return c.runGHAPIWithStdin(ctx, "", args...)
+}
+
+// runGHAPIWithStdin executes a gh api command with stdin input, for request
+// bodies passed with --input -
+func (c *CLIClient) runGHAPIWithStdin(ctx context.Context, stdin string, args ...string) (string, error) {
+	fullArgs := append([]string{"api"}, args...)
+
+	cmd := exec.CommandContext(ctx, "gh", fullArgs...)
+	cmd.Env = os.Environ()
+	if stdin != "" {
+		cmd.Stdin = strings.NewReader(stdin)
+	}
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		return "", fmt.Errorf("gh CLI command failed (gh %s): %w\nOutput: %s",
+			strings.Join(fullArgs, " "), err, string(output))
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
 // runGHWithStdin executes a gh CLI command with stdin input
 func (c *CLIClient) runGHWithStdin(ctx context.Context, stdin string, args ...string) (string, error) {
 	// Add repo context
@@ -87,6 +116,7 @@ func (c *CLIClient) CreatePR(ctx context.Context, req *models.PRCreateRequest) (
 	if req.Draft {
 		args = append(args, "--draft")
 	}
+	args = append(args, c.metadataArgs(&req.PRMetadata, "--")...)
 
 	// Get PR URL from output
 	output, err := c.runGH(ctx, args...)
@@ -126,14 +156,18 @@ func (c *CLIClient) GetPR(ctx context.Context, prNumber int) (*models.PullReques
 		Mergeable           string `json:"mergeable"`
 		URL                 string `json:"url"`
 		HeadRefName         string `json:"headRefName"`
+		HeadRefOid          string `json:"headRefOid"`
 		BaseRefName         string `json:"baseRefName"`
 		HeadRepositoryOwner struct {
 			Login string `json:"login"`
 		} `json:"headRepositoryOwner"`
+		Author struct {
+			Login string `json:"login"`
+		} `json:"author"`
 	}
 
 	args := []string{"pr", "view", strconv.Itoa(prNumber), "--json",
-		"number,title,body,state,isDraft,merged,mergeable,url,headRefName,baseRefName,headRepositoryOwner",
+		"number,title,body,state,isDraft,merged,mergeable,url,headRefName,headRefOid,baseRefName,headRepositoryOwner,author",
 	}
 
 	output, err := c.runGH(ctx, args...)
@@ -158,23 +192,31 @@ func (c *CLIClient) GetPR(ctx context.Context, prNumber int) (*models.PullReques
 		URL:       prData.URL,
 		Head: models.Branch{
 			Ref: prData.HeadRefName,
+			SHA: prData.HeadRefOid,
 		},
 		Base: models.Branch{
 			Ref: prData.BaseRefName,
 		},
+		User: models.GitHubUser{
+			Login: prData.Author.Login,
+		},
 	}, nil
 }
 
 // UpdatePR updates a pull request
-func (c *CLIClient) UpdatePR(ctx context.Context, prNumber int, title, body *string) (*models.PullRequest, error) {
+func (c *CLIClient) UpdatePR(ctx context.Context, prNumber int, req *models.PRUpdateRequest) (*models.PullRequest, error) {
 	args := []string{"pr", "edit", strconv.Itoa(prNumber)}
 
-	if title != nil {
-		args = append(args, "--title", *title)
+	if req.Title != nil {
+		args = append(args, "--title", *req.Title)
+	}
+
+	if req.Body != nil {
+		args = append(args, "--body", *req.Body)
 	}
 
-	if body != nil {
-		args = append(args, "--body", *body)
+	if req.Base != nil {
+		args = append(args, "--base", *req.Base)
 	}
 
 	_, err := c.runGH(ctx, args...)
@@ -194,38 +236,111 @@ func (c *CLIClient) GetPRStatus(ctx context.Context, prNumber int) (*models.PRSt
 	}
 
 	status := buildBasicPRStatus(prData)
-	status.ReviewStatus = parseReviewStatus(prData)
+	status.ReviewStatus = models.SummarizeReviews(latestReviews(prData.Reviews.toReviews()))
 	status.CheckStatus = parseCheckStatus(prData)
 
 	return status, nil
 }
 
+// GetPRReviews retrieves the latest review state of each reviewer on a pull request
+func (c *CLIClient) GetPRReviews(ctx context.Context, prNumber int) ([]models.Review, error) {
+	output, err := c.runGH(ctx, "pr", "view", strconv.Itoa(prNumber), "--json", "reviews")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reviews: %w", err)
+	}
+
+	var data struct {
+		Reviews prReviewList `json:"reviews"`
+	}
+	if err := json.Unmarshal([]byte(output), &data); err != nil {
+		return nil, fmt.Errorf("failed to parse reviews: %w", err)
+	}
+
+	return latestReviews(data.Reviews.toReviews()), nil
+}
+
+// GetPRForBranch retrieves the open pull request whose head is the given branch.
+// Returns nil if the branch has no open pull request.
+func (c *CLIClient) GetPRForBranch(ctx context.Context, branch string) (*models.PullRequest, error) {
+	output, err := c.runGH(ctx, "pr", "list", "--head", branch, "--state", "open",
+		"--limit", "1", "--json", "number")
+	if err != nil {
+		return nil, fmt.Errorf("failed to find PR for branch %s: %w", branch, err)
+	}
+
+	var prs []struct {
+		Number int `json:"number"`
+	}
+	if err := json.Unmarshal([]byte(output), &prs); err != nil {
+		return nil, fmt.Errorf("failed to parse PR list: %w", err)
+	}
+
+	if len(prs) == 0 {
+		return nil, nil
+	}
+
+	return c.GetPR(ctx, prs[0].Number)
+}
+
+// GetPRBody retrieves the body of a pull request
+func (c *CLIClient) GetPRBody(ctx context.Context, prNumber int) (string, error) {
+	output, err := c.runGH(ctx, "pr", "view", strconv.Itoa(prNumber), "--json", "body")
+	if err != nil {
+		return "", fmt.Errorf("failed to get PR body: %w", err)
+	}
+
+	var data struct {
+		Body string `json:"body"`
+	}
+	if err := json.Unmarshal([]byte(output), &data); err != nil {
+		return "", fmt.Errorf("failed to parse PR body: %w", err)
+	}
+
+	return data.Body, nil
+}
+
+// prReviewList is the reviews field of gh pr view --json output
+type prReviewList []struct {
+	State  string `json:"state"`
+	Author struct {
+		Login string `json:"login"`
+	} `json:"author"`
+}
+
+// toReviews converts gh reviews to models in chronological order
+func (l prReviewList) toReviews() []models.Review {
+	reviews := make([]models.Review, len(l))
+	for i, review := range l {
+		reviews[i] = models.Review{
+			Reviewer: review.Author.Login,
+			State:    review.State,
+		}
+	}
+	return reviews
+}
+
 type prStatusData struct {
 	Number            int    `json:"number"`
+	Title             string `json:"title"`
 	State             string `json:"state"`
 	IsDraft           bool   `json:"isDraft"`
 	Merged            bool   `json:"merged"`
 	Mergeable         string `json:"mergeable"`
 	URL               string `json:"url"`
+	BaseRefName       string `json:"baseRefName"`
 	StatusCheckRollup []struct {
+		Name       string `json:"name"`
 		Context    string `json:"context"`
 		State      string `json:"state"`
 		Status     string `json:"status"`
 		Conclusion string `json:"conclusion"`
 	} `json:"statusCheckRollup"`
-	Reviews struct {
-		Nodes []struct {
-			State  string `json:"state"`
-			Author struct {
-				Login string `json:"login"`
-			} `json:"author"`
-		} `json:"nodes"`
-	} `json:"reviews"`
+	Reviews prReviewList `json:"reviews"`
 }
 
 func (c *CLIClient) fetchPRData(ctx context.Context, prNumber int) (*prStatusData, error) {
 	args := []string{"pr", "view", strconv.Itoa(prNumber), "--json",
-		"number,state,isDraft,merged,mergeable,url,statusCheckRollup,reviews",
+		"number,title,state,isDraft,merged,mergeable,url,baseRefName,statusCheckRollup,reviews",
 	}
 
 	output, err := c.runGH(ctx, args...)
@@ -243,90 +358,38 @@ func (c *CLIClient) fetchPRData(ctx context.Context, prNumber int) (*prStatusDat
 
 func buildBasicPRStatus(prData *prStatusData) *models.PRStatus {
 	return &models.PRStatus{
-		Number:    prData.Number,
-		State:     strings.ToLower(prData.State),
-		Draft:     prData.IsDraft,
-		Merged:    prData.Merged,
-		Mergeable: prData.Mergeable == "MERGEABLE",
-		URL:       prData.URL,
+		Number:     prData.Number,
+		Title:      prData.Title,
+		State:      strings.ToLower(prData.State),
+		Draft:      prData.IsDraft,
+		Merged:     prData.Merged,
+		Mergeable:  prData.Mergeable == "MERGEABLE",
+		BaseBranch: prData.BaseRefName,
+		URL:        prData.URL,
 	}
 }
 
-func parseReviewStatus(prData *prStatusData) models.ReviewStatus {
-	reviewStatus := models.ReviewStatus{}
-
-	// Use a map to track only the latest review from each reviewer
-	latestReviews := make(map[string]string)
-	for _, review := range prData.Reviews.Nodes {
-		latestReviews[review.Author.Login] = review.State
-	}
-
-	// Count review states
-	for _, state := range latestReviews {
-		switch state {
-		case "APPROVED":
-			reviewStatus.Approved++
-		case "CHANGES_REQUESTED":
-			reviewStatus.ChangesRequested++
-		case "COMMENTED":
-			reviewStatus.Commented++
-		default:
-			reviewStatus.Pending++
-		}
-	}
-
-	// Determine overall status
-	reviewStatus.OverallStatus = determineOverallReviewStatus(reviewStatus)
-	return reviewStatus
-}
-
-func determineOverallReviewStatus(rs models.ReviewStatus) string {
-	if rs.ChangesRequested > 0 {
-		return "changes_requested"
-	} else if rs.Approved > 0 {
-		return "approved"
-	} else if rs.Commented > 0 || rs.Pending > 0 {
-		return "pending"
-	}
-	return "none"
-}
-
 func parseCheckStatus(prData *prStatusData) models.CheckStatus {
-	checkStatus := models.CheckStatus{
-		Total: len(prData.StatusCheckRollup),
-	}
+	checks := make([]models.Check, len(prData.StatusCheckRollup))
 
-	for _, check := range prData.StatusCheckRollup {
+	for i, check := range prData.StatusCheckRollup {
+		// Check runs report name/conclusion, commit statuses report context/state
+		name := check.Name
+		if name == "" {
+			name = check.Context
+		}
 		checkState := check.Conclusion
 		if checkState == "" {
 			checkState = check.State
 		}
 
-		switch strings.ToUpper(checkState) {
-		case "SUCCESS", "NEUTRAL", "SKIPPED":
-			checkStatus.Passed++
-		case "FAILURE", "ERROR", "TIMED_OUT", "ACTION_REQUIRED":
-			checkStatus.Failed++
-		case "PENDING", "QUEUED", "IN_PROGRESS":
-			checkStatus.Pending++
-		default:
-			checkStatus.Pending++
+		checks[i] = models.Check{
+			Name:   name,
+			Status: rollupCheckStatus(checkState),
 		}
 	}
 
-	checkStatus.OverallStatus = determineOverallCheckStatus(checkStatus)
-	return checkStatus
-}
-
-func determineOverallCheckStatus(cs models.CheckStatus) string {
-	if cs.Failed > 0 {
-		return "failure"
-	} else if cs.Pending > 0 {
-		return "pending"
-	} else if cs.Passed > 0 {
-		return "success"
-	}
-	return "none"
+	return models.SummarizeChecks(checks)
 }
 
 // ListPRs lists pull requests with optional state filter
@@ -391,6 +454,42 @@ func (c *CLIClient) AddComment(ctx context.Context, prNumber int, body string) e
 	return nil
 }
 
+// AddPRMetadata requests reviewers and adds labels and assignees to a pull request
+func (c *CLIClient) AddPRMetadata(ctx context.Context, prNumber int, metadata *models.PRMetadata) error {
+	if metadata.IsEmpty() {
+		return nil
+	}
+
+	args := append([]string{"pr", "edit", strconv.Itoa(prNumber)}, c.metadataArgs(metadata, "--add-")...)
+	if _, err := c.runGH(ctx, args...); err != nil {
+		return fmt.Errorf("failed to add reviewers, labels, or assignees: %w", err)
+	}
+
+	return nil
+}
+
+// metadataArgs builds the reviewer, label, and assignee flags of gh pr create
+// (prefix "--") or gh pr edit (prefix "--add-"). gh takes teams as org/team.
+func (c *CLIClient) metadataArgs(metadata *models.PRMetadata, prefix string) []string {
+	var args []string
+	for _, reviewer := range metadata.Reviewers {
+		args = append(args, prefix+"reviewer", reviewer)
+	}
+	for _, team := range metadata.TeamReviewers {
+		if !strings.Contains(team, "/") {
+			team = c.owner + "/" + team
+		}
+		args = append(args, prefix+"reviewer", team)
+	}
+	for _, label := range metadata.Labels {
+		args = append(args, prefix+"label", label)
+	}
+	for _, assignee := range metadata.Assignees {
+		args = append(args, prefix+"assignee", assignee)
+	}
+	return args
+}
+
 // GetPRTemplate retrieves the PR template from the repository
 func (c *CLIClient) GetPRTemplate(ctx context.Context) (string, error) {
 	// Try common PR template locations
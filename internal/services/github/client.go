@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	neturl "net/url"
 	"os/exec"
+	"strings"
 
 	"github.com/rithyhuot/vibe/internal/models"
 	"github.com/rithyhuot/vibe/internal/utils"
@@ -18,10 +20,15 @@ const (
 type Client interface {
 	CreatePR(ctx context.Context, req *models.PRCreateRequest) (*models.PullRequest, error)
 	GetPR(ctx context.Context, prNumber int) (*models.PullRequest, error)
-	UpdatePR(ctx context.Context, prNumber int, title, body *string) (*models.PullRequest, error)
+	UpdatePR(ctx context.Context, prNumber int, req *models.PRUpdateRequest) (*models.PullRequest, error)
 	GetPRStatus(ctx context.Context, prNumber int) (*models.PRStatus, error)
+	GetPRReviews(ctx context.Context, prNumber int) ([]models.Review, error)
+	GetPRForBranch(ctx context.Context, branch string) (*models.PullRequest, error)
+	GetPRBody(ctx context.Context, prNumber int) (string, error)
 	ListPRs(ctx context.Context, state string) ([]*models.PullRequest, error)
 	AddComment(ctx context.Context, prNumber int, body string) error
+	// AddPRMetadata requests reviewers and adds labels and assignees, keeping existing ones
+	AddPRMetadata(ctx context.Context, prNumber int, metadata *models.PRMetadata) error
 	GetPRTemplate(ctx context.Context) (string, error)
 
 	// Issue operations
@@ -54,6 +61,18 @@ func NewClient(token, owner, repo string) *HTTPClient {
 	}
 }
 
+// WithRetryPolicy sets the retry budget for requests made by this client
+func (c *HTTPClient) WithRetryPolicy(policy utils.RetryPolicy) *HTTPClient {
+	c.httpClient.WithRetryPolicy(policy)
+	return c
+}
+
+// WithCache enables on-disk caching of GET responses made by this client
+func (c *HTTPClient) WithCache(cache *utils.ResponseCache) *HTTPClient {
+	c.httpClient.WithCache(cache)
+	return c
+}
+
 // NewClientWithMode creates a GitHub client based on the specified mode
 // mode can be "api", "cli", or "auto"
 // Returns Client interface that can be either HTTPClient or CLIClient
@@ -122,6 +141,13 @@ func (c *HTTPClient) CreatePR(ctx context.Context, req *models.PRCreateRequest)
 		return nil, fmt.Errorf("failed to create PR: %w", err)
 	}
 
+	// Reviewers, labels, and assignees can't be set when creating a PR
+	if !req.IsEmpty() {
+		if err := c.AddPRMetadata(ctx, resp.Number, &req.PRMetadata); err != nil {
+			return nil, fmt.Errorf("PR #%d was created (%s), but %w", resp.Number, resp.HTMLURL, err)
+		}
+	}
+
 	return resp.ToPullRequest(), nil
 }
 
@@ -139,15 +165,18 @@ func (c *HTTPClient) GetPR(ctx context.Context, prNumber int) (*models.PullReque
 }
 
 // UpdatePR updates a pull request
-func (c *HTTPClient) UpdatePR(ctx context.Context, prNumber int, title, body *string) (*models.PullRequest, error) {
+func (c *HTTPClient) UpdatePR(ctx context.Context, prNumber int, req *models.PRUpdateRequest) (*models.PullRequest, error) {
 	url := fmt.Sprintf("%s/repos/%s/%s/pulls/%d", c.baseURL, c.owner, c.repo, prNumber)
 
 	payload := make(map[string]interface{})
-	if title != nil {
-		payload["title"] = *title
+	if req.Title != nil {
+		payload["title"] = *req.Title
+	}
+	if req.Body != nil {
+		payload["body"] = *req.Body
 	}
-	if body != nil {
-		payload["body"] = *body
+	if req.Base != nil {
+		payload["base"] = *req.Base
 	}
 
 	var resp PRResponse
@@ -168,21 +197,24 @@ func (c *HTTPClient) GetPRStatus(ctx context.Context, prNumber int) (*models.PRS
 	}
 
 	status := &models.PRStatus{
-		Number:    pr.Number,
-		State:     pr.State,
-		Draft:     pr.Draft,
-		Merged:    pr.Merged,
-		Mergeable: pr.Mergeable,
-		URL:       pr.URL,
+		Number:     pr.Number,
+		Title:      pr.Title,
+		State:      pr.State,
+		Draft:      pr.Draft,
+		Merged:     pr.Merged,
+		Mergeable:  pr.Mergeable,
+		BaseBranch: pr.Base.Ref,
+		URL:        pr.URL,
 	}
 
 	// Get review status
-	reviewStatus, err := c.getReviewStatus(ctx, prNumber)
+	reviews, err := c.GetPRReviews(ctx, prNumber)
 	if err != nil {
 		// Don't fail on review status error, just log it
-		reviewStatus = &models.ReviewStatus{OverallStatus: "unknown"}
+		status.ReviewStatus = models.ReviewStatus{OverallStatus: "unknown"}
+	} else {
+		status.ReviewStatus = models.SummarizeReviews(reviews)
 	}
-	status.ReviewStatus = *reviewStatus
 
 	// Get check status
 	if pr.Head.SHA == "" {
@@ -200,54 +232,58 @@ func (c *HTTPClient) GetPRStatus(ctx context.Context, prNumber int) (*models.PRS
 	return status, nil
 }
 
-// getReviewStatus retrieves review status for a PR
-func (c *HTTPClient) getReviewStatus(ctx context.Context, prNumber int) (*models.ReviewStatus, error) {
-	url := fmt.Sprintf("%s/repos/%s/%s/pulls/%d/reviews", c.baseURL, c.owner, c.repo, prNumber)
+// GetPRReviews retrieves the latest review state of each reviewer on a pull request
+func (c *HTTPClient) GetPRReviews(ctx context.Context, prNumber int) ([]models.Review, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/pulls/%d/reviews?per_page=100", c.baseURL, c.owner, c.repo, prNumber)
 
-	var reviews []ReviewResponse
-	err := c.httpClient.DoJSONRequest(ctx, "GET", url, nil, &reviews, c.headers())
+	var resp []ReviewResponse
+	err := c.httpClient.DoJSONRequest(ctx, "GET", url, nil, &resp, c.headers())
 	if err != nil {
 		return nil, fmt.Errorf("failed to get reviews: %w", err)
 	}
 
-	status := &models.ReviewStatus{}
-
-	// Count reviews by state (only count the latest review per user)
-	latestReviews := make(map[string]string)
-	for _, review := range reviews {
-		latestReviews[review.User.Login] = review.State
+	reviews := make([]models.Review, len(resp))
+	for i, review := range resp {
+		reviews[i] = models.Review{
+			Reviewer: review.User.Login,
+			State:    review.State,
+		}
 	}
 
-	for _, state := range latestReviews {
-		switch state {
-		case "APPROVED":
-			status.Approved++
-		case "CHANGES_REQUESTED":
-			status.ChangesRequested++
-		case "COMMENTED":
-			status.Commented++
-		default:
-			status.Pending++
-		}
+	return latestReviews(reviews), nil
+}
+
+// GetPRForBranch retrieves the open pull request whose head is the given branch.
+// Returns nil if the branch has no open pull request.
+func (c *HTTPClient) GetPRForBranch(ctx context.Context, branch string) (*models.PullRequest, error) {
+	head := neturl.QueryEscape(fmt.Sprintf("%s:%s", c.owner, branch))
+	url := fmt.Sprintf("%s/repos/%s/%s/pulls?state=open&head=%s", c.baseURL, c.owner, c.repo, head)
+
+	var prs []PRResponse
+	err := c.httpClient.DoJSONRequest(ctx, "GET", url, nil, &prs, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find PR for branch %s: %w", branch, err)
 	}
 
-	// Determine overall status
-	if status.ChangesRequested > 0 {
-		status.OverallStatus = "changes_requested"
-	} else if status.Approved > 0 {
-		status.OverallStatus = "approved"
-	} else if status.Commented > 0 || status.Pending > 0 {
-		status.OverallStatus = "pending"
-	} else {
-		status.OverallStatus = "none"
+	if len(prs) == 0 {
+		return nil, nil
 	}
 
-	return status, nil
+	return prs[0].ToPullRequest(), nil
+}
+
+// GetPRBody retrieves the body of a pull request
+func (c *HTTPClient) GetPRBody(ctx context.Context, prNumber int) (string, error) {
+	pr, err := c.GetPR(ctx, prNumber)
+	if err != nil {
+		return "", err
+	}
+	return pr.Body, nil
 }
 
 // getCheckStatus retrieves check status for a commit
 func (c *HTTPClient) getCheckStatus(ctx context.Context, sha string) (*models.CheckStatus, error) {
-	url := fmt.Sprintf("%s/repos/%s/%s/commits/%s/check-runs", c.baseURL, c.owner, c.repo, sha)
+	url := fmt.Sprintf("%s/repos/%s/%s/commits/%s/check-runs?per_page=100", c.baseURL, c.owner, c.repo, sha)
 
 	var resp struct {
 		TotalCount int                `json:"total_count"`
@@ -259,37 +295,31 @@ func (c *HTTPClient) getCheckStatus(ctx context.Context, sha string) (*models.Ch
 		return nil, fmt.Errorf("failed to get check runs: %w", err)
 	}
 
-	status := &models.CheckStatus{
-		Total: resp.TotalCount,
-	}
-
-	for _, check := range resp.CheckRuns {
-		switch check.Conclusion {
-		case "success":
-			status.Passed++
-		case "failure", "timed_out", "action_required":
-			status.Failed++
-		default:
-			if check.Status == "completed" {
-				status.Passed++
-			} else {
-				status.Pending++
-			}
+	checks := make([]models.Check, len(resp.CheckRuns))
+	for i, check := range resp.CheckRuns {
+		checks[i] = models.Check{
+			Name:   check.Name,
+			Status: checkRunStatus(check.Status, check.Conclusion),
 		}
 	}
 
-	// Determine overall status
-	if status.Failed > 0 {
-		status.OverallStatus = "failure"
-	} else if status.Pending > 0 {
-		status.OverallStatus = "pending"
-	} else if status.Passed > 0 {
-		status.OverallStatus = "success"
-	} else {
-		status.OverallStatus = "none"
+	// Integrations like CircleCI's OAuth app report commit statuses instead of
+	// check runs. They're best-effort, so an error doesn't hide the check runs.
+	var combined struct {
+		Statuses []CommitStatusResponse `json:"statuses"`
+	}
+	url = fmt.Sprintf("%s/repos/%s/%s/commits/%s/status?per_page=100", c.baseURL, c.owner, c.repo, sha)
+	if err := c.httpClient.DoJSONRequest(ctx, "GET", url, nil, &combined, c.headers()); err == nil {
+		for _, commitStatus := range combined.Statuses {
+			checks = append(checks, models.Check{
+				Name:   commitStatus.Context,
+				Status: rollupCheckStatus(commitStatus.State),
+			})
+		}
 	}
 
-	return status, nil
+	status := models.SummarizeChecks(checks)
+	return &status, nil
 }
 
 // ListPRs lists pull requests with optional state filter
@@ -329,6 +359,57 @@ func (c *HTTPClient) AddComment(ctx context.Context, prNumber int, body string)
 	return nil
 }
 
+// AddPRMetadata requests reviewers and adds labels and assignees to a pull request
+func (c *HTTPClient) AddPRMetadata(ctx context.Context, prNumber int, metadata *models.PRMetadata) error {
+	if len(metadata.Reviewers) > 0 || len(metadata.TeamReviewers) > 0 {
+		url := fmt.Sprintf("%s/repos/%s/%s/pulls/%d/requested_reviewers", c.baseURL, c.owner, c.repo, prNumber)
+
+		teams := make([]string, len(metadata.TeamReviewers))
+		for i, team := range metadata.TeamReviewers {
+			teams[i] = teamSlug(team)
+		}
+		payload := map[string]interface{}{
+			"reviewers":      nonNil(metadata.Reviewers),
+			"team_reviewers": teams,
+		}
+
+		if err := c.httpClient.DoJSONRequest(ctx, "POST", url, payload, nil, c.headers()); err != nil {
+			return fmt.Errorf("failed to request reviewers: %w", err)
+		}
+	}
+
+	if len(metadata.Labels) > 0 {
+		url := fmt.Sprintf("%s/repos/%s/%s/issues/%d/labels", c.baseURL, c.owner, c.repo, prNumber)
+		payload := map[string]interface{}{"labels": metadata.Labels}
+		if err := c.httpClient.DoJSONRequest(ctx, "POST", url, payload, nil, c.headers()); err != nil {
+			return fmt.Errorf("failed to add labels: %w", err)
+		}
+	}
+
+	if len(metadata.Assignees) > 0 {
+		url := fmt.Sprintf("%s/repos/%s/%s/issues/%d/assignees", c.baseURL, c.owner, c.repo, prNumber)
+		payload := map[string]interface{}{"assignees": metadata.Assignees}
+		if err := c.httpClient.DoJSONRequest(ctx, "POST", url, payload, nil, c.headers()); err != nil {
+			return fmt.Errorf("failed to add assignees: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// teamSlug returns the slug of a team given as slug or org/slug
+func teamSlug(team string) string {
+	return team[strings.LastIndex(team, "/")+1:]
+}
+
+// nonNil returns an empty slice for nil, so it's sent as [] rather than null
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
 // getTemplateFromPaths fetches a template from one of the given paths
 func (c *HTTPClient) getTemplateFromPaths(ctx context.Context, paths []string) (string, error) {
 	for _, path := range paths {
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -666,3 +667,245 @@ func TestCreateIssue_PartialProjectSuccess(t *testing.T) {
 		t.Errorf("Expected error to mention failed project, got: %v", err)
 	}
 }
+
+func TestGetPRStatus_PerReviewerAndChecks(t *testing.T) {
+	server := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/pulls/42/reviews"):
+			mustEncode(w, []ReviewResponse{
+				{User: UserRef{Login: "alice"}, State: "CHANGES_REQUESTED"},
+				{User: UserRef{Login: "bob"}, State: "APPROVED"},
+				{User: UserRef{Login: "alice"}, State: "APPROVED"},
+				{User: UserRef{Login: "bob"}, State: "COMMENTED"},
+				{User: UserRef{Login: "carol"}, State: "COMMENTED"},
+			})
+		case strings.HasSuffix(r.URL.Path, "/pulls/42"):
+			mustEncode(w, PRResponse{
+				Number:  42,
+				Title:   "Add feature",
+				State:   "open",
+				HTMLURL: "https://github.com/test-owner/test-repo/pull/42",
+				Head:    BranchRef{Ref: "feature", SHA: "abc123"},
+				Base:    BranchRef{Ref: "main"},
+			})
+		case strings.HasSuffix(r.URL.Path, "/commits/abc123/check-runs"):
+			mustEncode(w, map[string]interface{}{
+				"total_count": 3,
+				"check_runs": []CheckRunResponse{
+					{Name: "build", Status: "completed", Conclusion: "success"},
+					{Name: "lint", Status: "completed", Conclusion: "failure"},
+					{Name: "test", Status: "in_progress"},
+				},
+			})
+		case strings.HasSuffix(r.URL.Path, "/commits/abc123/status"):
+			mustEncode(w, map[string]interface{}{
+				"statuses": []CommitStatusResponse{
+					{Context: "ci/circleci: e2e", State: "success"},
+				},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+
+	client := createTestClient(server.URL)
+
+	status, err := client.GetPRStatus(context.Background(), 42)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if status.Title != "Add feature" {
+		t.Errorf("Expected title 'Add feature', got %q", status.Title)
+	}
+	if status.BaseBranch != "main" {
+		t.Errorf("Expected base branch 'main', got %q", status.BaseBranch)
+	}
+
+	expectedReviews := []models.Review{
+		{Reviewer: "alice", State: "APPROVED"},
+		{Reviewer: "bob", State: "APPROVED"},
+		{Reviewer: "carol", State: "COMMENTED"},
+	}
+	if len(status.ReviewStatus.Reviews) != len(expectedReviews) {
+		t.Fatalf("Expected %d reviews, got %v", len(expectedReviews), status.ReviewStatus.Reviews)
+	}
+	for i, expected := range expectedReviews {
+		if status.ReviewStatus.Reviews[i] != expected {
+			t.Errorf("Review %d: expected %v, got %v", i, expected, status.ReviewStatus.Reviews[i])
+		}
+	}
+	if status.ReviewStatus.Approved != 2 || status.ReviewStatus.OverallStatus != "approved" {
+		t.Errorf("Expected 2 approvals and approved status, got %+v", status.ReviewStatus)
+	}
+
+	if status.CheckStatus.Failed != 1 || status.CheckStatus.Pending != 1 || status.CheckStatus.Passed != 2 {
+		t.Errorf("Expected 2 passed, 1 failed, 1 pending, got %+v", status.CheckStatus)
+	}
+	if len(status.CheckStatus.Checks) != 4 || status.CheckStatus.Checks[1] != (models.Check{Name: "lint", Status: "failure"}) {
+		t.Errorf("Expected lint check to be failing, got %v", status.CheckStatus.Checks)
+	}
+}
+
+func TestGetPRForBranch(t *testing.T) {
+	var gotHead, gotState string
+
+	server := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		gotHead = r.URL.Query().Get("head")
+		gotState = r.URL.Query().Get("state")
+
+		if gotHead == "test-owner:feature/login" {
+			mustEncode(w, []PRResponse{{Number: 7, Title: "Login", Head: BranchRef{Ref: "feature/login"}}})
+			return
+		}
+		mustEncode(w, []PRResponse{})
+	})
+	defer server.Close()
+
+	client := createTestClient(server.URL)
+
+	pr, err := client.GetPRForBranch(context.Background(), "feature/login")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if gotHead != "test-owner:feature/login" || gotState != "open" {
+		t.Errorf("Expected head=test-owner:feature/login state=open, got head=%s state=%s", gotHead, gotState)
+	}
+	if pr == nil || pr.Number != 7 {
+		t.Fatalf("Expected PR #7, got %+v", pr)
+	}
+
+	pr, err = client.GetPRForBranch(context.Background(), "no-pr")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if pr != nil {
+		t.Errorf("Expected nil PR for branch without PR, got %+v", pr)
+	}
+}
+
+func TestGetPRBody(t *testing.T) {
+	server := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/pulls/9") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		mustEncode(w, PRResponse{Number: 9, Body: "## Summary\n\nDetails"})
+	})
+	defer server.Close()
+
+	client := createTestClient(server.URL)
+
+	body, err := client.GetPRBody(context.Background(), 9)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if body != "## Summary\n\nDetails" {
+		t.Errorf("Unexpected body: %q", body)
+	}
+
+	_, err = client.GetPRBody(context.Background(), 10)
+	if err == nil {
+		t.Fatal("Expected error for missing PR")
+	}
+	if httpErr := utils.GetHTTPError(err); httpErr == nil || httpErr.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected 404 HTTPError, got %v", err)
+	}
+}
+
+func TestUpdatePR_Base(t *testing.T) {
+	var gotMethod string
+	var payload map[string]interface{}
+
+	server := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		mustDecode(r, &payload)
+		mustEncode(w, PRResponse{Number: 9, Base: BranchRef{Ref: "main"}})
+	})
+	defer server.Close()
+
+	client := createTestClient(server.URL)
+
+	base := "main"
+	pr, err := client.UpdatePR(context.Background(), 9, &models.PRUpdateRequest{Base: &base})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if gotMethod != "PATCH" {
+		t.Errorf("Expected PATCH, got %s", gotMethod)
+	}
+	if len(payload) != 1 || payload["base"] != "main" {
+		t.Errorf("Expected only base in the payload, got %v", payload)
+	}
+	if pr.Base.Ref != "main" {
+		t.Errorf("Expected base main, got %q", pr.Base.Ref)
+	}
+}
+
+func TestCreatePR_WithMetadata(t *testing.T) {
+	requests := make(map[string]map[string]interface{})
+	server := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]interface{}
+		mustDecode(r, &payload)
+		requests[r.Method+" "+r.URL.Path] = payload
+
+		if strings.HasSuffix(r.URL.Path, "/pulls") {
+			w.WriteHeader(http.StatusCreated)
+			mustEncode(w, PRResponse{Number: 12, HTMLURL: "https://github.com/test-owner/test-repo/pull/12"})
+			return
+		}
+		mustEncode(w, map[string]interface{}{})
+	})
+	defer server.Close()
+
+	client := createTestClient(server.URL)
+
+	pr, err := client.CreatePR(context.Background(), &models.PRCreateRequest{
+		Title: "Add feature",
+		Head:  "feature",
+		Base:  "main",
+		PRMetadata: models.PRMetadata{
+			Reviewers:     []string{"alice"},
+			TeamReviewers: []string{"test-owner/backend"},
+			Labels:        []string{"enhancement"},
+			Assignees:     []string{"bob"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if pr.Number != 12 {
+		t.Errorf("Expected PR #12, got #%d", pr.Number)
+	}
+
+	reviewers := requests["POST /repos/test-owner/test-repo/pulls/12/requested_reviewers"]
+	if !reflect.DeepEqual(reviewers["reviewers"], []interface{}{"alice"}) ||
+		!reflect.DeepEqual(reviewers["team_reviewers"], []interface{}{"backend"}) {
+		t.Errorf("Unexpected requested reviewers payload: %v", reviewers)
+	}
+	if labels := requests["POST /repos/test-owner/test-repo/issues/12/labels"]; !reflect.DeepEqual(labels["labels"], []interface{}{"enhancement"}) {
+		t.Errorf("Unexpected labels payload: %v", labels)
+	}
+	if assignees := requests["POST /repos/test-owner/test-repo/issues/12/assignees"]; !reflect.DeepEqual(assignees["assignees"], []interface{}{"bob"}) {
+		t.Errorf("Unexpected assignees payload: %v", assignees)
+	}
+}
+
+func TestAddPRMetadata_OnlyLabels(t *testing.T) {
+	var paths []string
+	server := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		mustEncode(w, []map[string]interface{}{{"name": "bug"}})
+	})
+	defer server.Close()
+
+	client := createTestClient(server.URL)
+
+	if err := client.AddPRMetadata(context.Background(), 7, &models.PRMetadata{Labels: []string{"bug"}}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(paths, []string{"/repos/test-owner/test-repo/issues/7/labels"}) {
+		t.Errorf("Expected only the labels request, got %v", paths)
+	}
+}
@@ -0,0 +1,153 @@
+package github
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// CodeownersPaths are the locations GitHub reads a CODEOWNERS file from, in
+// order of precedence
+var CodeownersPaths = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+// Codeowners holds the rules of a CODEOWNERS file
+type Codeowners struct {
+	rules []codeownersRule
+}
+
+// codeownersRule is a file pattern and the owners of the files it matches
+type codeownersRule struct {
+	pattern *regexp.Regexp
+	owners  []string // Usernames and org/team slugs, without the leading @
+}
+
+// LoadCodeowners reads the CODEOWNERS file of the repository at root. It
+// returns nil when the repository has none.
+func LoadCodeowners(root string) (*Codeowners, error) {
+	for _, path := range CodeownersPaths {
+		data, err := os.ReadFile(filepath.Join(root, path))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		return ParseCodeowners(string(data)), nil
+	}
+	return nil, nil
+}
+
+// ParseCodeowners parses the content of a CODEOWNERS file. Owners given as
+// email addresses and lines with invalid patterns are skipped.
+func ParseCodeowners(content string) *Codeowners {
+	c := &Codeowners{}
+
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		pattern, err := compileCodeownersPattern(fields[0])
+		if err != nil {
+			continue
+		}
+
+		rule := codeownersRule{pattern: pattern}
+		for _, owner := range fields[1:] {
+			if strings.HasPrefix(owner, "#") {
+				break
+			}
+			if strings.HasPrefix(owner, "@") {
+				rule.owners = append(rule.owners, strings.TrimPrefix(owner, "@"))
+			}
+		}
+		c.rules = append(c.rules, rule)
+	}
+
+	return c
+}
+
+// Owners returns the owners of a file. The last rule matching the file wins,
+// as on GitHub.
+func (c *Codeowners) Owners(file string) []string {
+	for i := len(c.rules) - 1; i >= 0; i-- {
+		if c.rules[i].pattern.MatchString(file) {
+			return c.rules[i].owners
+		}
+	}
+	return nil
+}
+
+// SuggestReviewers returns the owners of the given files, split into users
+// and org/team slugs, leaving out author
+func (c *Codeowners) SuggestReviewers(files []string, author string) (users, teams []string) {
+	for _, file := range files {
+		for _, owner := range c.Owners(file) {
+			switch {
+			case strings.Contains(owner, "/"):
+				if !slices.Contains(teams, owner) {
+					teams = append(teams, owner)
+				}
+			case strings.EqualFold(owner, author):
+			case !slices.ContainsFunc(users, func(user string) bool { return strings.EqualFold(user, owner) }):
+				users = append(users, owner)
+			}
+		}
+	}
+	return users, teams
+}
+
+// compileCodeownersPattern converts a gitignore-style CODEOWNERS pattern to a
+// regular expression matching file paths relative to the repository root.
+// Patterns with a slash at the start or in the middle are anchored to the
+// root, and patterns match the files inside the directories they match,
+// except for patterns ending in "/*", which only match direct children.
+func compileCodeownersPattern(pattern string) (*regexp.Regexp, error) {
+	dirOnly := strings.HasSuffix(pattern, "/")
+	pattern = strings.TrimSuffix(pattern, "/")
+	anchored := strings.Contains(pattern, "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+
+	var re strings.Builder
+	re.WriteString("^")
+	if !anchored {
+		re.WriteString("(?:.*/)?")
+	}
+
+	for i := 0; i < len(pattern); i++ {
+		switch {
+		case strings.HasPrefix(pattern[i:], "**/"):
+			re.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(pattern[i:], "**"):
+			re.WriteString(".*")
+			i++
+		case pattern[i] == '*':
+			re.WriteString("[^/]*")
+		case pattern[i] == '?':
+			re.WriteString("[^/]")
+		case pattern[i] == '\\' && i+1 < len(pattern):
+			i++
+			fallthrough
+		default:
+			_, size := utf8.DecodeRuneInString(pattern[i:])
+			re.WriteString(regexp.QuoteMeta(pattern[i : i+size]))
+			i += size - 1
+		}
+	}
+
+	switch {
+	case dirOnly:
+		re.WriteString("/.*")
+	case !strings.HasSuffix(pattern, "/*"):
+		re.WriteString("(?:/.*)?")
+	}
+	re.WriteString("$")
+
+	return regexp.Compile(re.String())
+}
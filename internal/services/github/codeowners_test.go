@@ -0,0 +1,92 @@
+package github
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestCodeownersOwners(t *testing.T) {
+	codeowners := ParseCodeowners(`# Default owners
+*                   @acme/core
+
+*.js                @js-owner # JavaScript files
+/build/logs/        @build-owner
+docs/*              docs@example.com @docs-owner
+apps/               @apps-owner
+/scripts/**/*.sh    @ops
+**/fixtures         @qa
+/vendor/            
+`)
+
+	tests := []struct {
+		file string
+		want []string
+	}{
+		{"README.md", []string{"acme/core"}},
+		{"web/app.js", []string{"js-owner"}},
+		{"build/logs/today.log", []string{"build-owner"}},
+		{"src/build/logs/today.log", []string{"acme/core"}},
+		{"docs/getting-started.md", []string{"docs-owner"}},
+		{"docs/build-app/troubleshooting.md", []string{"acme/core"}},
+		{"apps/web/main.go", []string{"apps-owner"}},
+		{"services/apps/main.go", []string{"apps-owner"}},
+		{"scripts/deploy.sh", []string{"ops"}},
+		{"scripts/ci/release/tag.sh", []string{"ops"}},
+		{"internal/fixtures/data.json", []string{"qa"}},
+		{"vendor/lib/lib.go", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			if got := codeowners.Owners(tt.file); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected owners %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCodeownersSuggestReviewers(t *testing.T) {
+	codeowners := ParseCodeowners(`
+*.go        @alice @Bob @acme/backend
+/web/       @carol @acme/frontend
+go.mod      @alice @dave
+`)
+
+	users, teams := codeowners.SuggestReviewers([]string{"main.go", "web/index.ts", "go.mod", "LICENSE"}, "bob")
+
+	if expected := []string{"alice", "carol", "dave"}; !reflect.DeepEqual(users, expected) {
+		t.Errorf("Expected users %v, got %v", expected, users)
+	}
+	if expected := []string{"acme/backend", "acme/frontend"}; !reflect.DeepEqual(teams, expected) {
+		t.Errorf("Expected teams %v, got %v", expected, teams)
+	}
+}
+
+func TestLoadCodeowners(t *testing.T) {
+	root := t.TempDir()
+
+	codeowners, err := LoadCodeowners(root)
+	if err != nil || codeowners != nil {
+		t.Fatalf("Expected no CODEOWNERS, got %v, %v", codeowners, err)
+	}
+
+	if err := os.MkdirAll(filepath.Join(root, ".github"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "CODEOWNERS"), []byte("* @root-owner\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, ".github", "CODEOWNERS"), []byte("* @github-owner\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	codeowners, err = LoadCodeowners(root)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got := codeowners.Owners("main.go"); !reflect.DeepEqual(got, []string{"github-owner"}) {
+		t.Errorf("Expected .github/CODEOWNERS to take precedence, got %v", got)
+	}
+}
@@ -0,0 +1,159 @@
+package github
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rithyhuot/vibe/internal/models"
+)
+
+// Diff sides of review comments
+const (
+	DiffSideLeft  = "LEFT"  // The base version: deleted lines
+	DiffSideRight = "RIGHT" // The head version: added and unchanged lines
+)
+
+// DiffFile is a file in a unified diff
+type DiffFile struct {
+	Path    string // Path in the head version, or the old path for deleted files
+	OldPath string // Path in the base version, or empty for added files
+	Binary  bool
+	Hunks   []DiffHunk
+}
+
+// DiffHunk is a hunk of a file's diff
+type DiffHunk struct {
+	Header string // The @@ line
+	Lines  []DiffLine
+}
+
+// DiffLine is a line of a hunk
+type DiffLine struct {
+	Kind    byte // '+', '-', or ' '
+	Content string
+	OldLine int // Line in the base version, 0 for added lines
+	NewLine int // Line in the head version, 0 for deleted lines
+}
+
+// ParseDiff splits a unified diff, as produced by git diff, into files
+func ParseDiff(diff string) []*DiffFile {
+	var files []*DiffFile
+	var file *DiffFile
+	var hunk *DiffHunk
+	oldLine, newLine := 0, 0
+
+	for _, line := range strings.Split(diff, "\n") {
+		switch {
+		case strings.HasPrefix(line, "diff --git "):
+			file = &DiffFile{}
+			if _, after, ok := strings.Cut(line, " b/"); ok {
+				file.Path = after
+			}
+			files = append(files, file)
+			hunk = nil
+
+		case file == nil:
+
+		case hunk == nil && strings.HasPrefix(line, "--- "):
+			file.OldPath = diffPath(strings.TrimPrefix(line, "--- "), "a/")
+
+		case hunk == nil && strings.HasPrefix(line, "+++ "):
+			if path := diffPath(strings.TrimPrefix(line, "+++ "), "b/"); path != "" {
+				file.Path = path
+			} else {
+				file.Path = file.OldPath
+			}
+
+		case hunk == nil && strings.HasPrefix(line, "rename from "):
+			file.OldPath = strings.TrimPrefix(line, "rename from ")
+
+		case hunk == nil && strings.HasPrefix(line, "rename to "):
+			file.Path = strings.TrimPrefix(line, "rename to ")
+
+		case strings.HasPrefix(line, "Binary files "):
+			file.Binary = true
+
+		case strings.HasPrefix(line, "@@ "):
+			// @@ -oldStart[,oldCount] +newStart[,newCount] @@ section
+			_, _ = fmt.Sscanf(line[strings.Index(line, "-")+1:], "%d", &oldLine)
+			_, _ = fmt.Sscanf(line[strings.Index(line, "+")+1:], "%d", &newLine)
+			file.Hunks = append(file.Hunks, DiffHunk{Header: line})
+			hunk = &file.Hunks[len(file.Hunks)-1]
+
+		case hunk == nil || line == "":
+
+		case line[0] == '+':
+			hunk.Lines = append(hunk.Lines, DiffLine{Kind: '+', Content: line[1:], NewLine: newLine})
+			newLine++
+
+		case line[0] == '-':
+			hunk.Lines = append(hunk.Lines, DiffLine{Kind: '-', Content: line[1:], OldLine: oldLine})
+			oldLine++
+
+		case line[0] == ' ':
+			hunk.Lines = append(hunk.Lines, DiffLine{Kind: ' ', Content: line[1:], OldLine: oldLine, NewLine: newLine})
+			oldLine++
+			newLine++
+		}
+	}
+
+	return files
+}
+
+// diffPath returns the path of a ---/+++ line without its a/ or b/ prefix,
+// or empty for /dev/null
+func diffPath(path, prefix string) string {
+	path, _, _ = strings.Cut(path, "\t")
+	if path == "/dev/null" {
+		return ""
+	}
+	return strings.TrimPrefix(path, prefix)
+}
+
+// Stats returns the number of added and deleted lines
+func (f *DiffFile) Stats() (additions, deletions int) {
+	for _, hunk := range f.Hunks {
+		for _, line := range hunk.Lines {
+			switch line.Kind {
+			case '+':
+				additions++
+			case '-':
+				deletions++
+			}
+		}
+	}
+	return additions, deletions
+}
+
+// CanComment reports whether a review comment can be placed on the file's
+// diff. GitHub only takes comments on lines in the diff, and multi-line
+// comments must start and end in the same hunk.
+func (f *DiffFile) CanComment(comment *models.DraftReviewComment) bool {
+	if comment.Path != f.Path || comment.Line == 0 {
+		return false
+	}
+
+	hunk := f.hunkOf(comment.Line, comment.Side)
+	if hunk < 0 {
+		return false
+	}
+	if comment.StartLine == 0 {
+		return true
+	}
+	return comment.StartLine < comment.Line && f.hunkOf(comment.StartLine, comment.Side) == hunk
+}
+
+// hunkOf returns the index of the hunk with a line on the given side, or -1
+func (f *DiffFile) hunkOf(line int, side string) int {
+	for i, hunk := range f.Hunks {
+		for _, diffLine := range hunk.Lines {
+			if side == DiffSideLeft && diffLine.Kind != '+' && diffLine.OldLine == line {
+				return i
+			}
+			if side != DiffSideLeft && diffLine.Kind != '-' && diffLine.NewLine == line {
+				return i
+			}
+		}
+	}
+	return -1
+}
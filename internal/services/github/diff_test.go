@@ -0,0 +1,105 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/rithyhuot/vibe/internal/models"
+)
+
+const testDiff = `diff --git a/main.go b/main.go
+index 83db48f..bf269f4 100644
+--- a/main.go
++++ b/main.go
+@@ -10,6 +10,7 @@ func main() {
+ 	a := 1
+-	b := 2
++	b := 3
++	c := 4
+ 	fmt.Println(a)
+ 	fmt.Println(b)
+ }
+@@ -40,3 +41,3 @@ func helper() {
+ 	x := 1
+-	y := 2
++	y := 5
+ 	return
+diff --git a/old.go b/new.go
+similarity index 90%
+rename from old.go
+rename to new.go
+diff --git a/gone.txt b/gone.txt
+deleted file mode 100644
+--- a/gone.txt
++++ /dev/null
+@@ -1,2 +0,0 @@
+-one
+-two
+diff --git a/logo.png b/logo.png
+new file mode 100644
+Binary files /dev/null and b/logo.png differ
+`
+
+func TestParseDiff(t *testing.T) {
+	files := ParseDiff(testDiff)
+	if len(files) != 4 {
+		t.Fatalf("Expected 4 files, got %d", len(files))
+	}
+
+	main := files[0]
+	if main.Path != "main.go" || main.OldPath != "main.go" {
+		t.Errorf("Expected main.go, got %q (from %q)", main.Path, main.OldPath)
+	}
+	if len(main.Hunks) != 2 {
+		t.Fatalf("Expected 2 hunks, got %d", len(main.Hunks))
+	}
+	if additions, deletions := main.Stats(); additions != 3 || deletions != 2 {
+		t.Errorf("Expected +3 -2, got +%d -%d", additions, deletions)
+	}
+
+	added := main.Hunks[0].Lines[3]
+	if added.Kind != '+' || added.Content != "\tc := 4" || added.NewLine != 12 || added.OldLine != 0 {
+		t.Errorf("Expected added line 12, got %+v", added)
+	}
+	context := main.Hunks[0].Lines[4]
+	if context.OldLine != 12 || context.NewLine != 13 {
+		t.Errorf("Expected context line 12 -> 13, got %+v", context)
+	}
+
+	if files[1].Path != "new.go" || files[1].OldPath != "old.go" || len(files[1].Hunks) != 0 {
+		t.Errorf("Expected rename of old.go to new.go, got %+v", files[1])
+	}
+	if files[2].Path != "gone.txt" || files[2].OldPath != "gone.txt" {
+		t.Errorf("Expected deleted gone.txt, got %q (from %q)", files[2].Path, files[2].OldPath)
+	}
+	if files[3].Path != "logo.png" || !files[3].Binary {
+		t.Errorf("Expected binary logo.png, got %+v", files[3])
+	}
+}
+
+func TestDiffFile_CanComment(t *testing.T) {
+	main := ParseDiff(testDiff)[0]
+
+	tests := []struct {
+		name    string
+		comment models.DraftReviewComment
+		want    bool
+	}{
+		{"added line", models.DraftReviewComment{Path: "main.go", Line: 12, Side: DiffSideRight}, true},
+		{"context line", models.DraftReviewComment{Path: "main.go", Line: 14, Side: DiffSideRight}, true},
+		{"deleted line", models.DraftReviewComment{Path: "main.go", Line: 11, Side: DiffSideLeft}, true},
+		{"base line outside the diff", models.DraftReviewComment{Path: "main.go", Line: 43, Side: DiffSideLeft}, false},
+		{"line outside the diff", models.DraftReviewComment{Path: "main.go", Line: 30, Side: DiffSideRight}, false},
+		{"range in a hunk", models.DraftReviewComment{Path: "main.go", StartLine: 10, Line: 13, Side: DiffSideRight}, true},
+		{"range across hunks", models.DraftReviewComment{Path: "main.go", StartLine: 12, Line: 42, Side: DiffSideRight}, false},
+		{"other file", models.DraftReviewComment{Path: "other.go", Line: 12, Side: DiffSideRight}, false},
+		{"no line", models.DraftReviewComment{Path: "main.go", Side: DiffSideRight}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := main.CanComment(&tt.comment); got != tt.want {
+				t.Errorf("CanComment() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
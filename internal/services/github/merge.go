@@ -0,0 +1,363 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	neturl "net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/rithyhuot/vibe/internal/utils"
+)
+
+// Merge method constants
+const (
+	MergeMethodMerge  = "merge"
+	MergeMethodSquash = "squash"
+	MergeMethodRebase = "rebase"
+)
+
+// MergeClient defines native pull request merge operations. The GitLab
+// client doesn't implement it.
+type MergeClient interface {
+	// MergePR merges a pull request right away with the given merge method
+	MergePR(ctx context.Context, prNumber int, method string) error
+	// EnableAutoMerge merges a pull request once it meets the requirements of
+	// its base branch, or adds it to the merge queue of a branch that has one
+	EnableAutoMerge(ctx context.Context, prNumber int, method string) (*AutoMergeResult, error)
+	// GetBranchProtection retrieves the merge requirements of a branch
+	GetBranchProtection(ctx context.Context, branch string) (*BranchProtection, error)
+}
+
+// AutoMergeResult describes what EnableAutoMerge did with a pull request
+type AutoMergeResult struct {
+	Merged        bool // It already met the requirements, so it was merged right away
+	Queued        bool // It was added to the merge queue
+	QueuePosition int  // Position in the merge queue, when Queued
+	MergeQueue    bool // The base branch has a merge queue, which it joins once it meets the requirements
+}
+
+// BranchProtection holds the merge requirements of a branch, combined from
+// branch protection and rulesets
+type BranchProtection struct {
+	Protected         bool     // Branch protection or a ruleset applies to the branch
+	RequiredChecks    []string // Check and status names that must pass
+	RequiredApprovals int      // Approving reviews needed
+	ReviewsKnown      bool     // RequiredApprovals includes classic protection, whose review settings only admins can read
+	MergeQueue        bool     // Pull requests are merged through a merge queue
+}
+
+// MinApprovals returns the approving reviews a PR needs at least. When the
+// review settings of classic protection can't be read, one is assumed.
+func (p *BranchProtection) MinApprovals() int {
+	if !p.ReviewsKnown {
+		return max(p.RequiredApprovals, 1)
+	}
+	return p.RequiredApprovals
+}
+
+// ValidMergeMethod reports whether method is a GitHub merge method
+func ValidMergeMethod(method string) bool {
+	switch method {
+	case MergeMethodMerge, MergeMethodSquash, MergeMethodRebase:
+		return true
+	}
+	return false
+}
+
+// MergePR merges a pull request through the REST merge endpoint
+func (c *HTTPClient) MergePR(ctx context.Context, prNumber int, method string) error {
+	url := fmt.Sprintf("%s/repos/%s/%s/pulls/%d/merge", c.baseURL, c.owner, c.repo, prNumber)
+
+	payload := map[string]interface{}{
+		"merge_method": method,
+	}
+
+	var resp struct {
+		SHA     string `json:"sha"`
+		Merged  bool   `json:"merged"`
+		Message string `json:"message"`
+	}
+	err := c.httpClient.DoJSONRequest(ctx, "PUT", url, payload, &resp, c.headers())
+	if err != nil {
+		if reason := mergeRefusal(err); reason != "" {
+			return fmt.Errorf("GitHub refused to merge PR #%d: %s", prNumber, reason)
+		}
+		return fmt.Errorf("failed to merge PR: %w", err)
+	}
+	if !resp.Merged {
+		return fmt.Errorf("failed to merge PR: %s", resp.Message)
+	}
+
+	return nil
+}
+
+// mergeRefusal returns GitHub's reason for refusing a merge, like a branch
+// protection rule the PR doesn't meet, or "" for other errors
+func mergeRefusal(err error) string {
+	httpErr := utils.GetHTTPError(err)
+	if httpErr == nil {
+		return ""
+	}
+	switch httpErr.StatusCode {
+	case http.StatusMethodNotAllowed, http.StatusConflict, http.StatusUnprocessableEntity:
+	default:
+		return ""
+	}
+
+	var body struct {
+		Message string `json:"message"`
+	}
+	if json.Unmarshal([]byte(httpErr.Body), &body) != nil {
+		return ""
+	}
+	return strings.TrimSpace(body.Message)
+}
+
+// EnableAutoMerge enables auto-merge, or adds the pull request to the merge
+// queue of its base branch
+func (c *HTTPClient) EnableAutoMerge(ctx context.Context, prNumber int, method string) (*AutoMergeResult, error) {
+	return enableAutoMerge(ctx, c.owner, c.repo, prNumber, method, c.executeGraphQL, c.MergePR)
+}
+
+// GetBranchProtection retrieves the required checks, required approvals and
+// merge queue of a branch
+func (c *HTTPClient) GetBranchProtection(ctx context.Context, branch string) (*BranchProtection, error) {
+	return getBranchProtection(ctx, c.owner, c.repo, branch, func(ctx context.Context, path string, result interface{}) error {
+		return c.httpClient.DoJSONRequest(ctx, "GET", c.baseURL+"/"+path, nil, result, c.headers())
+	})
+}
+
+// MergePR merges a pull request using gh CLI
+func (c *CLIClient) MergePR(ctx context.Context, prNumber int, method string) error {
+	_, err := c.runGH(ctx, "pr", "merge", strconv.Itoa(prNumber), "--"+method)
+	if err != nil {
+		return fmt.Errorf("failed to merge PR: %w", err)
+	}
+	return nil
+}
+
+// EnableAutoMerge enables auto-merge, or adds the pull request to the merge
+// queue of its base branch, using gh CLI
+func (c *CLIClient) EnableAutoMerge(ctx context.Context, prNumber int, method string) (*AutoMergeResult, error) {
+	return enableAutoMerge(ctx, c.owner, c.repo, prNumber, method, c.executeGraphQL, c.MergePR)
+}
+
+// GetBranchProtection retrieves the merge requirements of a branch using gh CLI
+func (c *CLIClient) GetBranchProtection(ctx context.Context, branch string) (*BranchProtection, error) {
+	return getBranchProtection(ctx, c.owner, c.repo, branch, func(ctx context.Context, path string, result interface{}) error {
+		output, err := c.runGHAPI(ctx, path)
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal([]byte(output), result)
+	})
+}
+
+// executeGraphQL executes a GraphQL query with gh api graphql
+func (c *CLIClient) executeGraphQL(ctx context.Context, query string, variables map[string]interface{}, result interface{}) error {
+	args := []string{"graphql", "-f", "query=" + query}
+	for name, value := range variables {
+		if s, ok := value.(string); ok {
+			args = append(args, "-f", name+"="+s)
+		} else {
+			args = append(args, "-F", fmt.Sprintf("%s=%v", name, value))
+		}
+	}
+
+	output, err := c.runGHAPI(ctx, args...)
+	if err != nil {
+		return fmt.Errorf("GraphQL request failed: %w", err)
+	}
+
+	var resp graphQLResponse
+	if err := json.Unmarshal([]byte(output), &resp); err != nil {
+		return fmt.Errorf("failed to parse GraphQL response: %w", err)
+	}
+	if result != nil && len(resp.Data) > 0 {
+		if err := json.Unmarshal(resp.Data, result); err != nil {
+			return fmt.Errorf("failed to unmarshal GraphQL response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// graphQLExecutor runs a GraphQL query and decodes its data into result
+type graphQLExecutor func(ctx context.Context, query string, variables map[string]interface{}, result interface{}) error
+
+// restGetter fetches a REST API path, relative to the API root, into result
+type restGetter func(ctx context.Context, path string, result interface{}) error
+
+// pullRequestMergeInfo is the merge state of a pull request
+type pullRequestMergeInfo struct {
+	ID                  string `json:"id"`
+	MergeStateStatus    string `json:"mergeStateStatus"` // CLEAN when it meets the requirements of its base branch
+	IsMergeQueueEnabled bool   `json:"isMergeQueueEnabled"`
+	IsInMergeQueue      bool   `json:"isInMergeQueue"`
+}
+
+// enableAutoMerge enqueues a pull request on branches with a merge queue and
+// enables auto-merge elsewhere. GitHub rejects auto-merge for pull requests
+// that already meet the requirements, so those are merged right away.
+func enableAutoMerge(ctx context.Context, owner, repo string, prNumber int, method string, graphql graphQLExecutor, merge func(context.Context, int, string) error) (*AutoMergeResult, error) {
+	query := `
+		query PullRequestMergeInfo($owner: String!, $name: String!, $number: Int!) {
+			repository(owner: $owner, name: $name) {
+				pullRequest(number: $number) {
+					id
+					mergeStateStatus
+					isMergeQueueEnabled
+					isInMergeQueue
+				}
+			}
+		}
+	`
+	var info struct {
+		Repository struct {
+			PullRequest *pullRequestMergeInfo `json:"pullRequest"`
+		} `json:"repository"`
+	}
+	variables := map[string]interface{}{"owner": owner, "name": repo, "number": prNumber}
+	if err := graphql(ctx, query, variables, &info); err != nil {
+		return nil, fmt.Errorf("failed to get merge state: %w", err)
+	}
+	pr := info.Repository.PullRequest
+	if pr == nil {
+		return nil, fmt.Errorf("failed to get merge state: PR #%d not found", prNumber)
+	}
+
+	ready := pr.MergeStateStatus == "CLEAN"
+
+	switch {
+	case pr.IsInMergeQueue:
+		return &AutoMergeResult{Queued: true, MergeQueue: true}, nil
+
+	case pr.IsMergeQueueEnabled && ready:
+		mutation := `
+			mutation EnqueuePullRequest($pullRequestId: ID!) {
+				enqueuePullRequest(input: {pullRequestId: $pullRequestId}) {
+					mergeQueueEntry {
+						position
+					}
+				}
+			}
+		`
+		var resp struct {
+			EnqueuePullRequest struct {
+				MergeQueueEntry struct {
+					Position int `json:"position"`
+				} `json:"mergeQueueEntry"`
+			} `json:"enqueuePullRequest"`
+		}
+		if err := graphql(ctx, mutation, map[string]interface{}{"pullRequestId": pr.ID}, &resp); err != nil {
+			return nil, fmt.Errorf("failed to add PR to the merge queue: %w", err)
+		}
+		return &AutoMergeResult{
+			Queued:        true,
+			QueuePosition: resp.EnqueuePullRequest.MergeQueueEntry.Position,
+			MergeQueue:    true,
+		}, nil
+
+	case ready:
+		if err := merge(ctx, prNumber, method); err != nil {
+			return nil, err
+		}
+		return &AutoMergeResult{Merged: true}, nil
+	}
+
+	// The merge queue decides the merge method of branches that have one
+	mutation := `
+		mutation EnableAutoMerge($pullRequestId: ID!, $mergeMethod: PullRequestMergeMethod) {
+			enablePullRequestAutoMerge(input: {pullRequestId: $pullRequestId, mergeMethod: $mergeMethod}) {
+				clientMutationId
+			}
+		}
+	`
+	variables = map[string]interface{}{"pullRequestId": pr.ID}
+	if !pr.IsMergeQueueEnabled {
+		variables["mergeMethod"] = strings.ToUpper(method)
+	}
+	if err := graphql(ctx, mutation, variables, nil); err != nil {
+		return nil, fmt.Errorf("failed to enable auto-merge: %w", err)
+	}
+
+	return &AutoMergeResult{MergeQueue: pr.IsMergeQueueEnabled}, nil
+}
+
+// getBranchProtection combines the classic branch protection and the rulesets
+// of a branch. Required review counts of classic protection are only visible
+// to admins, so ReviewsKnown is false when they can't be read. Older GitHub
+// Enterprise servers have no rulesets, so those are best-effort.
+func getBranchProtection(ctx context.Context, owner, repo, branch string, get restGetter) (*BranchProtection, error) {
+	branchPath := fmt.Sprintf("repos/%s/%s/branches/%s", owner, repo, escapeBranch(branch))
+
+	var branchResp BranchResponse
+	if err := get(ctx, branchPath, &branchResp); err != nil {
+		return nil, fmt.Errorf("failed to get branch %s: %w", branch, err)
+	}
+
+	// Without classic protection, only rulesets can require reviews
+	protection := &BranchProtection{Protected: branchResp.Protected, ReviewsKnown: !branchResp.Protected}
+	if checks := branchResp.Protection.RequiredStatusChecks; checks != nil {
+		protection.addRequiredChecks(checks.Contexts...)
+		for _, check := range checks.Checks {
+			protection.addRequiredChecks(check.Context)
+		}
+	}
+
+	if branchResp.Protected {
+		var reviews RequiredReviewsResponse
+		if err := get(ctx, branchPath+"/protection/required_pull_request_reviews", &reviews); err == nil {
+			protection.RequiredApprovals = reviews.RequiredApprovingReviewCount
+			protection.ReviewsKnown = true
+		}
+	}
+
+	var rules []BranchRuleResponse
+	if err := get(ctx, fmt.Sprintf("repos/%s/%s/rules/branches/%s", owner, repo, escapeBranch(branch)), &rules); err == nil {
+		protection.addRules(rules)
+	}
+
+	return protection, nil
+}
+
+// addRules adds the requirements of the ruleset rules that apply to a branch
+func (p *BranchProtection) addRules(rules []BranchRuleResponse) {
+	for _, rule := range rules {
+		switch rule.Type {
+		case "pull_request":
+			p.Protected = true
+			p.RequiredApprovals = max(p.RequiredApprovals, rule.Parameters.RequiredApprovingReviewCount)
+		case "required_status_checks":
+			p.Protected = true
+			for _, check := range rule.Parameters.RequiredStatusChecks {
+				p.addRequiredChecks(check.Context)
+			}
+		case "merge_queue":
+			p.Protected = true
+			p.MergeQueue = true
+		}
+	}
+}
+
+// addRequiredChecks adds check names that aren't required yet
+func (p *BranchProtection) addRequiredChecks(names ...string) {
+	for _, name := range names {
+		if !slices.Contains(p.RequiredChecks, name) {
+			p.RequiredChecks = append(p.RequiredChecks, name)
+		}
+	}
+}
+
+// escapeBranch escapes each segment of a branch name for use in a URL path
+func escapeBranch(branch string) string {
+	segments := strings.Split(branch, "/")
+	for i, segment := range segments {
+		segments[i] = neturl.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
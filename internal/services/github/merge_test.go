@@ -0,0 +1,292 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMergePR(t *testing.T) {
+	var gotMethod, gotPath string
+	var payload map[string]interface{}
+
+	server := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod, gotPath = r.Method, r.URL.Path
+		mustDecode(r, &payload)
+		mustEncode(w, map[string]interface{}{"sha": "abc123", "merged": true, "message": "Pull Request successfully merged"})
+	})
+	defer server.Close()
+
+	client := createTestClient(server.URL)
+
+	if err := client.MergePR(context.Background(), 42, MergeMethodSquash); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if gotMethod != "PUT" || gotPath != "/repos/test-owner/test-repo/pulls/42/merge" {
+		t.Errorf("Expected PUT /repos/test-owner/test-repo/pulls/42/merge, got %s %s", gotMethod, gotPath)
+	}
+	if payload["merge_method"] != "squash" {
+		t.Errorf("Expected merge_method squash, got %v", payload["merge_method"])
+	}
+}
+
+func TestMergePR_NotMergeable(t *testing.T) {
+	server := setupTestServer(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		mustEncode(w, map[string]string{"message": "Required status check \"test\" is expected."})
+	})
+	defer server.Close()
+
+	client := createTestClient(server.URL)
+
+	err := client.MergePR(context.Background(), 42, MergeMethodMerge)
+	expected := `GitHub refused to merge PR #42: Required status check "test" is expected.`
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected %q, got %v", expected, err)
+	}
+}
+
+// autoMergeServer serves the merge state of PR #42 and records the GraphQL
+// mutations and REST merges made
+func autoMergeServer(t *testing.T, mergeInfo string, mutations *[]graphQLRequest, merged *bool) *HTTPClient {
+	t.Helper()
+
+	server := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/pulls/42/merge") {
+			*merged = true
+			mustEncode(w, map[string]interface{}{"merged": true})
+			return
+		}
+
+		var req graphQLRequest
+		mustDecode(r, &req)
+		switch {
+		case strings.Contains(req.Query, "PullRequestMergeInfo"):
+			mustEncode(w, graphQLResponse{Data: json.RawMessage(`{"repository": {"pullRequest": ` + mergeInfo + `}}`)})
+		case strings.Contains(req.Query, "enqueuePullRequest"):
+			*mutations = append(*mutations, req)
+			mustEncode(w, graphQLResponse{Data: json.RawMessage(`{"enqueuePullRequest": {"mergeQueueEntry": {"position": 3}}}`)})
+		default:
+			*mutations = append(*mutations, req)
+			mustEncode(w, graphQLResponse{Data: json.RawMessage(`{"enablePullRequestAutoMerge": {"clientMutationId": null}}`)})
+		}
+	})
+	t.Cleanup(server.Close)
+
+	return createTestClient(server.URL)
+}
+
+func TestEnableAutoMerge(t *testing.T) {
+	tests := []struct {
+		name         string
+		mergeInfo    string
+		wantResult   AutoMergeResult
+		wantMutation string
+		wantMerged   bool
+		wantVars     map[string]interface{}
+	}{
+		{
+			name:         "blocked PR enables auto-merge",
+			mergeInfo:    `{"id": "PR_1", "mergeStateStatus": "BLOCKED", "isMergeQueueEnabled": false}`,
+			wantResult:   AutoMergeResult{},
+			wantMutation: "enablePullRequestAutoMerge",
+			wantVars:     map[string]interface{}{"pullRequestId": "PR_1", "mergeMethod": "SQUASH"},
+		},
+		{
+			name:       "clean PR is merged right away",
+			mergeInfo:  `{"id": "PR_1", "mergeStateStatus": "CLEAN", "isMergeQueueEnabled": false}`,
+			wantResult: AutoMergeResult{Merged: true},
+			wantMerged: true,
+		},
+		{
+			name:         "clean PR joins the merge queue",
+			mergeInfo:    `{"id": "PR_1", "mergeStateStatus": "CLEAN", "isMergeQueueEnabled": true}`,
+			wantResult:   AutoMergeResult{Queued: true, QueuePosition: 3, MergeQueue: true},
+			wantMutation: "enqueuePullRequest",
+			wantVars:     map[string]interface{}{"pullRequestId": "PR_1"},
+		},
+		{
+			name:         "blocked PR joins the merge queue when ready",
+			mergeInfo:    `{"id": "PR_1", "mergeStateStatus": "BLOCKED", "isMergeQueueEnabled": true}`,
+			wantResult:   AutoMergeResult{MergeQueue: true},
+			wantMutation: "enablePullRequestAutoMerge",
+			wantVars:     map[string]interface{}{"pullRequestId": "PR_1"},
+		},
+		{
+			name:       "PR already in the merge queue",
+			mergeInfo:  `{"id": "PR_1", "mergeStateStatus": "CLEAN", "isMergeQueueEnabled": true, "isInMergeQueue": true}`,
+			wantResult: AutoMergeResult{Queued: true, MergeQueue: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mutations []graphQLRequest
+			merged := false
+			client := autoMergeServer(t, tt.mergeInfo, &mutations, &merged)
+
+			result, err := client.EnableAutoMerge(context.Background(), 42, MergeMethodSquash)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if *result != tt.wantResult {
+				t.Errorf("Expected result %+v, got %+v", tt.wantResult, *result)
+			}
+			if merged != tt.wantMerged {
+				t.Errorf("Expected merged=%v, got %v", tt.wantMerged, merged)
+			}
+
+			if tt.wantMutation == "" {
+				if len(mutations) != 0 {
+					t.Errorf("Expected no mutations, got %d", len(mutations))
+				}
+				return
+			}
+			if len(mutations) != 1 || !strings.Contains(mutations[0].Query, tt.wantMutation) {
+				t.Fatalf("Expected one %s mutation, got %v", tt.wantMutation, mutations)
+			}
+			if !reflect.DeepEqual(mutations[0].Variables, tt.wantVars) {
+				t.Errorf("Expected variables %v, got %v", tt.wantVars, mutations[0].Variables)
+			}
+		})
+	}
+}
+
+func TestGetBranchProtection(t *testing.T) {
+	server := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/test-owner/test-repo/branches/release/2.0":
+			mustEncode(w, map[string]interface{}{
+				"name":      "release/2.0",
+				"protected": true,
+				"protection": map[string]interface{}{
+					"required_status_checks": map[string]interface{}{
+						"contexts": []string{"ci/circleci: test"},
+						"checks":   []map[string]interface{}{{"context": "ci/circleci: test"}, {"context": "lint"}},
+					},
+				},
+			})
+		case "/repos/test-owner/test-repo/branches/release/2.0/protection/required_pull_request_reviews":
+			// Only admins can read review settings
+			w.WriteHeader(http.StatusNotFound)
+		case "/repos/test-owner/test-repo/rules/branches/release/2.0":
+			mustEncode(w, []map[string]interface{}{
+				{"type": "pull_request", "parameters": map[string]interface{}{"required_approving_review_count": 2}},
+				{"type": "required_status_checks", "parameters": map[string]interface{}{
+					"required_status_checks": []map[string]interface{}{{"context": "lint"}, {"context": "e2e"}},
+				}},
+				{"type": "merge_queue", "parameters": map[string]interface{}{}},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+
+	client := createTestClient(server.URL)
+
+	protection, err := client.GetBranchProtection(context.Background(), "release/2.0")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := &BranchProtection{
+		Protected:         true,
+		RequiredChecks:    []string{"ci/circleci: test", "lint", "e2e"},
+		RequiredApprovals: 2,
+		ReviewsKnown:      false,
+		MergeQueue:        true,
+	}
+	if !reflect.DeepEqual(protection, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, protection)
+	}
+}
+
+func TestGetBranchProtection_Unprotected(t *testing.T) {
+	server := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/test-owner/test-repo/branches/main":
+			mustEncode(w, map[string]interface{}{"name": "main", "protected": false})
+		case "/repos/test-owner/test-repo/rules/branches/main":
+			mustEncode(w, []map[string]interface{}{})
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+
+	client := createTestClient(server.URL)
+
+	protection, err := client.GetBranchProtection(context.Background(), "main")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if protection.Protected || len(protection.RequiredChecks) != 0 || protection.RequiredApprovals != 0 {
+		t.Errorf("Expected no requirements, got %+v", protection)
+	}
+	if !protection.ReviewsKnown || protection.MinApprovals() != 0 {
+		t.Errorf("Expected no required approvals, got %+v", protection)
+	}
+}
+
+func TestGetBranchProtection_ReviewsNotReadable(t *testing.T) {
+	server := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/test-owner/test-repo/branches/main":
+			mustEncode(w, map[string]interface{}{"name": "main", "protected": true})
+		case "/repos/test-owner/test-repo/branches/main/protection/required_pull_request_reviews":
+			// Only admins can read review settings
+			w.WriteHeader(http.StatusNotFound)
+		case "/repos/test-owner/test-repo/rules/branches/main":
+			mustEncode(w, []map[string]interface{}{})
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+
+	client := createTestClient(server.URL)
+
+	protection, err := client.GetBranchProtection(context.Background(), "main")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if protection.ReviewsKnown {
+		t.Errorf("Expected required approvals to be unknown, got %+v", protection)
+	}
+	if protection.MinApprovals() != 1 {
+		t.Errorf("Expected at least 1 approval, got %d", protection.MinApprovals())
+	}
+}
+
+func TestGetBranchProtection_ReviewsReadable(t *testing.T) {
+	server := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/test-owner/test-repo/branches/main":
+			mustEncode(w, map[string]interface{}{"name": "main", "protected": true})
+		case "/repos/test-owner/test-repo/branches/main/protection/required_pull_request_reviews":
+			mustEncode(w, map[string]interface{}{"required_approving_review_count": 0})
+		case "/repos/test-owner/test-repo/rules/branches/main":
+			mustEncode(w, []map[string]interface{}{})
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+
+	client := createTestClient(server.URL)
+
+	protection, err := client.GetBranchProtection(context.Background(), "main")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !protection.ReviewsKnown || protection.MinApprovals() != 0 {
+		t.Errorf("Expected no required approvals, got %+v", protection)
+	}
+}
@@ -0,0 +1,179 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/rithyhuot/vibe/internal/models"
+)
+
+// Review event constants
+const (
+	ReviewEventApprove        = "APPROVE"
+	ReviewEventRequestChanges = "REQUEST_CHANGES"
+	ReviewEventComment        = "COMMENT"
+)
+
+// ReviewClient defines pull request review operations. The GitLab client
+// doesn't implement it.
+type ReviewClient interface {
+	// GetPRDiff retrieves the unified diff of a pull request
+	GetPRDiff(ctx context.Context, prNumber int) (string, error)
+	// SubmitReview submits a review with its inline comments at once
+	SubmitReview(ctx context.Context, prNumber int, review *models.PRReview) (*ReviewResponse, error)
+}
+
+// GetPRDiff retrieves the unified diff of a pull request
+func (c *HTTPClient) GetPRDiff(ctx context.Context, prNumber int) (string, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/pulls/%d", c.baseURL, c.owner, c.repo, prNumber)
+
+	headers := c.headers()
+	headers["Accept"] = "application/vnd.github.v3.diff"
+
+	var diff []byte
+	if err := c.httpClient.DoJSONRequest(ctx, "GET", url, nil, &diff, headers); err != nil {
+		return "", fmt.Errorf("failed to get PR diff: %w", err)
+	}
+
+	return string(diff), nil
+}
+
+// SubmitReview submits a review through the pull request reviews endpoint
+func (c *HTTPClient) SubmitReview(ctx context.Context, prNumber int, review *models.PRReview) (*ReviewResponse, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/pulls/%d/reviews", c.baseURL, c.owner, c.repo, prNumber)
+
+	var resp ReviewResponse
+	if err := c.httpClient.DoJSONRequest(ctx, "POST", url, newReviewRequest(review), &resp, c.headers()); err != nil {
+		return nil, fmt.Errorf("failed to submit review: %w", err)
+	}
+
+	return &resp, nil
+}
+
+// GetPRDiff retrieves the unified diff of a pull request using gh CLI
+func (c *CLIClient) GetPRDiff(ctx context.Context, prNumber int) (string, error) {
+	output, err := c.runGH(ctx, "pr", "diff", strconv.Itoa(prNumber))
+	if err != nil {
+		return "", fmt.Errorf("failed to get PR diff: %w", err)
+	}
+	return output, nil
+}
+
+// SubmitReview submits a review through the pull request reviews endpoint
+// using gh api, since gh pr review can't add inline comments
+func (c *CLIClient) SubmitReview(ctx context.Context, prNumber int, review *models.PRReview) (*ReviewResponse, error) {
+	payload, err := json.Marshal(newReviewRequest(review))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode review: %w", err)
+	}
+
+	path := fmt.Sprintf("repos/%s/%s/pulls/%d/reviews", c.owner, c.repo, prNumber)
+	output, err := c.runGHAPIWithStdin(ctx, string(payload), "--method", "POST", path, "--input", "-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to submit review: %w", err)
+	}
+
+	var resp ReviewResponse
+	if err := json.Unmarshal([]byte(output), &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse review response: %w", err)
+	}
+
+	return &resp, nil
+}
+
+// newReviewRequest builds the body of a create review request
+func newReviewRequest(review *models.PRReview) *ReviewRequest {
+	req := &ReviewRequest{
+		Event:    review.Event,
+		Body:     review.Body,
+		CommitID: review.CommitID,
+		Comments: make([]ReviewCommentRequest, len(review.Comments)),
+	}
+
+	for i, comment := range review.Comments {
+		req.Comments[i] = ReviewCommentRequest{
+			Path: comment.Path,
+			Line: comment.Line,
+			Side: comment.Side,
+			Body: comment.Body,
+		}
+		if comment.StartLine > 0 {
+			req.Comments[i].StartLine = comment.StartLine
+			req.Comments[i].StartSide = comment.Side
+		}
+	}
+
+	return req
+}
+
+// reviewFindingPattern matches the location line of a finding in the output
+// of the vibe-code-review skill, like "**File: src/auth/login.go:45-52**"
+var reviewFindingPattern = regexp.MustCompile("^\\*\\*File:\\s*`?([^`*:]+?)`?(?::(\\d+)(?:-(\\d+))?)?\\s*\\*\\*\\s*$")
+
+// ParseReviewFindings turns the findings of the vibe-code-review skill into
+// draft review comments. Each finding starts with a "**File: path:line**" or
+// "**File: path:start-end**" line and runs until the next finding or heading.
+// Its severity heading, like "### 🔴 Critical Issues", leads the comment.
+// Findings without a line have Line 0.
+func ParseReviewFindings(markdown string) []models.DraftReviewComment {
+	var comments []models.DraftReviewComment
+	var current *models.DraftReviewComment
+	var body []string
+	heading := ""
+	inCode := false
+
+	flush := func() {
+		if current == nil {
+			return
+		}
+		text := strings.TrimSpace(strings.Join(body, "\n"))
+		if heading != "" {
+			text = "**" + heading + "**\n\n" + text
+		}
+		current.Body = text
+		comments = append(comments, *current)
+		current, body = nil, nil
+	}
+
+	for _, line := range strings.Split(markdown, "\n") {
+		// Lines of code blocks, like shell comments, are never headings
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCode = !inCode
+		}
+		if inCode {
+			if current != nil {
+				body = append(body, line)
+			}
+			continue
+		}
+
+		if match := reviewFindingPattern.FindStringSubmatch(strings.TrimSpace(line)); match != nil {
+			flush()
+			current = &models.DraftReviewComment{Path: strings.TrimSpace(match[1]), Side: DiffSideRight}
+			start, _ := strconv.Atoi(match[2])
+			end, _ := strconv.Atoi(match[3])
+			current.Line = start
+			if end > start {
+				current.StartLine, current.Line = start, end
+			}
+			continue
+		}
+
+		if strings.HasPrefix(line, "#") {
+			flush()
+			heading = strings.TrimSpace(strings.TrimLeft(line, "#"))
+			continue
+		}
+
+		if current != nil {
+			body = append(body, line)
+		}
+	}
+	flush()
+
+	return comments
+}
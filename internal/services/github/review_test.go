@@ -0,0 +1,132 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/rithyhuot/vibe/internal/models"
+)
+
+func TestGetPRDiff(t *testing.T) {
+	var gotAccept string
+
+	server := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		gotAccept = r.Header.Get("Accept")
+		_, _ = w.Write([]byte(testDiff))
+	})
+	defer server.Close()
+
+	client := createTestClient(server.URL)
+
+	diff, err := client.GetPRDiff(context.Background(), 42)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if gotAccept != "application/vnd.github.v3.diff" {
+		t.Errorf("Expected diff media type, got %q", gotAccept)
+	}
+	if diff != testDiff {
+		t.Errorf("Expected the raw diff, got %q", diff)
+	}
+}
+
+func TestSubmitReview(t *testing.T) {
+	var gotMethod, gotPath string
+	var payload struct {
+		Event    string                   `json:"event"`
+		Body     string                   `json:"body"`
+		CommitID string                   `json:"commit_id"`
+		Comments []map[string]interface{} `json:"comments"`
+	}
+
+	server := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod, gotPath = r.Method, r.URL.Path
+		mustDecode(r, &payload)
+		mustEncode(w, map[string]interface{}{
+			"id":       7,
+			"state":    "CHANGES_REQUESTED",
+			"html_url": "https://github.com/test-owner/test-repo/pull/42#pullrequestreview-7",
+		})
+	})
+	defer server.Close()
+
+	client := createTestClient(server.URL)
+
+	review := &models.PRReview{
+		Event:    ReviewEventRequestChanges,
+		Body:     "A few things",
+		CommitID: "abc123",
+		Comments: []models.DraftReviewComment{
+			{Path: "main.go", Line: 12, Side: DiffSideRight, Body: "Why 4?"},
+			{Path: "main.go", StartLine: 10, Line: 13, Side: DiffSideRight, Body: "Extract this"},
+		},
+	}
+	resp, err := client.SubmitReview(context.Background(), 42, review)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if gotMethod != "POST" || gotPath != "/repos/test-owner/test-repo/pulls/42/reviews" {
+		t.Errorf("Expected POST /repos/test-owner/test-repo/pulls/42/reviews, got %s %s", gotMethod, gotPath)
+	}
+	if payload.Event != "REQUEST_CHANGES" || payload.Body != "A few things" || payload.CommitID != "abc123" {
+		t.Errorf("Unexpected review payload: %+v", payload)
+	}
+	if len(payload.Comments) != 2 {
+		t.Fatalf("Expected 2 comments, got %d", len(payload.Comments))
+	}
+	if _, ok := payload.Comments[0]["start_line"]; ok {
+		t.Errorf("Expected no start_line on a single-line comment, got %v", payload.Comments[0])
+	}
+	if payload.Comments[1]["start_line"] != float64(10) || payload.Comments[1]["start_side"] != "RIGHT" {
+		t.Errorf("Expected start_line 10 on the RIGHT side, got %v", payload.Comments[1])
+	}
+	if resp.HTMLURL != "https://github.com/test-owner/test-repo/pull/42#pullrequestreview-7" {
+		t.Errorf("Expected review URL, got %q", resp.HTMLURL)
+	}
+}
+
+func TestParseReviewFindings(t *testing.T) {
+	markdown := "## Code Review Findings\n\n" +
+		"### 🔴 Critical Issues\n\n" +
+		"**File: src/auth/login.go:45-52**\n" +
+		"- **Issue**: SQL injection vulnerability\n" +
+		"- **Fix**: Use parameterized queries:\n" +
+		"  ```sh\n" +
+		"  # not a heading\n" +
+		"  ```\n\n" +
+		"### 🔵 Suggestions\n\n" +
+		"**File: src/utils/helper.go:78**\n\n" +
+		"- **Issue**: Function is too long\n\n" +
+		"**File: `README.md`**\n" +
+		"- **Issue**: Outdated install steps\n"
+
+	findings := ParseReviewFindings(markdown)
+	if len(findings) != 3 {
+		t.Fatalf("Expected 3 findings, got %d: %+v", len(findings), findings)
+	}
+
+	first := findings[0]
+	if first.Path != "src/auth/login.go" || first.StartLine != 45 || first.Line != 52 || first.Side != DiffSideRight {
+		t.Errorf("Expected src/auth/login.go:45-52, got %+v", first)
+	}
+	if !strings.HasPrefix(first.Body, "**🔴 Critical Issues**\n\n- **Issue**: SQL injection") {
+		t.Errorf("Expected body led by the severity, got %q", first.Body)
+	}
+	if !strings.Contains(first.Body, "# not a heading") {
+		t.Errorf("Expected code block kept in the body, got %q", first.Body)
+	}
+
+	second := findings[1]
+	if second.Path != "src/utils/helper.go" || second.StartLine != 0 || second.Line != 78 {
+		t.Errorf("Expected src/utils/helper.go:78, got %+v", second)
+	}
+	if second.Body != "**🔵 Suggestions**\n\n- **Issue**: Function is too long" {
+		t.Errorf("Unexpected body %q", second.Body)
+	}
+
+	if findings[2].Path != "README.md" || findings[2].Line != 0 {
+		t.Errorf("Expected README.md without a line, got %+v", findings[2])
+	}
+}
@@ -0,0 +1,248 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rithyhuot/vibe/internal/models"
+)
+
+// ReviewThreadClient defines operations on pull request review threads. The
+// GitLab client doesn't implement it.
+type ReviewThreadClient interface {
+	// GetReviewThreads retrieves the review threads of a pull request, oldest first
+	GetReviewThreads(ctx context.Context, prNumber int) ([]*models.ReviewThread, error)
+	// ReplyToReviewThread adds a comment to a review thread
+	ReplyToReviewThread(ctx context.Context, threadID, body string) (*models.ReviewComment, error)
+	// ResolveReviewThread marks a review thread as resolved
+	ResolveReviewThread(ctx context.Context, threadID string) error
+}
+
+// GetReviewThreads retrieves the review threads of a pull request
+func (c *HTTPClient) GetReviewThreads(ctx context.Context, prNumber int) ([]*models.ReviewThread, error) {
+	return getReviewThreads(ctx, c.owner, c.repo, prNumber, c.executeGraphQL)
+}
+
+// ReplyToReviewThread adds a comment to a review thread
+func (c *HTTPClient) ReplyToReviewThread(ctx context.Context, threadID, body string) (*models.ReviewComment, error) {
+	return replyToReviewThread(ctx, threadID, body, c.executeGraphQL)
+}
+
+// ResolveReviewThread marks a review thread as resolved
+func (c *HTTPClient) ResolveReviewThread(ctx context.Context, threadID string) error {
+	return resolveReviewThread(ctx, threadID, c.executeGraphQL)
+}
+
+// GetReviewThreads retrieves the review threads of a pull request using gh CLI
+func (c *CLIClient) GetReviewThreads(ctx context.Context, prNumber int) ([]*models.ReviewThread, error) {
+	return getReviewThreads(ctx, c.owner, c.repo, prNumber, c.executeGraphQL)
+}
+
+// ReplyToReviewThread adds a comment to a review thread using gh CLI
+func (c *CLIClient) ReplyToReviewThread(ctx context.Context, threadID, body string) (*models.ReviewComment, error) {
+	return replyToReviewThread(ctx, threadID, body, c.executeGraphQL)
+}
+
+// ResolveReviewThread marks a review thread as resolved using gh CLI
+func (c *CLIClient) ResolveReviewThread(ctx context.Context, threadID string) error {
+	return resolveReviewThread(ctx, threadID, c.executeGraphQL)
+}
+
+// reviewThreadNode is a review thread in the GraphQL API
+type reviewThreadNode struct {
+	ID           string `json:"id"`
+	IsResolved   bool   `json:"isResolved"`
+	IsOutdated   bool   `json:"isOutdated"`
+	Path         string `json:"path"`
+	Line         int    `json:"line"`
+	OriginalLine int    `json:"originalLine"`
+	StartLine    int    `json:"startLine"`
+	ResolvedBy   *struct {
+		Login string `json:"login"`
+	} `json:"resolvedBy"`
+	Comments struct {
+		Nodes []reviewCommentNode `json:"nodes"`
+	} `json:"comments"`
+}
+
+// reviewCommentNode is a review comment in the GraphQL API
+type reviewCommentNode struct {
+	ID     string `json:"id"`
+	Author *struct {
+		Login string `json:"login"`
+	} `json:"author"`
+	Body      string    `json:"body"`
+	DiffHunk  string    `json:"diffHunk"`
+	URL       string    `json:"url"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// reviewCommentFields are the fields of reviewCommentNode
+const reviewCommentFields = `
+	id
+	author {
+		login
+	}
+	body
+	diffHunk
+	url
+	createdAt
+`
+
+// getReviewThreads pages through the review threads of a pull request
+func getReviewThreads(ctx context.Context, owner, repo string, prNumber int, graphql graphQLExecutor) ([]*models.ReviewThread, error) {
+	query := `
+		query PullRequestReviewThreads($owner: String!, $name: String!, $number: Int!, $cursor: String) {
+			repository(owner: $owner, name: $name) {
+				pullRequest(number: $number) {
+					reviewThreads(first: 100, after: $cursor) {
+						pageInfo {
+							hasNextPage
+							endCursor
+						}
+						nodes {
+							id
+							isResolved
+							isOutdated
+							path
+							line
+							originalLine
+							startLine
+							resolvedBy {
+								login
+							}
+							comments(first: 100) {
+								nodes {` + reviewCommentFields + `}
+							}
+						}
+					}
+				}
+			}
+		}
+	`
+
+	var threads []*models.ReviewThread
+	cursor := ""
+	for {
+		variables := map[string]interface{}{"owner": owner, "name": repo, "number": prNumber}
+		if cursor != "" {
+			variables["cursor"] = cursor
+		}
+
+		var resp struct {
+			Repository struct {
+				PullRequest *struct {
+					ReviewThreads struct {
+						PageInfo struct {
+							HasNextPage bool   `json:"hasNextPage"`
+							EndCursor   string `json:"endCursor"`
+						} `json:"pageInfo"`
+						Nodes []reviewThreadNode `json:"nodes"`
+					} `json:"reviewThreads"`
+				} `json:"pullRequest"`
+			} `json:"repository"`
+		}
+		if err := graphql(ctx, query, variables, &resp); err != nil {
+			return nil, fmt.Errorf("failed to get review threads: %w", err)
+		}
+		pr := resp.Repository.PullRequest
+		if pr == nil {
+			return nil, fmt.Errorf("failed to get review threads: PR #%d not found", prNumber)
+		}
+
+		for _, node := range pr.ReviewThreads.Nodes {
+			threads = append(threads, node.toReviewThread(len(threads)+1))
+		}
+
+		if !pr.ReviewThreads.PageInfo.HasNextPage {
+			return threads, nil
+		}
+		cursor = pr.ReviewThreads.PageInfo.EndCursor
+	}
+}
+
+// replyToReviewThread adds a comment to a review thread
+func replyToReviewThread(ctx context.Context, threadID, body string, graphql graphQLExecutor) (*models.ReviewComment, error) {
+	mutation := `
+		mutation ReplyToReviewThread($threadId: ID!, $body: String!) {
+			addPullRequestReviewThreadReply(input: {pullRequestReviewThreadId: $threadId, body: $body}) {
+				comment {` + reviewCommentFields + `}
+			}
+		}
+	`
+	var resp struct {
+		AddPullRequestReviewThreadReply struct {
+			Comment *reviewCommentNode `json:"comment"`
+		} `json:"addPullRequestReviewThreadReply"`
+	}
+	variables := map[string]interface{}{"threadId": threadID, "body": body}
+	if err := graphql(ctx, mutation, variables, &resp); err != nil {
+		return nil, fmt.Errorf("failed to reply to review thread: %w", err)
+	}
+	if resp.AddPullRequestReviewThreadReply.Comment == nil {
+		return nil, fmt.Errorf("failed to reply to review thread: no comment was created")
+	}
+
+	comment := resp.AddPullRequestReviewThreadReply.Comment.toReviewComment()
+	return &comment, nil
+}
+
+// resolveReviewThread marks a review thread as resolved
+func resolveReviewThread(ctx context.Context, threadID string, graphql graphQLExecutor) error {
+	mutation := `
+		mutation ResolveReviewThread($threadId: ID!) {
+			resolveReviewThread(input: {threadId: $threadId}) {
+				thread {
+					isResolved
+				}
+			}
+		}
+	`
+	if err := graphql(ctx, mutation, map[string]interface{}{"threadId": threadID}, nil); err != nil {
+		return fmt.Errorf("failed to resolve review thread: %w", err)
+	}
+	return nil
+}
+
+// toReviewThread converts a GraphQL review thread to the model, numbered by
+// its position among the pull request's threads
+func (n *reviewThreadNode) toReviewThread(number int) *models.ReviewThread {
+	thread := &models.ReviewThread{
+		ID:         n.ID,
+		Number:     number,
+		Path:       n.Path,
+		Line:       n.Line,
+		StartLine:  n.StartLine,
+		IsResolved: n.IsResolved,
+		IsOutdated: n.IsOutdated,
+	}
+	if thread.Line == 0 {
+		thread.Line = n.OriginalLine
+	}
+	if n.ResolvedBy != nil {
+		thread.ResolvedBy = n.ResolvedBy.Login
+	}
+	if len(n.Comments.Nodes) > 0 {
+		thread.DiffHunk = n.Comments.Nodes[0].DiffHunk
+	}
+	for _, comment := range n.Comments.Nodes {
+		thread.Comments = append(thread.Comments, comment.toReviewComment())
+	}
+	return thread
+}
+
+// toReviewComment converts a GraphQL review comment to the model
+func (n *reviewCommentNode) toReviewComment() models.ReviewComment {
+	comment := models.ReviewComment{
+		ID:        n.ID,
+		Body:      n.Body,
+		URL:       n.URL,
+		CreatedAt: n.CreatedAt,
+	}
+	// Deleted accounts have no author
+	if n.Author != nil {
+		comment.Author = n.Author.Login
+	}
+	return comment
+}
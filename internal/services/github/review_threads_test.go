@@ -0,0 +1,105 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGetReviewThreads(t *testing.T) {
+	var cursors []interface{}
+	server := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		mustDecode(r, &req)
+		cursors = append(cursors, req.Variables["cursor"])
+
+		if req.Variables["cursor"] == nil {
+			mustEncode(w, graphQLResponse{Data: json.RawMessage(`{"repository": {"pullRequest": {"reviewThreads": {
+				"pageInfo": {"hasNextPage": true, "endCursor": "c1"},
+				"nodes": [{
+					"id": "PRRT_1", "isResolved": false, "path": "main.go", "line": 12, "startLine": 10,
+					"comments": {"nodes": [
+						{"id": "PRRC_1", "author": {"login": "alice"}, "body": "Handle the error", "diffHunk": "@@ -1,3 +1,12 @@", "createdAt": "2026-03-01T10:00:00Z"},
+						{"id": "PRRC_2", "author": null, "body": "Done"}
+					]}
+				}]
+			}}}}`)})
+			return
+		}
+		mustEncode(w, graphQLResponse{Data: json.RawMessage(`{"repository": {"pullRequest": {"reviewThreads": {
+			"pageInfo": {"hasNextPage": false},
+			"nodes": [{
+				"id": "PRRT_2", "isResolved": true, "isOutdated": true, "path": "util.go", "line": null, "originalLine": 7,
+				"resolvedBy": {"login": "bob"},
+				"comments": {"nodes": [{"id": "PRRC_3", "author": {"login": "bob"}, "body": "Nit"}]}
+			}]
+		}}}}`)})
+	})
+	defer server.Close()
+
+	client := createTestClient(server.URL)
+
+	threads, err := client.GetReviewThreads(context.Background(), 42)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(cursors) != 2 || cursors[1] != "c1" {
+		t.Errorf("Expected a second page request with cursor c1, got %v", cursors)
+	}
+	if len(threads) != 2 {
+		t.Fatalf("Expected 2 threads, got %d", len(threads))
+	}
+
+	first := threads[0]
+	if first.Number != 1 || first.ID != "PRRT_1" || first.Path != "main.go" || first.Line != 12 || first.StartLine != 10 {
+		t.Errorf("Unexpected first thread: %+v", first)
+	}
+	if first.DiffHunk != "@@ -1,3 +1,12 @@" || len(first.Comments) != 2 || first.Comments[0].Author != "alice" || first.Comments[1].Author != "" {
+		t.Errorf("Unexpected first thread comments: %+v", first)
+	}
+
+	second := threads[1]
+	if second.Number != 2 || !second.IsResolved || !second.IsOutdated || second.Line != 7 || second.ResolvedBy != "bob" {
+		t.Errorf("Unexpected second thread: %+v", second)
+	}
+}
+
+func TestReplyToReviewThread(t *testing.T) {
+	var req graphQLRequest
+	server := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		mustDecode(r, &req)
+		mustEncode(w, graphQLResponse{Data: json.RawMessage(`{"addPullRequestReviewThreadReply": {"comment": {
+			"id": "PRRC_9", "author": {"login": "me"}, "body": "Fixed in abc123", "url": "https://github.com/test-owner/test-repo/pull/42#discussion_r9"
+		}}}`)})
+	})
+	defer server.Close()
+
+	client := createTestClient(server.URL)
+
+	comment, err := client.ReplyToReviewThread(context.Background(), "PRRT_1", "Fixed in abc123")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !strings.Contains(req.Query, "addPullRequestReviewThreadReply") || req.Variables["threadId"] != "PRRT_1" || req.Variables["body"] != "Fixed in abc123" {
+		t.Errorf("Unexpected request: %+v", req)
+	}
+	if comment.ID != "PRRC_9" || comment.Author != "me" {
+		t.Errorf("Unexpected comment: %+v", comment)
+	}
+}
+
+func TestResolveReviewThread(t *testing.T) {
+	server := setupTestServer(func(w http.ResponseWriter, _ *http.Request) {
+		mustEncode(w, graphQLResponse{Errors: []graphQLError{{Message: "Could not resolve to a node with the global id of 'PRRT_x'"}}})
+	})
+	defer server.Close()
+
+	client := createTestClient(server.URL)
+
+	err := client.ResolveReviewThread(context.Background(), "PRRT_x")
+	if err == nil || !strings.Contains(err.Error(), "Could not resolve") {
+		t.Errorf("Expected GraphQL error, got %v", err)
+	}
+}
@@ -0,0 +1,62 @@
+package github
+
+import (
+	"strings"
+
+	"github.com/rithyhuot/vibe/internal/models"
+)
+
+// latestReviews reduces a chronological list of reviews to the latest review
+// state per reviewer, in order of first appearance. A comment-only review does
+// not override an earlier approval or change request, and pending (unsubmitted)
+// reviews are ignored, matching how GitHub computes the review decision.
+func latestReviews(reviews []models.Review) []models.Review {
+	var result []models.Review
+	index := make(map[string]int)
+
+	for _, review := range reviews {
+		if review.Reviewer == "" || review.State == "PENDING" {
+			continue
+		}
+
+		i, seen := index[review.Reviewer]
+		if !seen {
+			index[review.Reviewer] = len(result)
+			result = append(result, review)
+			continue
+		}
+
+		if review.State == "COMMENTED" && result[i].State != "COMMENTED" {
+			continue
+		}
+		result[i].State = review.State
+	}
+
+	return result
+}
+
+// checkRunStatus normalizes a REST check run status and conclusion
+func checkRunStatus(status, conclusion string) string {
+	switch conclusion {
+	case "success":
+		return "success"
+	case "failure", "timed_out", "action_required":
+		return "failure"
+	}
+	if status == "completed" {
+		return "success"
+	}
+	return "pending"
+}
+
+// rollupCheckStatus normalizes a gh statusCheckRollup state or conclusion
+func rollupCheckStatus(state string) string {
+	switch strings.ToUpper(state) {
+	case "SUCCESS", "NEUTRAL", "SKIPPED":
+		return "success"
+	case "FAILURE", "ERROR", "TIMED_OUT", "ACTION_REQUIRED":
+		return "failure"
+	default:
+		return "pending"
+	}
+}
@@ -49,9 +49,28 @@ type ReviewResponse struct {
 	User        UserRef   `json:"user"`
 	State       string    `json:"state"`
 	Body        string    `json:"body"`
+	HTMLURL     string    `json:"html_url"`
 	SubmittedAt time.Time `json:"submitted_at"`
 }
 
+// ReviewRequest represents the body of a create review request in GitHub API
+type ReviewRequest struct {
+	Event    string                 `json:"event"`
+	Body     string                 `json:"body,omitempty"`
+	CommitID string                 `json:"commit_id,omitempty"`
+	Comments []ReviewCommentRequest `json:"comments"`
+}
+
+// ReviewCommentRequest represents an inline comment of a create review request in GitHub API
+type ReviewCommentRequest struct {
+	Path      string `json:"path"`
+	Line      int    `json:"line"`
+	Side      string `json:"side"`
+	StartLine int    `json:"start_line,omitempty"`
+	StartSide string `json:"start_side,omitempty"`
+	Body      string `json:"body"`
+}
+
 // CheckRunResponse represents a check run in GitHub API
 type CheckRunResponse struct {
 	ID          int        `json:"id"`
@@ -63,6 +82,44 @@ type CheckRunResponse struct {
 	HTMLURL     string     `json:"html_url"`
 }
 
+// CommitStatusResponse represents a commit status in GitHub API
+type CommitStatusResponse struct {
+	Context   string `json:"context"`
+	State     string `json:"state"` // "success", "failure", "error", or "pending"
+	TargetURL string `json:"target_url"`
+}
+
+// BranchResponse represents a branch in GitHub API
+type BranchResponse struct {
+	Name       string `json:"name"`
+	Protected  bool   `json:"protected"`
+	Protection struct {
+		RequiredStatusChecks *struct {
+			Contexts []string `json:"contexts"`
+			Checks   []struct {
+				Context string `json:"context"`
+			} `json:"checks"`
+		} `json:"required_status_checks"`
+	} `json:"protection"`
+}
+
+// RequiredReviewsResponse represents the required review settings of a
+// protected branch in GitHub API
+type RequiredReviewsResponse struct {
+	RequiredApprovingReviewCount int `json:"required_approving_review_count"`
+}
+
+// BranchRuleResponse represents a ruleset rule that applies to a branch in GitHub API
+type BranchRuleResponse struct {
+	Type       string `json:"type"` // e.g. "pull_request", "required_status_checks", "merge_queue"
+	Parameters struct {
+		RequiredApprovingReviewCount int `json:"required_approving_review_count"`
+		RequiredStatusChecks         []struct {
+			Context string `json:"context"`
+		} `json:"required_status_checks"`
+	} `json:"parameters"`
+}
+
 // CheckSuiteResponse represents a check suite in GitHub API
 type CheckSuiteResponse struct {
 	ID         int    `json:"id"`
@@ -233,3 +290,49 @@ func (ir *IssueResponse) ToIssue() *models.Issue {
 
 	return issue
 }
+
+// WorkflowRunResponse represents a GitHub Actions workflow run
+type WorkflowRunResponse struct {
+	ID         int64     `json:"id"`
+	Name       string    `json:"name"`
+	RunNumber  int       `json:"run_number"`
+	RunAttempt int       `json:"run_attempt"`
+	Event      string    `json:"event"`
+	Status     string    `json:"status"`     // queued, in_progress, completed, waiting, requested, or pending
+	Conclusion string    `json:"conclusion"` // success, failure, cancelled, skipped, timed_out, action_required, or neutral
+	HeadBranch string    `json:"head_branch"`
+	HeadSHA    string    `json:"head_sha"`
+	HTMLURL    string    `json:"html_url"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// WorkflowJobResponse represents a job of a GitHub Actions workflow run
+type WorkflowJobResponse struct {
+	ID          int64          `json:"id"`
+	RunID       int64          `json:"run_id"`
+	Name        string         `json:"name"`
+	Status      string         `json:"status"`
+	Conclusion  string         `json:"conclusion"`
+	HTMLURL     string         `json:"html_url"`
+	StartedAt   *time.Time     `json:"started_at"`
+	CompletedAt *time.Time     `json:"completed_at"`
+	Steps       []WorkflowStep `json:"steps"`
+}
+
+// WorkflowStep represents a step of a GitHub Actions job
+type WorkflowStep struct {
+	Name       string `json:"name"`
+	Number     int    `json:"number"`
+	Status     string `json:"status"`
+	Conclusion string `json:"conclusion"`
+}
+
+// ArtifactResponse represents an artifact uploaded by a workflow run
+type ArtifactResponse struct {
+	ID                 int64  `json:"id"`
+	Name               string `json:"name"`
+	SizeInBytes        int64  `json:"size_in_bytes"`
+	ArchiveDownloadURL string `json:"archive_download_url"`
+	Expired            bool   `json:"expired"`
+}
@@ -0,0 +1,166 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	// ansiPattern matches ANSI escape sequences in job traces
+	ansiPattern = regexp.MustCompile(`\x1b\[[0-9;]*[A-Za-z]`)
+
+	// sectionPattern matches the collapsible section markers in job traces
+	sectionPattern = regexp.MustCompile(`section_(?:start|end):[0-9]+:[^\r\n]*\r`)
+)
+
+// GetPipelineStatus retrieves the latest pipeline for a branch with its jobs
+// and failed tests. Returns nil if the branch has no pipelines.
+func (c *HTTPClient) GetPipelineStatus(ctx context.Context, branch string) (*PipelineStatus, error) {
+	u := c.projectURL("/pipelines?ref=%s&per_page=1", url.QueryEscape(branch))
+
+	var pipelines []PipelineResponse
+	err := c.httpClient.DoJSONRequest(ctx, "GET", u, nil, &pipelines, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pipelines: %w", err)
+	}
+
+	if len(pipelines) == 0 {
+		return nil, nil
+	}
+
+	pipeline := pipelines[0]
+	jobs, err := c.getPipelineJobs(ctx, pipeline.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &PipelineStatus{
+		Branch:      branch,
+		Project:     c.project,
+		Pipeline:    pipeline,
+		Jobs:        jobs,
+		FailedTests: make(map[string][]TestCase),
+	}
+
+	// Test reports are only available when jobs upload JUnit artifacts
+	if hasFailedJob(jobs) {
+		if report, err := c.getTestReport(ctx, pipeline.ID); err == nil {
+			for _, suite := range report.TestSuites {
+				for _, tc := range suite.TestCases {
+					if tc.Status == "failed" || tc.Status == "error" {
+						status.FailedTests[suite.Name] = append(status.FailedTests[suite.Name], tc)
+					}
+				}
+			}
+		}
+	}
+
+	return status, nil
+}
+
+// getPipelineJobs retrieves the latest attempt of each job in a pipeline,
+// in pipeline order
+func (c *HTTPClient) getPipelineJobs(ctx context.Context, pipelineID int) ([]JobResponse, error) {
+	var jobs []JobResponse
+	err := c.httpClient.DoJSONRequest(ctx, "GET", c.projectURL("/pipelines/%d/jobs?per_page=100", pipelineID), nil, &jobs, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get jobs: %w", err)
+	}
+
+	// The API lists the newest jobs first
+	sort.SliceStable(jobs, func(i, j int) bool { return jobs[i].ID < jobs[j].ID })
+	return jobs, nil
+}
+
+// getTestReport retrieves the test report of a pipeline
+func (c *HTTPClient) getTestReport(ctx context.Context, pipelineID int) (*TestReportResponse, error) {
+	var report TestReportResponse
+	err := c.httpClient.DoJSONRequest(ctx, "GET", c.projectURL("/pipelines/%d/test_report", pipelineID), nil, &report, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get test report: %w", err)
+	}
+	return &report, nil
+}
+
+// GetJob retrieves a job by ID
+func (c *HTTPClient) GetJob(ctx context.Context, jobID int) (*JobResponse, error) {
+	var job JobResponse
+	err := c.httpClient.DoJSONRequest(ctx, "GET", c.projectURL("/jobs/%d", jobID), nil, &job, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job: %w", err)
+	}
+	return &job, nil
+}
+
+// GetJobTrace retrieves the log of a job as plain text
+func (c *HTTPClient) GetJobTrace(ctx context.Context, jobID int) (string, error) {
+	var trace []byte
+	err := c.httpClient.DoJSONRequest(ctx, "GET", c.projectURL("/jobs/%d/trace", jobID), nil, &trace, c.headers())
+	if err != nil {
+		return "", fmt.Errorf("failed to get job log: %w", err)
+	}
+	return CleanTrace(string(trace)), nil
+}
+
+// CleanTrace strips the ANSI colors, section markers, and overwritten
+// progress output from a job trace
+func CleanTrace(trace string) string {
+	trace = ansiPattern.ReplaceAllString(trace, "")
+	trace = sectionPattern.ReplaceAllString(trace, "")
+	trace = strings.ReplaceAll(trace, "\r\n", "\n")
+
+	lines := strings.Split(trace, "\n")
+	result := lines[:0]
+	for _, line := range lines {
+		// A carriage return redraws the line; keep what was drawn last
+		if i := strings.LastIndex(line, "\r"); i >= 0 {
+			line = line[i+1:]
+		}
+		result = append(result, line)
+	}
+
+	return strings.TrimRight(strings.Join(result, "\n"), "\n")
+}
+
+// IsBlockingFailure reports whether a job failed in a way that fails its
+// pipeline; jobs with allow_failure don't
+func IsBlockingFailure(job JobResponse) bool {
+	return job.Status == "failed" && !job.AllowFailure
+}
+
+// hasFailedJob reports whether any job failed its pipeline
+func hasFailedJob(jobs []JobResponse) bool {
+	for _, job := range jobs {
+		if IsBlockingFailure(job) {
+			return true
+		}
+	}
+	return false
+}
+
+// jobCheckStatus normalizes a job status to a check status. Jobs that are
+// allowed to fail, skipped jobs, and optional manual jobs don't block merging.
+func jobCheckStatus(job JobResponse) string {
+	switch job.Status {
+	case "success", "skipped":
+		return "success"
+	case "failed":
+		if job.AllowFailure {
+			return "success"
+		}
+		return "failure"
+	case "canceled":
+		return "failure"
+	case "manual":
+		if job.AllowFailure {
+			return "success"
+		}
+		return "pending"
+	default:
+		return "pending"
+	}
+}
@@ -0,0 +1,651 @@
+// Package gitlab provides a client for the GitLab REST API. Merge requests
+// are exposed through the same pull request operations as the GitHub client.
+package gitlab
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/rithyhuot/vibe/internal/models"
+	"github.com/rithyhuot/vibe/internal/utils"
+)
+
+const (
+	// DefaultHost is the host of GitLab.com
+	DefaultHost = "gitlab.com"
+)
+
+// Client interface defines GitLab operations. The merge request and issue
+// operations mirror github.Client, so either can back the PR and issue commands.
+type Client interface {
+	Project() string
+
+	CreatePR(ctx context.Context, req *models.PRCreateRequest) (*models.PullRequest, error)
+	GetPR(ctx context.Context, prNumber int) (*models.PullRequest, error)
+	UpdatePR(ctx context.Context, prNumber int, req *models.PRUpdateRequest) (*models.PullRequest, error)
+	GetPRStatus(ctx context.Context, prNumber int) (*models.PRStatus, error)
+	GetPRReviews(ctx context.Context, prNumber int) ([]models.Review, error)
+	GetPRForBranch(ctx context.Context, branch string) (*models.PullRequest, error)
+	GetPRBody(ctx context.Context, prNumber int) (string, error)
+	ListPRs(ctx context.Context, state string) ([]*models.PullRequest, error)
+	AddComment(ctx context.Context, prNumber int, body string) error
+	GetPRTemplate(ctx context.Context) (string, error)
+
+	// Issue operations
+	CreateIssue(ctx context.Context, req *models.IssueCreateRequest) (*models.Issue, error)
+	GetIssue(ctx context.Context, issueNumber int, includeComments bool) (*models.Issue, error)
+	UpdateIssue(ctx context.Context, issueNumber int, req *models.IssueUpdateRequest) (*models.Issue, error)
+	ListIssues(ctx context.Context, state string) ([]*models.Issue, error)
+	GetIssueTemplate(ctx context.Context) (string, error)
+
+	// CI operations
+	GetPipelineStatus(ctx context.Context, branch string) (*PipelineStatus, error)
+	GetJob(ctx context.Context, jobID int) (*JobResponse, error)
+	GetJobTrace(ctx context.Context, jobID int) (string, error)
+}
+
+// HTTPClient implements the Client interface using HTTP
+type HTTPClient struct {
+	httpClient *utils.HTTPClient
+	token      string
+	project    string // Project path, e.g. group/subgroup/repo
+	apiURL     string // e.g. https://gitlab.com/api/v4
+}
+
+// NewClient creates a new GitLab client for a project on the given host
+func NewClient(host, token, project string) *HTTPClient {
+	return &HTTPClient{
+		httpClient: utils.NewHTTPClient(0).WithUserAgent("vibe"),
+		token:      token,
+		project:    project,
+		apiURL:     fmt.Sprintf("https://%s/api/v4", host),
+	}
+}
+
+// WithRetryPolicy sets the retry budget for requests made by this client
+func (c *HTTPClient) WithRetryPolicy(policy utils.RetryPolicy) *HTTPClient {
+	c.httpClient.WithRetryPolicy(policy)
+	return c
+}
+
+// WithCache enables on-disk caching of GET responses made by this client
+func (c *HTTPClient) WithCache(cache *utils.ResponseCache) *HTTPClient {
+	c.httpClient.WithCache(cache)
+	return c
+}
+
+// Project returns the path of the project this client operates on
+func (c *HTTPClient) Project() string {
+	return c.project
+}
+
+// IsGitLabHost reports whether a git remote host is a GitLab instance:
+// gitlab.com, a host listed in gitlab.hosts, or a host named gitlab.*
+func IsGitLabHost(host string, hosts []string) bool {
+	host = strings.ToLower(host)
+	if host == DefaultHost || strings.HasPrefix(host, "gitlab.") {
+		return true
+	}
+	for _, h := range hosts {
+		if strings.EqualFold(h, host) {
+			return true
+		}
+	}
+	return false
+}
+
+// headers returns the common headers for GitLab API requests
+func (c *HTTPClient) headers() map[string]string {
+	return map[string]string{
+		"Authorization": fmt.Sprintf("Bearer %s", c.token),
+		"Content-Type":  "application/json",
+	}
+}
+
+// projectURL returns the API URL of a path within the project
+func (c *HTTPClient) projectURL(format string, args ...any) string {
+	return fmt.Sprintf("%s/projects/%s", c.apiURL, url.PathEscape(c.project)) + fmt.Sprintf(format, args...)
+}
+
+// CreatePR creates a merge request. GitLab marks drafts with a title prefix.
+func (c *HTTPClient) CreatePR(ctx context.Context, req *models.PRCreateRequest) (*models.PullRequest, error) {
+	title := req.Title
+	if req.Draft {
+		title = "Draft: " + title
+	}
+
+	payload := map[string]interface{}{
+		"title":         title,
+		"description":   req.Body,
+		"source_branch": req.Head,
+		"target_branch": req.Base,
+	}
+	if err := c.addMetadataToPayload(ctx, payload, &req.PRMetadata, nil); err != nil {
+		return nil, err
+	}
+	if len(req.Labels) > 0 {
+		payload["labels"] = strings.Join(req.Labels, ",")
+	}
+
+	var resp MergeRequestResponse
+	err := c.httpClient.DoJSONRequest(ctx, "POST", c.projectURL("/merge_requests"), payload, &resp, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create merge request: %w", err)
+	}
+
+	return resp.ToPullRequest(), nil
+}
+
+// getMergeRequest retrieves a merge request by IID
+func (c *HTTPClient) getMergeRequest(ctx context.Context, iid int) (*MergeRequestResponse, error) {
+	var resp MergeRequestResponse
+	err := c.httpClient.DoJSONRequest(ctx, "GET", c.projectURL("/merge_requests/%d", iid), nil, &resp, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get merge request: %w", err)
+	}
+	return &resp, nil
+}
+
+// GetPR retrieves a merge request by IID
+func (c *HTTPClient) GetPR(ctx context.Context, prNumber int) (*models.PullRequest, error) {
+	mr, err := c.getMergeRequest(ctx, prNumber)
+	if err != nil {
+		return nil, err
+	}
+	return mr.ToPullRequest(), nil
+}
+
+// UpdatePR updates the title, description, or target branch of a merge request
+func (c *HTTPClient) UpdatePR(ctx context.Context, prNumber int, req *models.PRUpdateRequest) (*models.PullRequest, error) {
+	payload := make(map[string]interface{})
+	if req.Title != nil {
+		payload["title"] = *req.Title
+	}
+	if req.Body != nil {
+		payload["description"] = *req.Body
+	}
+	if req.Base != nil {
+		payload["target_branch"] = *req.Base
+	}
+
+	var resp MergeRequestResponse
+	err := c.httpClient.DoJSONRequest(ctx, "PUT", c.projectURL("/merge_requests/%d", prNumber), payload, &resp, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to update merge request: %w", err)
+	}
+
+	return resp.ToPullRequest(), nil
+}
+
+// GetPRStatus retrieves the status of a merge request including approvals
+// and the jobs of its head pipeline
+func (c *HTTPClient) GetPRStatus(ctx context.Context, prNumber int) (*models.PRStatus, error) {
+	mr, err := c.getMergeRequest(ctx, prNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	pr := mr.ToPullRequest()
+	status := &models.PRStatus{
+		Number:     pr.Number,
+		Title:      pr.Title,
+		State:      pr.State,
+		Draft:      pr.Draft,
+		Merged:     pr.Merged,
+		Mergeable:  pr.Mergeable,
+		BaseBranch: pr.Base.Ref,
+		URL:        pr.URL,
+	}
+
+	// Get review status
+	reviews, err := c.GetPRReviews(ctx, prNumber)
+	if err != nil {
+		// Don't fail on review status error
+		status.ReviewStatus = models.ReviewStatus{OverallStatus: "unknown"}
+	} else {
+		status.ReviewStatus = models.SummarizeReviews(reviews)
+	}
+
+	// Get pipeline status
+	if mr.HeadPipeline == nil {
+		status.CheckStatus = models.SummarizeChecks(nil)
+	} else {
+		jobs, err := c.getPipelineJobs(ctx, mr.HeadPipeline.ID)
+		if err != nil {
+			// Don't fail on pipeline status error
+			status.CheckStatus = models.CheckStatus{OverallStatus: "unknown"}
+		} else {
+			checks := make([]models.Check, len(jobs))
+			for i, job := range jobs {
+				checks[i] = models.Check{Name: job.Name, Status: jobCheckStatus(job)}
+			}
+			status.CheckStatus = models.SummarizeChecks(checks)
+		}
+	}
+
+	return status, nil
+}
+
+// GetPRReviews retrieves the review state of each reviewer on a merge
+// request. Approvals come from the approvals API, so approvers who weren't
+// requested as reviewers are included.
+func (c *HTTPClient) GetPRReviews(ctx context.Context, prNumber int) ([]models.Review, error) {
+	var reviewers []ReviewerResponse
+	err := c.httpClient.DoJSONRequest(ctx, "GET", c.projectURL("/merge_requests/%d/reviewers", prNumber), nil, &reviewers, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reviewers: %w", err)
+	}
+
+	var approvals ApprovalsResponse
+	err = c.httpClient.DoJSONRequest(ctx, "GET", c.projectURL("/merge_requests/%d/approvals", prNumber), nil, &approvals, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get approvals: %w", err)
+	}
+
+	var reviews []models.Review
+	index := make(map[string]int)
+	for _, reviewer := range reviewers {
+		state := reviewState(reviewer.State)
+		if state == "" {
+			continue
+		}
+		index[reviewer.User.Username] = len(reviews)
+		reviews = append(reviews, models.Review{Reviewer: reviewer.User.Username, State: state})
+	}
+
+	for _, approval := range approvals.ApprovedBy {
+		if i, ok := index[approval.User.Username]; ok {
+			reviews[i].State = "APPROVED"
+			continue
+		}
+		reviews = append(reviews, models.Review{Reviewer: approval.User.Username, State: "APPROVED"})
+	}
+
+	return reviews, nil
+}
+
+// GetPRForBranch retrieves the open merge request whose source is the given
+// branch. Returns nil if the branch has no open merge request.
+func (c *HTTPClient) GetPRForBranch(ctx context.Context, branch string) (*models.PullRequest, error) {
+	u := c.projectURL("/merge_requests?state=opened&source_branch=%s", url.QueryEscape(branch))
+
+	var mrs []MergeRequestResponse
+	err := c.httpClient.DoJSONRequest(ctx, "GET", u, nil, &mrs, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find merge request for branch %s: %w", branch, err)
+	}
+
+	if len(mrs) == 0 {
+		return nil, nil
+	}
+
+	return mrs[0].ToPullRequest(), nil
+}
+
+// GetPRBody retrieves the description of a merge request
+func (c *HTTPClient) GetPRBody(ctx context.Context, prNumber int) (string, error) {
+	mr, err := c.getMergeRequest(ctx, prNumber)
+	if err != nil {
+		return "", err
+	}
+	return mr.Description, nil
+}
+
+// ListPRs lists merge requests with optional state filter (open, closed, merged, or all)
+func (c *HTTPClient) ListPRs(ctx context.Context, state string) ([]*models.PullRequest, error) {
+	u := c.projectURL("/merge_requests?state=%s&per_page=100", gitlabState(state))
+
+	var mrs []MergeRequestResponse
+	err := c.httpClient.DoJSONRequest(ctx, "GET", u, nil, &mrs, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list merge requests: %w", err)
+	}
+
+	result := make([]*models.PullRequest, len(mrs))
+	for i, mr := range mrs {
+		result[i] = mr.ToPullRequest()
+	}
+
+	return result, nil
+}
+
+// AddComment adds a comment to a merge request
+func (c *HTTPClient) AddComment(ctx context.Context, prNumber int, body string) error {
+	payload := map[string]interface{}{
+		"body": body,
+	}
+
+	var resp NoteResponse
+	err := c.httpClient.DoJSONRequest(ctx, "POST", c.projectURL("/merge_requests/%d/notes", prNumber), payload, &resp, c.headers())
+	if err != nil {
+		return fmt.Errorf("failed to add comment: %w", err)
+	}
+
+	return nil
+}
+
+// errTeamReviewers is returned for team reviewers, since GitLab groups can't review merge requests
+var errTeamReviewers = errors.New("team reviewers are not supported for GitLab merge requests")
+
+// AddPRMetadata adds reviewers, labels, and assignees to a merge request.
+// Reviewers and assignees are resolved from usernames.
+func (c *HTTPClient) AddPRMetadata(ctx context.Context, prNumber int, metadata *models.PRMetadata) error {
+	if metadata.IsEmpty() {
+		return nil
+	}
+	if len(metadata.TeamReviewers) > 0 {
+		return errTeamReviewers
+	}
+
+	// reviewer_ids and assignee_ids replace the existing ones
+	mr, err := c.getMergeRequest(ctx, prNumber)
+	if err != nil {
+		return err
+	}
+
+	payload := make(map[string]interface{})
+	if err := c.addMetadataToPayload(ctx, payload, metadata, mr); err != nil {
+		return err
+	}
+	if len(metadata.Labels) > 0 {
+		payload["add_labels"] = strings.Join(metadata.Labels, ",")
+	}
+
+	err = c.httpClient.DoJSONRequest(ctx, "PUT", c.projectURL("/merge_requests/%d", prNumber), payload, nil, c.headers())
+	if err != nil {
+		return fmt.Errorf("failed to update merge request: %w", err)
+	}
+
+	return nil
+}
+
+// addMetadataToPayload sets the reviewer and assignee IDs of a merge request
+// payload, keeping those of existing when it isn't nil
+func (c *HTTPClient) addMetadataToPayload(ctx context.Context, payload map[string]interface{}, metadata *models.PRMetadata, existing *MergeRequestResponse) error {
+	if len(metadata.TeamReviewers) > 0 {
+		return errTeamReviewers
+	}
+
+	if len(metadata.Reviewers) > 0 {
+		ids, err := c.userIDs(ctx, metadata.Reviewers)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			ids = appendUserIDs(ids, existing.Reviewers)
+		}
+		payload["reviewer_ids"] = ids
+	}
+
+	if len(metadata.Assignees) > 0 {
+		ids, err := c.userIDs(ctx, metadata.Assignees)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			ids = appendUserIDs(ids, existing.Assignees)
+		}
+		payload["assignee_ids"] = ids
+	}
+
+	return nil
+}
+
+// appendUserIDs appends the IDs of users that aren't in ids yet
+func appendUserIDs(ids []int, users []UserResponse) []int {
+	for _, user := range users {
+		if !slices.Contains(ids, user.ID) {
+			ids = append(ids, user.ID)
+		}
+	}
+	return ids
+}
+
+// getTemplateFromPaths fetches a template from one of the given paths on the
+// default branch
+func (c *HTTPClient) getTemplateFromPaths(ctx context.Context, paths []string) (string, error) {
+	for _, path := range paths {
+		u := c.projectURL("/repository/files/%s?ref=HEAD", url.PathEscape(path))
+
+		var resp FileResponse
+		err := c.httpClient.DoJSONRequest(ctx, "GET", u, nil, &resp, c.headers())
+		if err == nil && resp.Content != "" && resp.Encoding == "base64" {
+			decoded, err := base64.StdEncoding.DecodeString(resp.Content)
+			if err == nil {
+				return string(decoded), nil
+			}
+		}
+	}
+
+	return "", nil // No template found, return empty string
+}
+
+// GetPRTemplate retrieves the default merge request template
+func (c *HTTPClient) GetPRTemplate(ctx context.Context) (string, error) {
+	templatePaths := []string{
+		".gitlab/merge_request_templates/Default.md",
+		".gitlab/merge_request_templates/default.md",
+	}
+	return c.getTemplateFromPaths(ctx, templatePaths)
+}
+
+// CreateIssue creates a new issue. Assignees and the milestone are resolved
+// from usernames and the milestone title.
+func (c *HTTPClient) CreateIssue(ctx context.Context, req *models.IssueCreateRequest) (*models.Issue, error) {
+	if len(req.ProjectIDs) > 0 {
+		return nil, fmt.Errorf("GitHub Projects are not supported for GitLab issues")
+	}
+
+	payload := map[string]interface{}{
+		"title":       req.Title,
+		"description": req.Body,
+	}
+
+	if len(req.Assignees) > 0 {
+		ids, err := c.userIDs(ctx, req.Assignees)
+		if err != nil {
+			return nil, err
+		}
+		payload["assignee_ids"] = ids
+	}
+	if len(req.Labels) > 0 {
+		payload["labels"] = strings.Join(req.Labels, ",")
+	}
+	if req.Milestone != "" {
+		id, err := c.milestoneID(ctx, req.Milestone)
+		if err != nil {
+			return nil, err
+		}
+		payload["milestone_id"] = id
+	}
+
+	var resp IssueResponse
+	err := c.httpClient.DoJSONRequest(ctx, "POST", c.projectURL("/issues"), payload, &resp, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create issue: %w", err)
+	}
+
+	return resp.ToIssue(), nil
+}
+
+// GetIssue retrieves an issue by IID
+func (c *HTTPClient) GetIssue(ctx context.Context, issueNumber int, includeComments bool) (*models.Issue, error) {
+	var resp IssueResponse
+	err := c.httpClient.DoJSONRequest(ctx, "GET", c.projectURL("/issues/%d", issueNumber), nil, &resp, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue: %w", err)
+	}
+
+	issue := resp.ToIssue()
+
+	// Fetch comments if requested
+	if includeComments {
+		comments, err := c.getIssueComments(ctx, issueNumber)
+		if err != nil {
+			// Don't fail on comments error
+			issue.Comments = []models.IssueComment{}
+		} else {
+			issue.Comments = comments
+		}
+	}
+
+	return issue, nil
+}
+
+// getIssueComments retrieves the user comments on an issue, oldest first
+func (c *HTTPClient) getIssueComments(ctx context.Context, issueNumber int) ([]models.IssueComment, error) {
+	u := c.projectURL("/issues/%d/notes?sort=asc&order_by=created_at&per_page=100", issueNumber)
+
+	var notes []NoteResponse
+	err := c.httpClient.DoJSONRequest(ctx, "GET", u, nil, &notes, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get comments: %w", err)
+	}
+
+	comments := make([]models.IssueComment, 0, len(notes))
+	for _, note := range notes {
+		if note.System {
+			continue
+		}
+		comments = append(comments, models.IssueComment{
+			ID:        note.ID,
+			Body:      note.Body,
+			User:      note.Author.toUser(),
+			CreatedAt: note.CreatedAt,
+		})
+	}
+
+	return comments, nil
+}
+
+// UpdateIssue updates an existing issue
+func (c *HTTPClient) UpdateIssue(ctx context.Context, issueNumber int, req *models.IssueUpdateRequest) (*models.Issue, error) {
+	if req.ProjectIDs != nil && len(*req.ProjectIDs) > 0 {
+		return nil, fmt.Errorf("GitHub Projects are not supported for GitLab issues")
+	}
+
+	payload := make(map[string]interface{})
+	if req.Title != nil {
+		payload["title"] = *req.Title
+	}
+	if req.Body != nil {
+		payload["description"] = *req.Body
+	}
+	if req.State != nil {
+		switch *req.State {
+		case "closed":
+			payload["state_event"] = "close"
+		case "open":
+			payload["state_event"] = "reopen"
+		default:
+			return nil, fmt.Errorf("invalid issue state: %s", *req.State)
+		}
+	}
+	if req.Assignees != nil {
+		ids, err := c.userIDs(ctx, *req.Assignees)
+		if err != nil {
+			return nil, err
+		}
+		payload["assignee_ids"] = ids
+	}
+	if req.Labels != nil {
+		payload["labels"] = strings.Join(*req.Labels, ",")
+	}
+	if req.Milestone != nil {
+		// Zero removes the milestone
+		id := 0
+		if *req.Milestone != "" {
+			var err error
+			if id, err = c.milestoneID(ctx, *req.Milestone); err != nil {
+				return nil, err
+			}
+		}
+		payload["milestone_id"] = id
+	}
+
+	var resp IssueResponse
+	err := c.httpClient.DoJSONRequest(ctx, "PUT", c.projectURL("/issues/%d", issueNumber), payload, &resp, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to update issue: %w", err)
+	}
+
+	return resp.ToIssue(), nil
+}
+
+// ListIssues lists issues with optional state filter (open, closed, or all)
+func (c *HTTPClient) ListIssues(ctx context.Context, state string) ([]*models.Issue, error) {
+	u := c.projectURL("/issues?state=%s&per_page=100", gitlabState(state))
+
+	var issues []IssueResponse
+	err := c.httpClient.DoJSONRequest(ctx, "GET", u, nil, &issues, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list issues: %w", err)
+	}
+
+	result := make([]*models.Issue, len(issues))
+	for i, issue := range issues {
+		result[i] = issue.ToIssue()
+	}
+
+	return result, nil
+}
+
+// GetIssueTemplate retrieves the default issue template
+func (c *HTTPClient) GetIssueTemplate(ctx context.Context) (string, error) {
+	templatePaths := []string{
+		".gitlab/issue_templates/Default.md",
+		".gitlab/issue_templates/default.md",
+	}
+	return c.getTemplateFromPaths(ctx, templatePaths)
+}
+
+// userIDs resolves usernames to user IDs
+func (c *HTTPClient) userIDs(ctx context.Context, usernames []string) ([]int, error) {
+	ids := make([]int, 0, len(usernames))
+	for _, username := range usernames {
+		u := fmt.Sprintf("%s/users?username=%s", c.apiURL, url.QueryEscape(strings.TrimPrefix(username, "@")))
+
+		var users []UserResponse
+		if err := c.httpClient.DoJSONRequest(ctx, "GET", u, nil, &users, c.headers()); err != nil {
+			return nil, fmt.Errorf("failed to look up user %s: %w", username, err)
+		}
+		if len(users) == 0 {
+			return nil, fmt.Errorf("user not found: %s", username)
+		}
+		ids = append(ids, users[0].ID)
+	}
+	return ids, nil
+}
+
+// milestoneID resolves a project milestone by title
+func (c *HTTPClient) milestoneID(ctx context.Context, title string) (int, error) {
+	var milestones []MilestoneResponse
+	u := c.projectURL("/milestones?title=%s", url.QueryEscape(title))
+	if err := c.httpClient.DoJSONRequest(ctx, "GET", u, nil, &milestones, c.headers()); err != nil {
+		return 0, fmt.Errorf("failed to look up milestone %s: %w", title, err)
+	}
+	if len(milestones) == 0 {
+		return 0, fmt.Errorf("milestone not found: %s", title)
+	}
+	return milestones[0].ID, nil
+}
+
+// reviewState maps a GitLab reviewer state onto a GitHub review state.
+// Reviewers who haven't reviewed yet are skipped, like GitHub review requests.
+func reviewState(state string) string {
+	switch state {
+	case "approved":
+		return "APPROVED"
+	case "requested_changes":
+		return "CHANGES_REQUESTED"
+	case "reviewed":
+		return "COMMENTED"
+	default:
+		return ""
+	}
+}
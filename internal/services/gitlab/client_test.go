@@ -0,0 +1,307 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rithyhuot/vibe/internal/models"
+	"github.com/rithyhuot/vibe/internal/utils"
+)
+
+// createTestClient creates a test HTTPClient configured to use the test server
+func createTestClient(serverURL string) *HTTPClient {
+	return &HTTPClient{
+		httpClient: utils.NewHTTPClient(0),
+		token:      "test-token",
+		project:    "group/sub/repo",
+		apiURL:     serverURL,
+	}
+}
+
+// mustEncode encodes a response to JSON, ignoring errors (for test code)
+func mustEncode(w http.ResponseWriter, v interface{}) {
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// mustDecode decodes a JSON request, ignoring errors (for test code)
+func mustDecode(r *http.Request, v interface{}) {
+	_ = json.NewDecoder(r.Body).Decode(v)
+}
+
+const projectPath = "/projects/group%2Fsub%2Frepo"
+
+func TestGetPRStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); auth != "Bearer test-token" {
+			t.Errorf("Authorization = %q", auth)
+		}
+		switch r.URL.EscapedPath() {
+		case projectPath + "/merge_requests/7":
+			mustEncode(w, MergeRequestResponse{
+				IID:          7,
+				Title:        "Add export",
+				State:        "opened",
+				SourceBranch: "feature",
+				HeadPipeline: &PipelineResponse{ID: 100},
+			})
+		case projectPath + "/merge_requests/7/reviewers":
+			mustEncode(w, []ReviewerResponse{
+				{User: UserResponse{Username: "ada"}, State: "reviewed"},
+				{User: UserResponse{Username: "bob"}, State: "requested_changes"},
+				{User: UserResponse{Username: "cy"}, State: "unreviewed"},
+			})
+		case projectPath + "/merge_requests/7/approvals":
+			_, _ = w.Write([]byte(`{"approved_by": [{"user": {"username": "ada"}}, {"user": {"username": "dee"}}]}`))
+		case projectPath + "/pipelines/100/jobs":
+			mustEncode(w, []JobResponse{
+				{ID: 3, Name: "deploy", Status: "manual", AllowFailure: true},
+				{ID: 2, Name: "lint", Status: "failed", AllowFailure: true},
+				{ID: 1, Name: "test", Status: "running"},
+			})
+		default:
+			t.Errorf("unexpected request: %s", r.URL.EscapedPath())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	status, err := createTestClient(server.URL).GetPRStatus(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("GetPRStatus() error = %v", err)
+	}
+
+	if status.State != "open" || status.Merged {
+		t.Errorf("unexpected state: %s merged=%v", status.State, status.Merged)
+	}
+
+	want := []models.Review{
+		{Reviewer: "ada", State: "APPROVED"},
+		{Reviewer: "bob", State: "CHANGES_REQUESTED"},
+		{Reviewer: "dee", State: "APPROVED"},
+	}
+	if len(status.ReviewStatus.Reviews) != len(want) {
+		t.Fatalf("reviews = %+v", status.ReviewStatus.Reviews)
+	}
+	for i, review := range want {
+		if status.ReviewStatus.Reviews[i] != review {
+			t.Errorf("review %d = %+v, want %+v", i, status.ReviewStatus.Reviews[i], review)
+		}
+	}
+	if status.ReviewStatus.OverallStatus != "changes_requested" {
+		t.Errorf("review status = %s", status.ReviewStatus.OverallStatus)
+	}
+
+	// Jobs allowed to fail don't block; the running job is pending
+	if status.CheckStatus.Passed != 2 || status.CheckStatus.Pending != 1 || status.CheckStatus.OverallStatus != "pending" {
+		t.Errorf("unexpected check status: %+v", status.CheckStatus)
+	}
+	if status.CheckStatus.Checks[0].Name != "test" {
+		t.Errorf("checks should be in pipeline order, got %+v", status.CheckStatus.Checks)
+	}
+}
+
+func TestGetPRForBranch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("source_branch"); got != "user/abc123xyz/fix" {
+			t.Errorf("source_branch = %q", got)
+		}
+		if got := r.URL.Query().Get("state"); got != "opened" {
+			t.Errorf("state = %q", got)
+		}
+		mustEncode(w, []MergeRequestResponse{{IID: 3, State: "merged"}})
+	}))
+	defer server.Close()
+
+	pr, err := createTestClient(server.URL).GetPRForBranch(context.Background(), "user/abc123xyz/fix")
+	if err != nil {
+		t.Fatalf("GetPRForBranch() error = %v", err)
+	}
+	if pr.Number != 3 || pr.State != "closed" || !pr.Merged {
+		t.Errorf("unexpected PR: %+v", pr)
+	}
+}
+
+func TestUpdateIssue_StateAndAssignees(t *testing.T) {
+	var payload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/users":
+			mustEncode(w, []UserResponse{{ID: 42, Username: r.URL.Query().Get("username")}})
+		case r.Method == "PUT":
+			mustDecode(r, &payload)
+			mustEncode(w, IssueResponse{IID: 5, State: "closed", Labels: []string{"bug"}})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	state := "closed"
+	assignees := []string{"@ada"}
+	labels := []string{"bug", "ui"}
+	issue, err := createTestClient(server.URL).UpdateIssue(context.Background(), 5, &models.IssueUpdateRequest{
+		State:     &state,
+		Assignees: &assignees,
+		Labels:    &labels,
+	})
+	if err != nil {
+		t.Fatalf("UpdateIssue() error = %v", err)
+	}
+
+	if issue.State != "closed" || issue.Labels[0].Name != "bug" {
+		t.Errorf("unexpected issue: %+v", issue)
+	}
+	if payload["state_event"] != "close" {
+		t.Errorf("state_event = %v", payload["state_event"])
+	}
+	if ids, _ := payload["assignee_ids"].([]interface{}); len(ids) != 1 || ids[0] != float64(42) {
+		t.Errorf("assignee_ids = %v", payload["assignee_ids"])
+	}
+	if payload["labels"] != "bug,ui" {
+		t.Errorf("labels = %v", payload["labels"])
+	}
+}
+
+func TestUpdatePR_TargetBranch(t *testing.T) {
+	var payload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.EscapedPath() != projectPath+"/merge_requests/7" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.EscapedPath())
+		}
+		mustDecode(r, &payload)
+		mustEncode(w, MergeRequestResponse{IID: 7, TargetBranch: "main"})
+	}))
+	defer server.Close()
+
+	base := "main"
+	pr, err := createTestClient(server.URL).UpdatePR(context.Background(), 7, &models.PRUpdateRequest{Base: &base})
+	if err != nil {
+		t.Fatalf("UpdatePR() error = %v", err)
+	}
+
+	if len(payload) != 1 || payload["target_branch"] != "main" {
+		t.Errorf("payload = %v", payload)
+	}
+	if pr.Base.Ref != "main" {
+		t.Errorf("base = %q", pr.Base.Ref)
+	}
+}
+
+func TestGetPipelineStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case projectPath + "/pipelines":
+			if got := r.URL.Query().Get("ref"); got != "feature" {
+				t.Errorf("ref = %q", got)
+			}
+			mustEncode(w, []PipelineResponse{{ID: 100, IID: 12, Status: "failed"}})
+		case projectPath + "/pipelines/100/jobs":
+			mustEncode(w, []JobResponse{
+				{ID: 2, Name: "test", Stage: "test", Status: "failed"},
+				{ID: 1, Name: "build", Stage: "build", Status: "success"},
+			})
+		case projectPath + "/pipelines/100/test_report":
+			_, _ = w.Write([]byte(`{"test_suites": [{"name": "test", "test_cases": [
+				{"name": "TestA", "status": "success"},
+				{"name": "TestB", "status": "failed", "system_output": "boom"}
+			]}]}`))
+		default:
+			t.Errorf("unexpected request: %s", r.URL.EscapedPath())
+		}
+	}))
+	defer server.Close()
+
+	status, err := createTestClient(server.URL).GetPipelineStatus(context.Background(), "feature")
+	if err != nil {
+		t.Fatalf("GetPipelineStatus() error = %v", err)
+	}
+
+	if status.Pipeline.IID != 12 || len(status.Jobs) != 2 || status.Jobs[0].Name != "build" {
+		t.Errorf("unexpected status: %+v", status)
+	}
+	if failed := status.FailedTests["test"]; len(failed) != 1 || failed[0].Name != "TestB" {
+		t.Errorf("failed tests = %+v", status.FailedTests)
+	}
+}
+
+func TestCleanTrace(t *testing.T) {
+	trace := "\x1b[0Ksection_start:1700000000:step_script\r\x1b[0K\x1b[32;1mExecuting step\x1b[0;m\r\n" +
+		"Downloading 10%\rDownloading 100%\n" +
+		"\x1b[31mFAIL\x1b[0m\n" +
+		"\x1b[0Ksection_end:1700000001:step_script\r\x1b[0K\n"
+
+	want := "Executing step\nDownloading 100%\nFAIL"
+	if got := CleanTrace(trace); got != want {
+		t.Errorf("CleanTrace() = %q, want %q", got, want)
+	}
+}
+
+func TestIsGitLabHost(t *testing.T) {
+	tests := map[string]bool{
+		"gitlab.com":         true,
+		"gitlab.example.com": true,
+		"git.example.com":    true, // Listed in hosts
+		"github.com":         false,
+	}
+	for host, want := range tests {
+		if got := IsGitLabHost(host, []string{"Git.Example.com"}); got != want {
+			t.Errorf("IsGitLabHost(%q) = %v, want %v", host, got, want)
+		}
+	}
+}
+
+func TestAddPRMetadata(t *testing.T) {
+	userIDs := map[string]int{"ada": 1, "grace": 2, "linus": 3}
+	var payload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/users":
+			username := r.URL.Query().Get("username")
+			mustEncode(w, []UserResponse{{ID: userIDs[username], Username: username}})
+		case r.Method == "GET":
+			mustEncode(w, MergeRequestResponse{
+				IID:       8,
+				Reviewers: []UserResponse{{ID: 3, Username: "linus"}},
+				Assignees: []UserResponse{{ID: 1, Username: "ada"}},
+			})
+		case r.Method == "PUT":
+			mustDecode(r, &payload)
+			mustEncode(w, MergeRequestResponse{IID: 8})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	err := createTestClient(server.URL).AddPRMetadata(context.Background(), 8, &models.PRMetadata{
+		Reviewers: []string{"@ada", "grace"},
+		Labels:    []string{"bug", "backend"},
+		Assignees: []string{"ada"},
+	})
+	if err != nil {
+		t.Fatalf("AddPRMetadata() error = %v", err)
+	}
+
+	// Existing reviewers and assignees are kept
+	if ids, _ := payload["reviewer_ids"].([]interface{}); len(ids) != 3 || ids[0] != float64(1) || ids[1] != float64(2) || ids[2] != float64(3) {
+		t.Errorf("reviewer_ids = %v", payload["reviewer_ids"])
+	}
+	if ids, _ := payload["assignee_ids"].([]interface{}); len(ids) != 1 || ids[0] != float64(1) {
+		t.Errorf("assignee_ids = %v", payload["assignee_ids"])
+	}
+	if payload["add_labels"] != "bug,backend" {
+		t.Errorf("add_labels = %v", payload["add_labels"])
+	}
+}
+
+func TestAddPRMetadata_TeamReviewers(t *testing.T) {
+	err := createTestClient("http://127.0.0.1:0").AddPRMetadata(context.Background(), 8, &models.PRMetadata{
+		TeamReviewers: []string{"backend"},
+	})
+	if err == nil {
+		t.Error("expected an error for team reviewers")
+	}
+}
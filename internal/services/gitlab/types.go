@@ -0,0 +1,229 @@
+package gitlab
+
+import (
+	"time"
+
+	"github.com/rithyhuot/vibe/internal/models"
+)
+
+// API response structures
+
+// UserResponse represents a user in API responses
+type UserResponse struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+	Name     string `json:"name"`
+}
+
+// MergeRequestResponse represents a merge request in API responses
+type MergeRequestResponse struct {
+	IID          int               `json:"iid"`
+	Title        string            `json:"title"`
+	Description  string            `json:"description"`
+	State        string            `json:"state"` // opened, closed, merged, or locked
+	Draft        bool              `json:"draft"`
+	WebURL       string            `json:"web_url"`
+	SourceBranch string            `json:"source_branch"`
+	TargetBranch string            `json:"target_branch"`
+	SHA          string            `json:"sha"`
+	HasConflicts bool              `json:"has_conflicts"`
+	Author       UserResponse      `json:"author"`
+	Assignees    []UserResponse    `json:"assignees"`
+	Reviewers    []UserResponse    `json:"reviewers"`
+	HeadPipeline *PipelineResponse `json:"head_pipeline"`
+	CreatedAt    time.Time         `json:"created_at"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+	MergedAt     *time.Time        `json:"merged_at"`
+}
+
+// ApprovalsResponse represents the approval state of a merge request
+type ApprovalsResponse struct {
+	ApprovedBy []struct {
+		User UserResponse `json:"user"`
+	} `json:"approved_by"`
+}
+
+// ReviewerResponse represents a reviewer of a merge request
+type ReviewerResponse struct {
+	User  UserResponse `json:"user"`
+	State string       `json:"state"` // unreviewed, reviewed, requested_changes, approved, or unapproved
+}
+
+// IssueResponse represents an issue in API responses
+type IssueResponse struct {
+	IID         int                `json:"iid"`
+	Title       string             `json:"title"`
+	Description string             `json:"description"`
+	State       string             `json:"state"` // opened or closed
+	WebURL      string             `json:"web_url"`
+	Author      UserResponse       `json:"author"`
+	Assignees   []UserResponse     `json:"assignees"`
+	Labels      []string           `json:"labels"`
+	Milestone   *MilestoneResponse `json:"milestone"`
+	CreatedAt   time.Time          `json:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at"`
+	ClosedAt    *time.Time         `json:"closed_at"`
+}
+
+// MilestoneResponse represents a milestone in API responses
+type MilestoneResponse struct {
+	ID          int    `json:"id"`
+	IID         int    `json:"iid"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	State       string `json:"state"`
+}
+
+// NoteResponse represents a comment on an issue or merge request
+type NoteResponse struct {
+	ID        int          `json:"id"`
+	Body      string       `json:"body"`
+	Author    UserResponse `json:"author"`
+	System    bool         `json:"system"` // Notes generated by GitLab, e.g. "changed the description"
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+// FileResponse represents a repository file
+type FileResponse struct {
+	Content  string `json:"content"`
+	Encoding string `json:"encoding"`
+}
+
+// PipelineResponse represents a pipeline in API responses
+type PipelineResponse struct {
+	ID        int       `json:"id"`
+	IID       int       `json:"iid"`
+	Status    string    `json:"status"`
+	Ref       string    `json:"ref"`
+	SHA       string    `json:"sha"`
+	WebURL    string    `json:"web_url"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// JobResponse represents a pipeline job in API responses
+type JobResponse struct {
+	ID           int        `json:"id"`
+	Name         string     `json:"name"`
+	Stage        string     `json:"stage"`
+	Status       string     `json:"status"`
+	AllowFailure bool       `json:"allow_failure"`
+	WebURL       string     `json:"web_url"`
+	StartedAt    *time.Time `json:"started_at"`
+	FinishedAt   *time.Time `json:"finished_at"`
+}
+
+// TestReportResponse represents the test report of a pipeline
+type TestReportResponse struct {
+	TestSuites []TestSuite `json:"test_suites"`
+}
+
+// TestSuite represents the test results of a job; its name is the job name
+type TestSuite struct {
+	Name      string     `json:"name"`
+	TestCases []TestCase `json:"test_cases"`
+}
+
+// TestCase represents a single test result
+type TestCase struct {
+	Name          string  `json:"name"`
+	Classname     string  `json:"classname"`
+	File          string  `json:"file"`
+	Status        string  `json:"status"` // success, failed, skipped, or error
+	SystemOutput  string  `json:"system_output"`
+	ExecutionTime float64 `json:"execution_time"`
+}
+
+// PipelineStatus is the latest pipeline of a branch with its jobs and failed tests
+type PipelineStatus struct {
+	Branch      string
+	Project     string
+	Pipeline    PipelineResponse
+	Jobs        []JobResponse         // In pipeline order
+	FailedTests map[string][]TestCase // Failed test cases by job name
+}
+
+// ToPullRequest converts a MergeRequestResponse to models.PullRequest. Merge
+// request states are mapped onto GitHub's: opened is open, anything else is
+// closed, with Merged set for merged requests.
+func (mr *MergeRequestResponse) ToPullRequest() *models.PullRequest {
+	state := "closed"
+	if mr.State == "opened" {
+		state = "open"
+	}
+
+	return &models.PullRequest{
+		Number:    mr.IID,
+		Title:     mr.Title,
+		Body:      mr.Description,
+		State:     state,
+		Draft:     mr.Draft,
+		Merged:    mr.State == "merged",
+		Mergeable: !mr.HasConflicts,
+		URL:       mr.WebURL,
+		Head:      models.Branch{Ref: mr.SourceBranch, SHA: mr.SHA},
+		Base:      models.Branch{Ref: mr.TargetBranch},
+		User:      mr.Author.toUser(),
+		CreatedAt: mr.CreatedAt,
+		UpdatedAt: mr.UpdatedAt,
+		MergedAt:  mr.MergedAt,
+	}
+}
+
+// ToIssue converts an IssueResponse to models.Issue
+func (ir *IssueResponse) ToIssue() *models.Issue {
+	state := ir.State
+	if state == "opened" {
+		state = "open"
+	}
+
+	issue := &models.Issue{
+		Number:    ir.IID,
+		Title:     ir.Title,
+		Body:      ir.Description,
+		State:     state,
+		URL:       ir.WebURL,
+		User:      ir.Author.toUser(),
+		CreatedAt: ir.CreatedAt,
+		UpdatedAt: ir.UpdatedAt,
+		ClosedAt:  ir.ClosedAt,
+	}
+
+	issue.Assignees = make([]models.GitHubUser, len(ir.Assignees))
+	for i, a := range ir.Assignees {
+		issue.Assignees[i] = a.toUser()
+	}
+
+	issue.Labels = make([]models.Label, len(ir.Labels))
+	for i, name := range ir.Labels {
+		issue.Labels[i] = models.Label{Name: name}
+	}
+
+	if ir.Milestone != nil {
+		issue.Milestone = &models.Milestone{
+			Number:      ir.Milestone.IID,
+			Title:       ir.Milestone.Title,
+			Description: ir.Milestone.Description,
+			State:       ir.Milestone.State,
+		}
+	}
+
+	return issue
+}
+
+// toUser converts a UserResponse to models.GitHubUser
+func (u UserResponse) toUser() models.GitHubUser {
+	return models.GitHubUser{Login: u.Username, ID: u.ID}
+}
+
+// gitlabState converts a GitHub-style state filter to GitLab's
+func gitlabState(state string) string {
+	switch state {
+	case "open":
+		return "opened"
+	case "":
+		return "all"
+	default:
+		return state
+	}
+}
@@ -0,0 +1,136 @@
+package jira
+
+import (
+	"fmt"
+	"strings"
+)
+
+// adfNode is a node of an Atlassian Document Format (ADF) document, the rich
+// text format used by Jira Cloud's API v3 for descriptions and comments
+type adfNode struct {
+	Type    string         `json:"type"`
+	Version int            `json:"version,omitempty"`
+	Text    string         `json:"text,omitempty"`
+	Attrs   map[string]any `json:"attrs,omitempty"`
+	Content []*adfNode     `json:"content,omitempty"`
+}
+
+// adfToText renders an ADF document as plain text. Formatting marks are
+// dropped; block structure is kept as line breaks and list markers.
+func adfToText(doc *adfNode) string {
+	var b strings.Builder
+	writeADFBlocks(&b, doc.Content, "")
+	return strings.TrimSpace(b.String())
+}
+
+// writeADFBlocks writes block-level nodes, prefixing each line with indent
+func writeADFBlocks(b *strings.Builder, nodes []*adfNode, indent string) {
+	for _, node := range nodes {
+		switch node.Type {
+		case "bulletList", "orderedList":
+			for i, item := range node.Content {
+				marker := "- "
+				if node.Type == "orderedList" {
+					marker = fmt.Sprintf("%d. ", i+1)
+				}
+				b.WriteString(indent + marker)
+				writeADFListItem(b, item, indent+"  ")
+			}
+			b.WriteString("\n")
+		case "rule":
+			b.WriteString(indent + "---\n\n")
+		default:
+			if isADFInline(node) {
+				writeADFInline(b, node)
+				continue
+			}
+			b.WriteString(indent)
+			writeADFInlineContent(b, node.Content, indent)
+			b.WriteString("\n\n")
+		}
+	}
+}
+
+// writeADFListItem writes the first paragraph of a list item inline with its
+// marker and any nested blocks below it
+func writeADFListItem(b *strings.Builder, item *adfNode, indent string) {
+	for i, child := range item.Content {
+		if i == 0 && child.Type == "paragraph" {
+			writeADFInlineContent(b, child.Content, indent)
+			b.WriteString("\n")
+			continue
+		}
+		var nested strings.Builder
+		writeADFBlocks(&nested, []*adfNode{child}, indent)
+		b.WriteString(strings.TrimRight(nested.String(), "\n") + "\n")
+	}
+}
+
+// writeADFInlineContent writes the inline children of a block node
+func writeADFInlineContent(b *strings.Builder, nodes []*adfNode, indent string) {
+	for _, node := range nodes {
+		if node.Type == "hardBreak" {
+			b.WriteString("\n" + indent)
+			continue
+		}
+		if isADFInline(node) {
+			writeADFInline(b, node)
+			continue
+		}
+		// Nested blocks inside e.g. a panel or table cell
+		writeADFInlineContent(b, node.Content, indent)
+	}
+}
+
+// writeADFInline writes a single inline node
+func writeADFInline(b *strings.Builder, node *adfNode) {
+	switch node.Type {
+	case "text":
+		b.WriteString(node.Text)
+	case "mention", "emoji":
+		if text, ok := node.Attrs["text"].(string); ok {
+			b.WriteString(text)
+		}
+	case "inlineCard":
+		if url, ok := node.Attrs["url"].(string); ok {
+			b.WriteString(url)
+		}
+	}
+}
+
+// isADFInline reports whether a node is an inline node
+func isADFInline(node *adfNode) bool {
+	switch node.Type {
+	case "text", "mention", "emoji", "inlineCard", "hardBreak":
+		return true
+	default:
+		return false
+	}
+}
+
+// textToADF converts plain text to an ADF document. Blank lines separate
+// paragraphs and single newlines become hard breaks.
+func textToADF(text string) *adfNode {
+	doc := &adfNode{Type: "doc", Version: 1, Content: []*adfNode{}}
+
+	normalized := strings.ReplaceAll(strings.TrimSpace(text), "\r\n", "\n")
+	for _, paragraph := range strings.Split(normalized, "\n\n") {
+		paragraph = strings.Trim(paragraph, "\n")
+		if strings.TrimSpace(paragraph) == "" {
+			continue
+		}
+
+		node := &adfNode{Type: "paragraph"}
+		for i, line := range strings.Split(paragraph, "\n") {
+			if i > 0 {
+				node.Content = append(node.Content, &adfNode{Type: "hardBreak"})
+			}
+			if line != "" {
+				node.Content = append(node.Content, &adfNode{Type: "text", Text: line})
+			}
+		}
+		doc.Content = append(doc.Content, node)
+	}
+
+	return doc
+}
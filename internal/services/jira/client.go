@@ -0,0 +1,249 @@
+// Package jira provides a client for interacting with the Jira Cloud and Jira
+// Server/Data Center REST APIs.
+package jira
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/rithyhuot/vibe/internal/models"
+	"github.com/rithyhuot/vibe/internal/utils"
+)
+
+const (
+	// DeploymentCloud is Jira Cloud (*.atlassian.net), which uses REST API v3
+	// and authenticates with an account email and API token
+	DeploymentCloud = "cloud"
+
+	// DeploymentServer is Jira Server or Data Center, which uses REST API v2
+	// and authenticates with a personal access token
+	DeploymentServer = "server"
+)
+
+// Client interface defines Jira operations
+type Client interface {
+	GetIssue(ctx context.Context, key string) (*models.Task, error)
+	SearchIssues(ctx context.Context, jql string, maxResults int) ([]*models.Task, error)
+	GetTransitions(ctx context.Context, key string) ([]Transition, error)
+	TransitionIssue(ctx context.Context, key string, transitionID string) error
+	AddComment(ctx context.Context, key string, body string) (*models.Comment, error)
+	CreateIssue(ctx context.Context, req *CreateIssueRequest) (string, error)
+	GetMyself(ctx context.Context) (*User, error)
+}
+
+// HTTPClient implements the Client interface using HTTP
+type HTTPClient struct {
+	httpClient *utils.HTTPClient
+	baseURL    string
+	deployment string
+	authHeader string
+}
+
+// NewClient creates a new Jira HTTP client. Cloud deployments authenticate
+// with email and API token; Server deployments with a personal access token.
+func NewClient(baseURL, email, apiToken, deployment string) *HTTPClient {
+	authHeader := "Bearer " + apiToken
+	if deployment == DeploymentCloud {
+		credentials := base64.StdEncoding.EncodeToString([]byte(email + ":" + apiToken))
+		authHeader = "Basic " + credentials
+	}
+
+	return &HTTPClient{
+		httpClient: utils.NewHTTPClient(0). // Use default timeout from HTTPClient
+							WithUserAgent("vibe"),
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		deployment: deployment,
+		authHeader: authHeader,
+	}
+}
+
+// WithRetryPolicy sets the retry budget for requests made by this client
+func (c *HTTPClient) WithRetryPolicy(policy utils.RetryPolicy) *HTTPClient {
+	c.httpClient.WithRetryPolicy(policy)
+	return c
+}
+
+// WithCache enables on-disk caching of GET responses made by this client
+func (c *HTTPClient) WithCache(cache *utils.ResponseCache) *HTTPClient {
+	c.httpClient.WithCache(cache)
+	return c
+}
+
+// isCloud reports whether the client talks to Jira Cloud
+func (c *HTTPClient) isCloud() bool {
+	return c.deployment == DeploymentCloud
+}
+
+// apiURL returns the URL of a REST API path for the configured deployment
+func (c *HTTPClient) apiURL(path string) string {
+	version := "2"
+	if c.isCloud() {
+		version = "3"
+	}
+	return fmt.Sprintf("%s/rest/api/%s/%s", c.baseURL, version, path)
+}
+
+// headers returns the common headers for Jira API requests
+func (c *HTTPClient) headers() map[string]string {
+	return map[string]string{
+		"Authorization": c.authHeader,
+		"Accept":        "application/json",
+	}
+}
+
+// GetIssue retrieves a single issue by key
+func (c *HTTPClient) GetIssue(ctx context.Context, key string) (*models.Task, error) {
+	u := c.apiURL("issue/" + url.PathEscape(key) + "?fields=" + issueFields)
+
+	var resp IssueResponse
+	err := c.httpClient.DoJSONRequest(ctx, "GET", u, nil, &resp, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue: %w", err)
+	}
+
+	return resp.ToTask(c.baseURL), nil
+}
+
+// SearchIssues returns the issues matching a JQL query
+func (c *HTTPClient) SearchIssues(ctx context.Context, jql string, maxResults int) ([]*models.Task, error) {
+	query := url.Values{}
+	query.Add("jql", jql)
+	query.Add("fields", issueFields)
+	query.Add("maxResults", strconv.Itoa(maxResults))
+
+	// Jira Cloud replaced /search with the paginated /search/jql endpoint
+	path := "search"
+	if c.isCloud() {
+		path = "search/jql"
+	}
+	u := c.apiURL(path + "?" + query.Encode())
+
+	var resp SearchResponse
+	err := c.httpClient.DoJSONRequest(ctx, "GET", u, nil, &resp, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to search issues: %w", err)
+	}
+
+	tasks := make([]*models.Task, len(resp.Issues))
+	for i := range resp.Issues {
+		tasks[i] = resp.Issues[i].ToTask(c.baseURL)
+	}
+
+	return tasks, nil
+}
+
+// GetTransitions returns the workflow transitions available on an issue
+func (c *HTTPClient) GetTransitions(ctx context.Context, key string) ([]Transition, error) {
+	u := c.apiURL("issue/" + url.PathEscape(key) + "/transitions")
+
+	var resp TransitionsResponse
+	err := c.httpClient.DoJSONRequest(ctx, "GET", u, nil, &resp, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transitions: %w", err)
+	}
+
+	return resp.Transitions, nil
+}
+
+// TransitionIssue moves an issue through a workflow transition
+func (c *HTTPClient) TransitionIssue(ctx context.Context, key string, transitionID string) error {
+	u := c.apiURL("issue/" + url.PathEscape(key) + "/transitions")
+
+	req := map[string]any{
+		"transition": map[string]string{"id": transitionID},
+	}
+
+	if err := c.httpClient.DoJSONRequest(ctx, "POST", u, req, nil, c.headers()); err != nil {
+		return fmt.Errorf("failed to transition issue: %w", err)
+	}
+
+	return nil
+}
+
+// AddComment adds a comment to an issue
+func (c *HTTPClient) AddComment(ctx context.Context, key string, body string) (*models.Comment, error) {
+	u := c.apiURL("issue/" + url.PathEscape(key) + "/comment")
+
+	req := map[string]any{"body": c.richText(body)}
+
+	var resp struct {
+		ID      string `json:"id"`
+		Author  User   `json:"author"`
+		Created string `json:"created"`
+	}
+	err := c.httpClient.DoJSONRequest(ctx, "POST", u, req, &resp, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to add comment: %w", err)
+	}
+
+	return &models.Comment{
+		ID:          resp.ID,
+		CommentText: body,
+		Comment:     []models.Content{{Text: body}},
+		User: models.User{
+			Username: resp.Author.DisplayName,
+			Email:    resp.Author.EmailAddress,
+		},
+		DateCreated: resp.Created,
+	}, nil
+}
+
+// CreateIssue creates an issue and returns its key
+func (c *HTTPClient) CreateIssue(ctx context.Context, req *CreateIssueRequest) (string, error) {
+	fields := map[string]any{
+		"project":   map[string]string{"key": req.ProjectKey},
+		"issuetype": map[string]string{"name": req.IssueType},
+		"summary":   req.Summary,
+	}
+	if req.Description != "" {
+		fields["description"] = c.richText(req.Description)
+	}
+	if len(req.Labels) > 0 {
+		fields["labels"] = req.Labels
+	}
+	if req.Priority != "" {
+		fields["priority"] = map[string]string{"name": req.Priority}
+	}
+	if req.Assignee != "" {
+		if c.isCloud() {
+			fields["assignee"] = map[string]string{"accountId": req.Assignee}
+		} else {
+			fields["assignee"] = map[string]string{"name": req.Assignee}
+		}
+	}
+	if req.DueDate != nil {
+		fields["duedate"] = req.DueDate.Format("2006-01-02")
+	}
+
+	var resp CreateIssueResponse
+	err := c.httpClient.DoJSONRequest(ctx, "POST", c.apiURL("issue"), map[string]any{"fields": fields}, &resp, c.headers())
+	if err != nil {
+		return "", fmt.Errorf("failed to create issue: %w", err)
+	}
+
+	return resp.Key, nil
+}
+
+// GetMyself returns the authenticated user
+func (c *HTTPClient) GetMyself(ctx context.Context) (*User, error) {
+	var user User
+	err := c.httpClient.DoJSONRequest(ctx, "GET", c.apiURL("myself"), nil, &user, c.headers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current user: %w", err)
+	}
+
+	return &user, nil
+}
+
+// richText returns text in the format the API expects for rich text fields:
+// an ADF document for API v3 and a plain string for API v2
+func (c *HTTPClient) richText(text string) any {
+	if c.isCloud() {
+		return textToADF(text)
+	}
+	return text
+}
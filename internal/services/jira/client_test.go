@@ -0,0 +1,187 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// mustEncode encodes a response to JSON, ignoring errors (for test code)
+func mustEncode(w http.ResponseWriter, v interface{}) {
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// mustDecode decodes a JSON request, ignoring errors (for test code)
+func mustDecode(r *http.Request, v interface{}) {
+	_ = json.NewDecoder(r.Body).Decode(v)
+}
+
+func TestGetIssue_Cloud(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/ABC-123" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if auth := r.Header.Get("Authorization"); !strings.HasPrefix(auth, "Basic ") {
+			t.Errorf("expected basic auth, got %q", auth)
+		}
+		_, _ = w.Write([]byte(`{
+			"id": "10001",
+			"key": "ABC-123",
+			"fields": {
+				"summary": "Fix login",
+				"description": {"type": "doc", "version": 1, "content": [
+					{"type": "paragraph", "content": [{"type": "text", "text": "First line"}]},
+					{"type": "bulletList", "content": [
+						{"type": "listItem", "content": [{"type": "paragraph", "content": [{"type": "text", "text": "item"}]}]}
+					]}
+				]},
+				"status": {"name": "In Progress", "statusCategory": {"key": "indeterminate", "colorName": "yellow"}},
+				"priority": {"id": "2", "name": "High"},
+				"assignee": {"accountId": "abc", "displayName": "Ada", "emailAddress": "ada@example.com"},
+				"labels": ["backend"],
+				"duedate": "2026-01-31",
+				"issuetype": {"name": "Bug"},
+				"project": {"key": "ABC"}
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "ada@example.com", "token", DeploymentCloud)
+	task, err := client.GetIssue(context.Background(), "ABC-123")
+	if err != nil {
+		t.Fatalf("GetIssue() error = %v", err)
+	}
+
+	if task.ID != "ABC-123" || task.Name != "Fix login" {
+		t.Errorf("unexpected task: %s %s", task.ID, task.Name)
+	}
+	if task.Description != "First line\n\n- item" {
+		t.Errorf("Description = %q", task.Description)
+	}
+	if task.Status.Status != "In Progress" || task.Status.Type != "custom" {
+		t.Errorf("unexpected status: %+v", task.Status)
+	}
+	if task.Priority == nil || task.Priority.Priority != "high" {
+		t.Errorf("unexpected priority: %+v", task.Priority)
+	}
+	if task.URL != server.URL+"/browse/ABC-123" {
+		t.Errorf("URL = %q", task.URL)
+	}
+	if task.DueDate == nil || task.DueDate.Format("2006-01-02") != "2026-01-31" {
+		t.Errorf("unexpected due date: %v", task.DueDate)
+	}
+}
+
+func TestGetIssue_ServerPlainDescription(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/2/issue/OPS-7" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if auth := r.Header.Get("Authorization"); auth != "Bearer pat" {
+			t.Errorf("expected bearer auth, got %q", auth)
+		}
+		_, _ = w.Write([]byte(`{"key": "OPS-7", "fields": {"summary": "Rotate keys", "description": "Plain text",
+			"status": {"name": "To Do", "statusCategory": {"key": "new"}}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "", "pat", DeploymentServer)
+	task, err := client.GetIssue(context.Background(), "OPS-7")
+	if err != nil {
+		t.Fatalf("GetIssue() error = %v", err)
+	}
+
+	if task.Description != "Plain text" {
+		t.Errorf("Description = %q", task.Description)
+	}
+	if task.Status.Type != "open" {
+		t.Errorf("Status.Type = %q, want open", task.Status.Type)
+	}
+}
+
+func TestAddComment_SendsADFOnCloud(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mustDecode(r, &body)
+		mustEncode(w, map[string]any{"id": "1", "author": map[string]string{"displayName": "Ada"}})
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "ada@example.com", "token", DeploymentCloud)
+	if _, err := client.AddComment(context.Background(), "ABC-1", "Hello\nworld"); err != nil {
+		t.Fatalf("AddComment() error = %v", err)
+	}
+
+	doc, ok := body["body"].(map[string]any)
+	if !ok || doc["type"] != "doc" {
+		t.Fatalf("expected ADF document body, got %v", body["body"])
+	}
+	paragraphs := doc["content"].([]any)
+	content := paragraphs[0].(map[string]any)["content"].([]any)
+	if len(content) != 3 {
+		t.Errorf("expected text, hardBreak, text; got %v", content)
+	}
+}
+
+func TestCreateIssue(t *testing.T) {
+	var body struct {
+		Fields map[string]any `json:"fields"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/rest/api/2/issue" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		mustDecode(r, &body)
+		mustEncode(w, CreateIssueResponse{ID: "10002", Key: "OPS-8"})
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "", "pat", DeploymentServer)
+	key, err := client.CreateIssue(context.Background(), &CreateIssueRequest{
+		ProjectKey:  "OPS",
+		IssueType:   "Task",
+		Summary:     "New task",
+		Description: "Details",
+		Assignee:    "ada",
+	})
+	if err != nil {
+		t.Fatalf("CreateIssue() error = %v", err)
+	}
+
+	if key != "OPS-8" {
+		t.Errorf("key = %q, want OPS-8", key)
+	}
+	if body.Fields["description"] != "Details" {
+		t.Errorf("expected plain description on Server, got %v", body.Fields["description"])
+	}
+	if assignee, _ := body.Fields["assignee"].(map[string]any); assignee["name"] != "ada" {
+		t.Errorf("expected assignee by name on Server, got %v", body.Fields["assignee"])
+	}
+}
+
+func TestSearchIssues_UsesJQLEndpointOnCloud(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/search/jql" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if jql := r.URL.Query().Get("jql"); jql != "project = ABC" {
+			t.Errorf("jql = %q", jql)
+		}
+		_, _ = w.Write([]byte(`{"issues": [{"key": "ABC-1", "fields": {"summary": "One"}}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "ada@example.com", "token", DeploymentCloud)
+	tasks, err := client.SearchIssues(context.Background(), "project = ABC", 50)
+	if err != nil {
+		t.Fatalf("SearchIssues() error = %v", err)
+	}
+
+	if len(tasks) != 1 || tasks[0].ID != "ABC-1" {
+		t.Errorf("unexpected tasks: %+v", tasks)
+	}
+}
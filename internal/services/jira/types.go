@@ -0,0 +1,188 @@
+package jira
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/rithyhuot/vibe/internal/models"
+)
+
+// issueFields lists the fields requested for issues
+const issueFields = "summary,description,status,priority,assignee,labels,duedate,issuetype,project"
+
+// API response structures
+
+// IssueResponse represents an issue in API responses
+type IssueResponse struct {
+	ID     string              `json:"id"`
+	Key    string              `json:"key"`
+	Fields IssueFieldsResponse `json:"fields"`
+}
+
+// IssueFieldsResponse holds the fields of an issue. Description is an ADF
+// document in API v3 (Cloud) and a plain string in API v2 (Server).
+type IssueFieldsResponse struct {
+	Summary     string            `json:"summary"`
+	Description json.RawMessage   `json:"description"`
+	Status      StatusResponse    `json:"status"`
+	Priority    *PriorityResponse `json:"priority"`
+	Assignee    *User             `json:"assignee"`
+	Labels      []string          `json:"labels"`
+	DueDate     string            `json:"duedate"`
+	IssueType   NamedResponse     `json:"issuetype"`
+	Project     ProjectResponse   `json:"project"`
+}
+
+// StatusResponse represents an issue status
+type StatusResponse struct {
+	Name           string                 `json:"name"`
+	StatusCategory StatusCategoryResponse `json:"statusCategory"`
+}
+
+// StatusCategoryResponse groups statuses into to do, in progress, and done
+type StatusCategoryResponse struct {
+	Key       string `json:"key"` // "new", "indeterminate", or "done"
+	ColorName string `json:"colorName"`
+}
+
+// PriorityResponse represents an issue priority
+type PriorityResponse struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// NamedResponse represents an object identified by name, such as an issue type
+type NamedResponse struct {
+	Name string `json:"name"`
+}
+
+// ProjectResponse represents the project an issue belongs to
+type ProjectResponse struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+// User represents a Jira user. Cloud identifies users by account ID, Server
+// and Data Center by user name.
+type User struct {
+	AccountID    string `json:"accountId,omitempty"`
+	Name         string `json:"name,omitempty"`
+	DisplayName  string `json:"displayName"`
+	EmailAddress string `json:"emailAddress"`
+}
+
+// SearchResponse wraps a JQL search response
+type SearchResponse struct {
+	Issues []IssueResponse `json:"issues"`
+}
+
+// Transition represents a workflow transition available on an issue
+type Transition struct {
+	ID   string        `json:"id"`
+	Name string        `json:"name"`
+	To   NamedResponse `json:"to"`
+}
+
+// TransitionsResponse wraps the transitions available on an issue
+type TransitionsResponse struct {
+	Transitions []Transition `json:"transitions"`
+}
+
+// CreateIssueRequest is a request to create an issue
+type CreateIssueRequest struct {
+	ProjectKey  string
+	IssueType   string
+	Summary     string
+	Description string // Plain text or markdown
+	Labels      []string
+	Priority    string     // Priority name, e.g. "High"
+	Assignee    string     // Account ID (Cloud) or user name (Server)
+	DueDate     *time.Time // Optional
+}
+
+// CreateIssueResponse is returned when an issue is created
+type CreateIssueResponse struct {
+	ID  string `json:"id"`
+	Key string `json:"key"`
+}
+
+// statusTypes maps Jira status categories to the status types used for
+// ClickUp tasks, so tickets from either tracker sort and display the same way
+var statusTypes = map[string]string{
+	"new":           "open",
+	"indeterminate": "custom",
+	"done":          "closed",
+}
+
+// ToTask converts an IssueResponse to a models.Task
+func (i *IssueResponse) ToTask(baseURL string) *models.Task {
+	task := &models.Task{
+		ID:          i.Key,
+		Name:        i.Fields.Summary,
+		Description: descriptionText(i.Fields.Description),
+		Status: models.Status{
+			Status: i.Fields.Status.Name,
+			Color:  i.Fields.Status.StatusCategory.ColorName,
+			Type:   statusTypes[i.Fields.Status.StatusCategory.Key],
+		},
+		Tags:   make([]models.Tag, 0, len(i.Fields.Labels)),
+		URL:    strings.TrimSuffix(baseURL, "/") + "/browse/" + i.Key,
+		ListID: i.Fields.Project.Key,
+	}
+
+	if i.Fields.Priority != nil {
+		task.Priority = &models.Priority{
+			ID:       i.Fields.Priority.ID,
+			Priority: strings.ToLower(i.Fields.Priority.Name),
+		}
+	}
+
+	if i.Fields.Assignee != nil {
+		task.Assignees = []models.User{{
+			Username: i.Fields.Assignee.DisplayName,
+			Email:    i.Fields.Assignee.EmailAddress,
+		}}
+	}
+
+	for _, label := range i.Fields.Labels {
+		task.Tags = append(task.Tags, models.Tag{Name: label})
+	}
+
+	if i.Fields.DueDate != "" {
+		if due, err := time.Parse("2006-01-02", i.Fields.DueDate); err == nil {
+			task.DueDate = &due
+		}
+	}
+
+	// Expose the issue type the same way ClickUp's "Type" custom field is shown
+	if i.Fields.IssueType.Name != "" {
+		task.CustomFields = []models.CustomField{{
+			ID:    "issuetype",
+			Name:  "Type",
+			Type:  "text",
+			Value: i.Fields.IssueType.Name,
+		}}
+	}
+
+	return task
+}
+
+// descriptionText returns a description as plain text, whether it was
+// returned as a string (API v2) or an ADF document (API v3)
+func descriptionText(raw json.RawMessage) string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return ""
+	}
+
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		return text
+	}
+
+	var doc adfNode
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return ""
+	}
+	return adfToText(&doc)
+}
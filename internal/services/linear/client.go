@@ -0,0 +1,390 @@
+// Package linear provides a client for interacting with the Linear GraphQL API.
+package linear
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rithyhuot/vibe/internal/models"
+	"github.com/rithyhuot/vibe/internal/utils"
+)
+
+const (
+	graphqlURL = "https://api.linear.app/graphql"
+
+	// searchLimit is the maximum number of issues returned by a search
+	searchLimit = 50
+)
+
+// Client interface defines Linear operations
+type Client interface {
+	GetIssue(ctx context.Context, identifier string) (*models.Task, error)
+	SearchIssues(ctx context.Context, teamKey string, term string) ([]*models.Task, error)
+	GetWorkflowStates(ctx context.Context, identifier string) ([]WorkflowState, error)
+	UpdateIssueState(ctx context.Context, identifier string, stateID string) error
+	AddComment(ctx context.Context, identifier string, body string) (*models.Comment, error)
+	CreateIssue(ctx context.Context, req *CreateIssueRequest) (*models.Task, error)
+	GetViewer(ctx context.Context) (*User, error)
+}
+
+// HTTPClient implements the Client interface using HTTP
+type HTTPClient struct {
+	httpClient *utils.HTTPClient
+	apiKey     string
+	graphqlURL string
+}
+
+// NewClient creates a new Linear HTTP client using a personal API key
+func NewClient(apiKey string) *HTTPClient {
+	return &HTTPClient{
+		httpClient: utils.NewHTTPClient(0). // Use default timeout from HTTPClient
+							WithUserAgent("vibe"),
+		apiKey:     apiKey,
+		graphqlURL: graphqlURL,
+	}
+}
+
+// WithRetryPolicy sets the retry budget for requests made by this client
+func (c *HTTPClient) WithRetryPolicy(policy utils.RetryPolicy) *HTTPClient {
+	c.httpClient.WithRetryPolicy(policy)
+	return c
+}
+
+// graphQLRequest represents a GraphQL request
+type graphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables,omitempty"`
+}
+
+// graphQLResponse represents a GraphQL response
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// executeGraphQL executes a GraphQL query and decodes its data into result
+func (c *HTTPClient) executeGraphQL(ctx context.Context, query string, variables map[string]any, result any) error {
+	req := graphQLRequest{
+		Query:     query,
+		Variables: variables,
+	}
+
+	// Personal API keys are sent without a scheme
+	headers := map[string]string{
+		"Authorization": c.apiKey,
+	}
+
+	var resp graphQLResponse
+	if err := c.httpClient.DoJSONRequest(ctx, "POST", c.graphqlURL, req, &resp, headers); err != nil {
+		return fmt.Errorf("GraphQL request failed: %w", err)
+	}
+
+	// GraphQL returns 200 OK even with errors in the response body
+	if len(resp.Errors) > 0 {
+		errMsgs := make([]string, len(resp.Errors))
+		for i, e := range resp.Errors {
+			errMsgs[i] = e.Message
+		}
+		return fmt.Errorf("GraphQL errors: %s", strings.Join(errMsgs, "; "))
+	}
+
+	if result != nil && len(resp.Data) > 0 {
+		if err := json.Unmarshal(resp.Data, result); err != nil {
+			return fmt.Errorf("failed to unmarshal GraphQL response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// getIssue fetches an issue by identifier (e.g. ENG-482) or ID
+func (c *HTTPClient) getIssue(ctx context.Context, identifier string) (*IssueResponse, error) {
+	query := `query($id: String!) { issue(id: $id) {` + issueFields + `} }`
+
+	var data struct {
+		Issue *IssueResponse `json:"issue"`
+	}
+	if err := c.executeGraphQL(ctx, query, map[string]any{"id": identifier}, &data); err != nil {
+		return nil, err
+	}
+	if data.Issue == nil {
+		return nil, fmt.Errorf("issue not found: %s", identifier)
+	}
+
+	return data.Issue, nil
+}
+
+// GetIssue retrieves a single issue by identifier
+func (c *HTTPClient) GetIssue(ctx context.Context, identifier string) (*models.Task, error) {
+	issue, err := c.getIssue(ctx, identifier)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue: %w", err)
+	}
+
+	return issue.ToTask(), nil
+}
+
+// SearchIssues runs a full-text search, optionally limited to a team. An
+// empty term lists the open issues assigned to the current user.
+func (c *HTTPClient) SearchIssues(ctx context.Context, teamKey string, term string) ([]*models.Task, error) {
+	filter := map[string]any{}
+	if teamKey != "" {
+		filter["team"] = map[string]any{"key": map[string]any{"eq": teamKey}}
+	}
+
+	var issues []IssueResponse
+	if strings.TrimSpace(term) == "" {
+		filter["state"] = map[string]any{
+			"type": map[string]any{"nin": []string{StateTypeCompleted, StateTypeCanceled}},
+		}
+		query := `query($filter: IssueFilter, $first: Int) {
+			viewer { assignedIssues(filter: $filter, first: $first, orderBy: updatedAt) { nodes {` + issueFields + `} } }
+		}`
+
+		var data struct {
+			Viewer struct {
+				AssignedIssues IssueConnection `json:"assignedIssues"`
+			} `json:"viewer"`
+		}
+		if err := c.executeGraphQL(ctx, query, map[string]any{"filter": filter, "first": searchLimit}, &data); err != nil {
+			return nil, fmt.Errorf("failed to search issues: %w", err)
+		}
+		issues = data.Viewer.AssignedIssues.Nodes
+	} else {
+		query := `query($term: String!, $filter: IssueFilter, $first: Int) {
+			searchIssues(term: $term, filter: $filter, first: $first) { nodes {` + issueFields + `} }
+		}`
+
+		var data struct {
+			SearchIssues IssueConnection `json:"searchIssues"`
+		}
+		variables := map[string]any{"term": term, "filter": filter, "first": searchLimit}
+		if err := c.executeGraphQL(ctx, query, variables, &data); err != nil {
+			return nil, fmt.Errorf("failed to search issues: %w", err)
+		}
+		issues = data.SearchIssues.Nodes
+	}
+
+	tasks := make([]*models.Task, len(issues))
+	for i := range issues {
+		tasks[i] = issues[i].ToTask()
+	}
+
+	return tasks, nil
+}
+
+// GetWorkflowStates returns the workflow states of an issue's team, ordered
+// as they appear on the board
+func (c *HTTPClient) GetWorkflowStates(ctx context.Context, identifier string) ([]WorkflowState, error) {
+	query := `query($id: String!) {
+		issue(id: $id) { team { states { nodes { id name type color position } } } }
+	}`
+
+	var data struct {
+		Issue *struct {
+			Team struct {
+				States struct {
+					Nodes []WorkflowState `json:"nodes"`
+				} `json:"states"`
+			} `json:"team"`
+		} `json:"issue"`
+	}
+	if err := c.executeGraphQL(ctx, query, map[string]any{"id": identifier}, &data); err != nil {
+		return nil, fmt.Errorf("failed to get workflow states: %w", err)
+	}
+	if data.Issue == nil {
+		return nil, fmt.Errorf("failed to get workflow states: issue not found: %s", identifier)
+	}
+
+	states := data.Issue.Team.States.Nodes
+	sort.SliceStable(states, func(i, j int) bool {
+		return states[i].Position < states[j].Position
+	})
+
+	return states, nil
+}
+
+// UpdateIssueState moves an issue to a workflow state
+func (c *HTTPClient) UpdateIssueState(ctx context.Context, identifier string, stateID string) error {
+	query := `mutation($id: String!, $stateId: String!) {
+		issueUpdate(id: $id, input: { stateId: $stateId }) { success }
+	}`
+
+	var data struct {
+		IssueUpdate struct {
+			Success bool `json:"success"`
+		} `json:"issueUpdate"`
+	}
+	if err := c.executeGraphQL(ctx, query, map[string]any{"id": identifier, "stateId": stateID}, &data); err != nil {
+		return fmt.Errorf("failed to update issue: %w", err)
+	}
+	if !data.IssueUpdate.Success {
+		return fmt.Errorf("failed to update issue: %s", identifier)
+	}
+
+	return nil
+}
+
+// AddComment adds a markdown comment to an issue
+func (c *HTTPClient) AddComment(ctx context.Context, identifier string, body string) (*models.Comment, error) {
+	// commentCreate needs the issue ID rather than its identifier
+	issue, err := c.getIssue(ctx, identifier)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add comment: %w", err)
+	}
+
+	query := `mutation($issueId: String!, $body: String!) {
+		commentCreate(input: { issueId: $issueId, body: $body }) {
+			success
+			comment { id body createdAt user { name displayName email } }
+		}
+	}`
+
+	var data struct {
+		CommentCreate struct {
+			Success bool `json:"success"`
+			Comment struct {
+				ID        string `json:"id"`
+				Body      string `json:"body"`
+				CreatedAt string `json:"createdAt"`
+				User      User   `json:"user"`
+			} `json:"comment"`
+		} `json:"commentCreate"`
+	}
+	if err := c.executeGraphQL(ctx, query, map[string]any{"issueId": issue.ID, "body": body}, &data); err != nil {
+		return nil, fmt.Errorf("failed to add comment: %w", err)
+	}
+	if !data.CommentCreate.Success {
+		return nil, fmt.Errorf("failed to add comment to %s", identifier)
+	}
+
+	comment := data.CommentCreate.Comment
+	return &models.Comment{
+		ID:          comment.ID,
+		CommentText: comment.Body,
+		Comment:     []models.Content{{Text: comment.Body}},
+		User: models.User{
+			Username: comment.User.DisplayName,
+			Email:    comment.User.Email,
+		},
+		DateCreated: comment.CreatedAt,
+	}, nil
+}
+
+// CreateIssue creates an issue in a team
+func (c *HTTPClient) CreateIssue(ctx context.Context, req *CreateIssueRequest) (*models.Task, error) {
+	teamID, err := c.teamID(ctx, req.TeamKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create issue: %w", err)
+	}
+
+	input := map[string]any{
+		"teamId": teamID,
+		"title":  req.Title,
+	}
+	if req.Description != "" {
+		input["description"] = req.Description
+	}
+	if req.Priority > 0 {
+		input["priority"] = req.Priority
+	}
+	if req.AssigneeID != "" {
+		input["assigneeId"] = req.AssigneeID
+	}
+	if req.DueDate != nil {
+		input["dueDate"] = req.DueDate.Format("2006-01-02")
+	}
+	if len(req.Labels) > 0 {
+		labelIDs, err := c.labelIDs(ctx, req.Labels)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create issue: %w", err)
+		}
+		input["labelIds"] = labelIDs
+	}
+
+	query := `mutation($input: IssueCreateInput!) {
+		issueCreate(input: $input) { success issue {` + issueFields + `} }
+	}`
+
+	var data struct {
+		IssueCreate struct {
+			Success bool           `json:"success"`
+			Issue   *IssueResponse `json:"issue"`
+		} `json:"issueCreate"`
+	}
+	if err := c.executeGraphQL(ctx, query, map[string]any{"input": input}, &data); err != nil {
+		return nil, fmt.Errorf("failed to create issue: %w", err)
+	}
+	if !data.IssueCreate.Success || data.IssueCreate.Issue == nil {
+		return nil, fmt.Errorf("failed to create issue in team %s", req.TeamKey)
+	}
+
+	return data.IssueCreate.Issue.ToTask(), nil
+}
+
+// GetViewer returns the authenticated user
+func (c *HTTPClient) GetViewer(ctx context.Context) (*User, error) {
+	query := `query { viewer { id name displayName email } }`
+
+	var data struct {
+		Viewer User `json:"viewer"`
+	}
+	if err := c.executeGraphQL(ctx, query, nil, &data); err != nil {
+		return nil, fmt.Errorf("failed to get current user: %w", err)
+	}
+
+	return &data.Viewer, nil
+}
+
+// teamID resolves a team key (e.g. ENG) to its ID
+func (c *HTTPClient) teamID(ctx context.Context, key string) (string, error) {
+	query := `query($key: String!) { teams(filter: { key: { eq: $key } }) { nodes { id key } } }`
+
+	var data struct {
+		Teams struct {
+			Nodes []Team `json:"nodes"`
+		} `json:"teams"`
+	}
+	if err := c.executeGraphQL(ctx, query, map[string]any{"key": strings.ToUpper(key)}, &data); err != nil {
+		return "", err
+	}
+	if len(data.Teams.Nodes) == 0 {
+		return "", fmt.Errorf("team not found: %s", key)
+	}
+
+	return data.Teams.Nodes[0].ID, nil
+}
+
+// labelIDs resolves label names to IDs, matching case-insensitively
+func (c *HTTPClient) labelIDs(ctx context.Context, names []string) ([]string, error) {
+	query := `query { issueLabels(first: 250) { nodes { id name } } }`
+
+	var data struct {
+		IssueLabels LabelConnection `json:"issueLabels"`
+	}
+	if err := c.executeGraphQL(ctx, query, nil, &data); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		found := false
+		for _, label := range data.IssueLabels.Nodes {
+			if strings.EqualFold(label.Name, name) {
+				ids = append(ids, label.ID)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("label not found: %s", name)
+		}
+	}
+
+	return ids, nil
+}
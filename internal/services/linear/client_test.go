@@ -0,0 +1,132 @@
+package linear
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rithyhuot/vibe/internal/utils"
+)
+
+// createTestClient creates a test HTTPClient configured to use the test server
+func createTestClient(serverURL string) *HTTPClient {
+	return &HTTPClient{
+		httpClient: utils.NewHTTPClient(0),
+		apiKey:     "lin_api_test",
+		graphqlURL: serverURL,
+	}
+}
+
+// decodeGraphQL decodes a GraphQL request, ignoring errors (for test code)
+func decodeGraphQL(r *http.Request) graphQLRequest {
+	var req graphQLRequest
+	_ = json.NewDecoder(r.Body).Decode(&req)
+	return req
+}
+
+const testIssueJSON = `{
+	"id": "uuid-1",
+	"identifier": "ENG-482",
+	"title": "Fix login",
+	"description": "Steps to reproduce",
+	"url": "https://linear.app/acme/issue/ENG-482/fix-login",
+	"priority": 3,
+	"priorityLabel": "Medium",
+	"dueDate": "2026-02-01",
+	"state": {"id": "s1", "name": "In Progress", "type": "started", "color": "#f2c94c"},
+	"assignee": {"id": "u1", "name": "ada", "displayName": "Ada", "email": "ada@example.com"},
+	"labels": {"nodes": [{"name": "bug", "color": "#eb5757"}]},
+	"team": {"id": "t1", "key": "ENG"}
+}`
+
+func TestGetIssue(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); auth != "lin_api_test" {
+			t.Errorf("Authorization = %q", auth)
+		}
+		req := decodeGraphQL(r)
+		if req.Variables["id"] != "ENG-482" {
+			t.Errorf("id = %v", req.Variables["id"])
+		}
+		_, _ = w.Write([]byte(`{"data": {"issue": ` + testIssueJSON + `}}`))
+	}))
+	defer server.Close()
+
+	task, err := createTestClient(server.URL).GetIssue(context.Background(), "ENG-482")
+	if err != nil {
+		t.Fatalf("GetIssue() error = %v", err)
+	}
+
+	if task.ID != "ENG-482" || task.Name != "Fix login" {
+		t.Errorf("unexpected task: %s %s", task.ID, task.Name)
+	}
+	if task.Status.Status != "In Progress" || task.Status.Type != "custom" {
+		t.Errorf("unexpected status: %+v", task.Status)
+	}
+	if task.Priority == nil || task.Priority.Priority != "normal" {
+		t.Errorf("unexpected priority: %+v", task.Priority)
+	}
+	if len(task.Assignees) != 1 || task.Assignees[0].Username != "Ada" {
+		t.Errorf("unexpected assignees: %+v", task.Assignees)
+	}
+	if len(task.Tags) != 1 || task.Tags[0].Name != "bug" {
+		t.Errorf("unexpected tags: %+v", task.Tags)
+	}
+}
+
+func TestGetIssue_GraphQLError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data": null, "errors": [{"message": "Entity not found"}]}`))
+	}))
+	defer server.Close()
+
+	_, err := createTestClient(server.URL).GetIssue(context.Background(), "ENG-1")
+	if err == nil || !strings.Contains(err.Error(), "Entity not found") {
+		t.Errorf("expected GraphQL error, got %v", err)
+	}
+}
+
+func TestCreateIssue_ResolvesTeamAndLabels(t *testing.T) {
+	var input map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := decodeGraphQL(r)
+		switch {
+		case strings.Contains(req.Query, "teams("):
+			_, _ = w.Write([]byte(`{"data": {"teams": {"nodes": [{"id": "t1", "key": "ENG"}]}}}`))
+		case strings.Contains(req.Query, "issueLabels"):
+			_, _ = w.Write([]byte(`{"data": {"issueLabels": {"nodes": [{"id": "l1", "name": "Bug"}]}}}`))
+		case strings.Contains(req.Query, "issueCreate"):
+			input, _ = req.Variables["input"].(map[string]any)
+			_, _ = w.Write([]byte(`{"data": {"issueCreate": {"success": true, "issue": ` + testIssueJSON + `}}}`))
+		default:
+			t.Errorf("unexpected query: %s", req.Query)
+		}
+	}))
+	defer server.Close()
+
+	task, err := createTestClient(server.URL).CreateIssue(context.Background(), &CreateIssueRequest{
+		TeamKey:  "eng",
+		Title:    "Fix login",
+		Priority: 2,
+		Labels:   []string{"bug"},
+	})
+	if err != nil {
+		t.Fatalf("CreateIssue() error = %v", err)
+	}
+
+	if task.ID != "ENG-482" {
+		t.Errorf("task.ID = %q", task.ID)
+	}
+	if input["teamId"] != "t1" {
+		t.Errorf("teamId = %v", input["teamId"])
+	}
+	if labels, _ := input["labelIds"].([]any); len(labels) != 1 || labels[0] != "l1" {
+		t.Errorf("labelIds = %v", input["labelIds"])
+	}
+	if input["priority"] != float64(2) {
+		t.Errorf("priority = %v", input["priority"])
+	}
+}
@@ -0,0 +1,172 @@
+package linear
+
+import (
+	"strings"
+	"time"
+
+	"github.com/rithyhuot/vibe/internal/models"
+)
+
+// issueFields is the GraphQL selection used for issues
+const issueFields = `
+	id
+	identifier
+	title
+	description
+	url
+	priority
+	priorityLabel
+	dueDate
+	state { id name type color }
+	assignee { id name displayName email }
+	labels { nodes { name color } }
+	team { id key }
+`
+
+// Workflow state types
+const (
+	StateTypeTriage    = "triage"
+	StateTypeBacklog   = "backlog"
+	StateTypeUnstarted = "unstarted"
+	StateTypeStarted   = "started"
+	StateTypeCompleted = "completed"
+	StateTypeCanceled  = "canceled"
+)
+
+// API response structures
+
+// IssueResponse represents an issue in API responses
+type IssueResponse struct {
+	ID            string          `json:"id"`
+	Identifier    string          `json:"identifier"`
+	Title         string          `json:"title"`
+	Description   string          `json:"description"`
+	URL           string          `json:"url"`
+	Priority      float64         `json:"priority"`
+	PriorityLabel string          `json:"priorityLabel"`
+	DueDate       string          `json:"dueDate"`
+	State         WorkflowState   `json:"state"`
+	Assignee      *User           `json:"assignee"`
+	Labels        LabelConnection `json:"labels"`
+	Team          Team            `json:"team"`
+}
+
+// IssueConnection wraps a list of issues
+type IssueConnection struct {
+	Nodes []IssueResponse `json:"nodes"`
+}
+
+// WorkflowState represents a state in a team's workflow
+type WorkflowState struct {
+	ID       string  `json:"id"`
+	Name     string  `json:"name"`
+	Type     string  `json:"type"` // triage, backlog, unstarted, started, completed, or canceled
+	Color    string  `json:"color"`
+	Position float64 `json:"position"`
+}
+
+// User represents a Linear user
+type User struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+	Email       string `json:"email"`
+}
+
+// Label represents an issue label
+type Label struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+// LabelConnection wraps a list of labels
+type LabelConnection struct {
+	Nodes []Label `json:"nodes"`
+}
+
+// Team represents a Linear team
+type Team struct {
+	ID  string `json:"id"`
+	Key string `json:"key"`
+}
+
+// CreateIssueRequest is a request to create an issue
+type CreateIssueRequest struct {
+	TeamKey     string
+	Title       string
+	Description string // Markdown
+	Priority    int    // 0 none, 1 urgent, 2 high, 3 normal, 4 low
+	AssigneeID  string
+	Labels      []string   // Label names
+	DueDate     *time.Time // Optional
+}
+
+// statusTypes maps Linear workflow state types to the status types used for
+// ClickUp tasks, so tickets from any tracker sort and display the same way
+var statusTypes = map[string]string{
+	StateTypeTriage:    "open",
+	StateTypeBacklog:   "open",
+	StateTypeUnstarted: "open",
+	StateTypeStarted:   "custom",
+	StateTypeCompleted: "closed",
+	StateTypeCanceled:  "closed",
+}
+
+// ToTask converts an IssueResponse to a models.Task
+func (i *IssueResponse) ToTask() *models.Task {
+	task := &models.Task{
+		ID:          i.Identifier,
+		Name:        i.Title,
+		Description: i.Description,
+		Status: models.Status{
+			Status: i.State.Name,
+			Color:  i.State.Color,
+			Type:   statusTypes[i.State.Type],
+		},
+		Tags:   make([]models.Tag, 0, len(i.Labels.Nodes)),
+		URL:    i.URL,
+		ListID: i.Team.Key,
+	}
+
+	if i.Priority > 0 {
+		task.Priority = &models.Priority{
+			ID:       i.PriorityLabel,
+			Priority: normalizePriority(i.PriorityLabel),
+		}
+	}
+
+	if i.Assignee != nil {
+		name := i.Assignee.DisplayName
+		if name == "" {
+			name = i.Assignee.Name
+		}
+		task.Assignees = []models.User{{
+			Username: name,
+			Email:    i.Assignee.Email,
+		}}
+	}
+
+	for _, label := range i.Labels.Nodes {
+		task.Tags = append(task.Tags, models.Tag{Name: label.Name, BG: label.Color})
+	}
+
+	if i.DueDate != "" {
+		if due, err := time.Parse("2006-01-02", i.DueDate); err == nil {
+			task.DueDate = &due
+		}
+	}
+
+	return task
+}
+
+// normalizePriority returns a priority label in the lower-case form used
+// for ClickUp priorities; Linear's "Medium" is ClickUp's "normal"
+func normalizePriority(label string) string {
+	switch label {
+	case "Medium":
+		return "normal"
+	default:
+		return strings.ToLower(label)
+	}
+}
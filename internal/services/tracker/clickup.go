@@ -0,0 +1,98 @@
+package tracker
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rithyhuot/vibe/internal/models"
+	"github.com/rithyhuot/vibe/internal/services/clickup"
+)
+
+// ClickUp implements Tracker on top of the ClickUp API
+type ClickUp struct {
+	client clickup.Client
+	teamID string
+	userID string
+}
+
+// NewClickUp creates a ClickUp tracker. teamID scopes searches and userID
+// resolves the "me" assignee.
+func NewClickUp(client clickup.Client, teamID, userID string) *ClickUp {
+	return &ClickUp{
+		client: client,
+		teamID: teamID,
+		userID: userID,
+	}
+}
+
+// Client returns the underlying ClickUp client
+func (t *ClickUp) Client() clickup.Client {
+	return t.client
+}
+
+// Name returns the display name of the provider
+func (t *ClickUp) Name() string {
+	return "ClickUp"
+}
+
+// GetTicket retrieves a task by ID
+func (t *ClickUp) GetTicket(ctx context.Context, ticketID string) (*models.Task, error) {
+	return t.client.GetTask(ctx, ticketID)
+}
+
+// SearchTickets searches tasks across the team
+func (t *ClickUp) SearchTickets(ctx context.Context, query string) ([]*models.Task, error) {
+	return t.client.SearchTeamTasks(ctx, t.teamID, query)
+}
+
+// UpdateStatus sets the status of a task
+func (t *ClickUp) UpdateStatus(ctx context.Context, ticketID string, status string) error {
+	_, err := t.client.UpdateTask(ctx, ticketID, &models.TaskUpdateRequest{Status: &status})
+	return err
+}
+
+// AddComment adds a comment to a task
+func (t *ClickUp) AddComment(ctx context.Context, ticketID string, text string) (*models.Comment, error) {
+	return t.client.AddComment(ctx, ticketID, text)
+}
+
+// CreateTicket creates a task in the list given by req.Container
+func (t *ClickUp) CreateTicket(ctx context.Context, req *CreateRequest) (*models.Task, error) {
+	if req.Container == "" {
+		return nil, fmt.Errorf("a ClickUp list ID is required to create a ticket")
+	}
+
+	createReq := &models.TaskCreateRequest{
+		Name:                req.Name,
+		MarkdownDescription: req.Description,
+		Tags:                req.Labels,
+	}
+
+	if req.Priority != "" {
+		priority, ok := clickup.Priorities[strings.ToLower(req.Priority)]
+		if !ok {
+			return nil, fmt.Errorf("invalid priority: %s (must be one of: urgent, high, normal, low)", req.Priority)
+		}
+		createReq.Priority = priority
+	}
+
+	for _, assignee := range req.Assignees {
+		if assignee == "me" {
+			assignee = t.userID
+		}
+		id, err := strconv.Atoi(assignee)
+		if err != nil {
+			return nil, fmt.Errorf("invalid assignee: %s (must be a ClickUp user ID or \"me\")", assignee)
+		}
+		createReq.Assignees = append(createReq.Assignees, id)
+	}
+
+	if req.DueDate != nil {
+		ms := req.DueDate.UnixMilli()
+		createReq.DueDate = &ms
+	}
+
+	return t.client.CreateTask(ctx, req.Container, createReq)
+}
@@ -0,0 +1,165 @@
+package tracker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rithyhuot/vibe/internal/models"
+	"github.com/rithyhuot/vibe/internal/services/jira"
+)
+
+// jiraPriorities maps the provider-neutral priority names to Jira's default
+// priority scheme. Other names are passed through unchanged.
+var jiraPriorities = map[string]string{
+	"urgent": "Highest",
+	"high":   "High",
+	"normal": "Medium",
+	"low":    "Low",
+}
+
+// Jira implements Tracker on top of the Jira REST API
+type Jira struct {
+	client     jira.Client
+	projectKey string
+	issueType  string
+}
+
+// NewJira creates a Jira tracker. projectKey scopes searches and is the
+// default project for new tickets; issueType is the type of new tickets.
+func NewJira(client jira.Client, projectKey, issueType string) *Jira {
+	return &Jira{
+		client:     client,
+		projectKey: projectKey,
+		issueType:  issueType,
+	}
+}
+
+// Name returns the display name of the provider
+func (t *Jira) Name() string {
+	return "Jira"
+}
+
+// GetTicket retrieves an issue by key
+func (t *Jira) GetTicket(ctx context.Context, ticketID string) (*models.Task, error) {
+	return t.client.GetIssue(ctx, ticketID)
+}
+
+// SearchTickets runs a full-text search, limited to the configured project
+func (t *Jira) SearchTickets(ctx context.Context, query string) ([]*models.Task, error) {
+	return t.client.SearchIssues(ctx, searchJQL(query, t.projectKey), searchLimit)
+}
+
+// UpdateStatus moves an issue to a status through the workflow transition
+// that leads to it. Jira statuses can't be set directly.
+func (t *Jira) UpdateStatus(ctx context.Context, ticketID string, status string) error {
+	transitions, err := t.client.GetTransitions(ctx, ticketID)
+	if err != nil {
+		return err
+	}
+
+	transition := findTransition(transitions, status)
+	if transition == nil {
+		available := make([]string, len(transitions))
+		for i, tr := range transitions {
+			available[i] = tr.To.Name
+		}
+		return fmt.Errorf("no transition to status %q (available: %s)", status, strings.Join(available, ", "))
+	}
+
+	return t.client.TransitionIssue(ctx, ticketID, transition.ID)
+}
+
+// AddComment adds a comment to an issue
+func (t *Jira) AddComment(ctx context.Context, ticketID string, text string) (*models.Comment, error) {
+	return t.client.AddComment(ctx, ticketID, text)
+}
+
+// CreateTicket creates an issue in the project given by req.Container, or the
+// configured project
+func (t *Jira) CreateTicket(ctx context.Context, req *CreateRequest) (*models.Task, error) {
+	projectKey := req.Container
+	if projectKey == "" {
+		projectKey = t.projectKey
+	}
+	if projectKey == "" {
+		return nil, fmt.Errorf("a Jira project key is required to create a ticket (set jira.project_key)")
+	}
+	if len(req.Assignees) > 1 {
+		return nil, fmt.Errorf("jira issues have a single assignee, got %d", len(req.Assignees))
+	}
+
+	createReq := &jira.CreateIssueRequest{
+		ProjectKey:  strings.ToUpper(projectKey),
+		IssueType:   t.issueType,
+		Summary:     req.Name,
+		Description: req.Description,
+		Labels:      req.Labels,
+		DueDate:     req.DueDate,
+	}
+
+	if req.Priority != "" {
+		createReq.Priority = req.Priority
+		if name, ok := jiraPriorities[strings.ToLower(req.Priority)]; ok {
+			createReq.Priority = name
+		}
+	}
+
+	if len(req.Assignees) == 1 {
+		assignee := req.Assignees[0]
+		if assignee == "me" {
+			user, err := t.client.GetMyself(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// Cloud identifies users by account ID, Server by user name
+			assignee = user.AccountID
+			if assignee == "" {
+				assignee = user.Name
+			}
+		}
+		createReq.Assignee = assignee
+	}
+
+	key, err := t.client.CreateIssue(ctx, createReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.client.GetIssue(ctx, key)
+}
+
+// findTransition returns the transition leading to a status, matching the
+// target status or the transition name case-insensitively
+func findTransition(transitions []jira.Transition, status string) *jira.Transition {
+	for i := range transitions {
+		if strings.EqualFold(transitions[i].To.Name, status) {
+			return &transitions[i]
+		}
+	}
+	for i := range transitions {
+		if strings.EqualFold(transitions[i].Name, status) {
+			return &transitions[i]
+		}
+	}
+	return nil
+}
+
+// searchJQL builds the JQL for a full-text search. An empty query lists the
+// current user's unresolved issues.
+func searchJQL(query, projectKey string) string {
+	var clauses []string
+	if projectKey != "" {
+		clauses = append(clauses, fmt.Sprintf("project = %q", projectKey))
+	}
+
+	query = strings.TrimSpace(query)
+	if query == "" {
+		clauses = append(clauses, "assignee = currentUser()", "statusCategory != Done")
+	} else {
+		escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(query)
+		clauses = append(clauses, fmt.Sprintf(`text ~ "%s"`, escaped))
+	}
+
+	return strings.Join(clauses, " AND ") + " ORDER BY updated DESC"
+}
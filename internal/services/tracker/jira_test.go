@@ -0,0 +1,96 @@
+package tracker
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rithyhuot/vibe/internal/models"
+	"github.com/rithyhuot/vibe/internal/services/jira"
+)
+
+// fakeJiraClient records the calls made by the Jira tracker
+type fakeJiraClient struct {
+	transitions  []jira.Transition
+	transitioned string
+	created      *jira.CreateIssueRequest
+	myself       *jira.User
+}
+
+func (f *fakeJiraClient) GetIssue(_ context.Context, key string) (*models.Task, error) {
+	return &models.Task{ID: key}, nil
+}
+
+func (f *fakeJiraClient) SearchIssues(_ context.Context, _ string, _ int) ([]*models.Task, error) {
+	return nil, nil
+}
+
+func (f *fakeJiraClient) GetTransitions(_ context.Context, _ string) ([]jira.Transition, error) {
+	return f.transitions, nil
+}
+
+func (f *fakeJiraClient) TransitionIssue(_ context.Context, _ string, transitionID string) error {
+	f.transitioned = transitionID
+	return nil
+}
+
+func (f *fakeJiraClient) AddComment(_ context.Context, _ string, body string) (*models.Comment, error) {
+	return &models.Comment{CommentText: body}, nil
+}
+
+func (f *fakeJiraClient) CreateIssue(_ context.Context, req *jira.CreateIssueRequest) (string, error) {
+	f.created = req
+	return req.ProjectKey + "-1", nil
+}
+
+func (f *fakeJiraClient) GetMyself(_ context.Context) (*jira.User, error) {
+	return f.myself, nil
+}
+
+func TestJira_UpdateStatus(t *testing.T) {
+	client := &fakeJiraClient{
+		transitions: []jira.Transition{
+			{ID: "11", Name: "Start work", To: jira.NamedResponse{Name: "In Progress"}},
+			{ID: "21", Name: "Resolve", To: jira.NamedResponse{Name: "Done"}},
+		},
+	}
+	tr := NewJira(client, "ABC", "Task")
+
+	require.NoError(t, tr.UpdateStatus(context.Background(), "ABC-1", "in progress"))
+	assert.Equal(t, "11", client.transitioned)
+
+	require.NoError(t, tr.UpdateStatus(context.Background(), "ABC-1", "Resolve"))
+	assert.Equal(t, "21", client.transitioned)
+
+	err := tr.UpdateStatus(context.Background(), "ABC-1", "Blocked")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "In Progress, Done")
+}
+
+func TestJira_CreateTicket(t *testing.T) {
+	client := &fakeJiraClient{myself: &jira.User{AccountID: "acc-1"}}
+	tr := NewJira(client, "ABC", "Story")
+
+	task, err := tr.CreateTicket(context.Background(), &CreateRequest{
+		Name:      "Add export",
+		Priority:  "urgent",
+		Assignees: []string{"me"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "ABC-1", task.ID)
+	assert.Equal(t, "ABC", client.created.ProjectKey)
+	assert.Equal(t, "Story", client.created.IssueType)
+	assert.Equal(t, "Highest", client.created.Priority)
+	assert.Equal(t, "acc-1", client.created.Assignee)
+}
+
+func TestSearchJQL(t *testing.T) {
+	assert.Equal(t, `project = "ABC" AND text ~ "say \"hi\"" ORDER BY updated DESC`, searchJQL(`say "hi"`, "ABC"))
+
+	jql := searchJQL("", "")
+	assert.True(t, strings.HasPrefix(jql, "assignee = currentUser() AND statusCategory != Done"))
+}
@@ -0,0 +1,160 @@
+package tracker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rithyhuot/vibe/internal/models"
+	"github.com/rithyhuot/vibe/internal/services/linear"
+)
+
+// linearPriorities maps the provider-neutral priority names to Linear's
+// numeric priorities
+var linearPriorities = map[string]int{
+	"urgent": 1,
+	"high":   2,
+	"normal": 3,
+	"medium": 3,
+	"low":    4,
+}
+
+// linearStateTypes maps common status names to Linear workflow state types,
+// so a status like "in progress" finds the team's started state whatever it
+// is called
+var linearStateTypes = map[string]string{
+	"in progress": linear.StateTypeStarted,
+	"doing":       linear.StateTypeStarted,
+	"started":     linear.StateTypeStarted,
+	"done":        linear.StateTypeCompleted,
+	"complete":    linear.StateTypeCompleted,
+	"completed":   linear.StateTypeCompleted,
+	"closed":      linear.StateTypeCompleted,
+	"todo":        linear.StateTypeUnstarted,
+	"to do":       linear.StateTypeUnstarted,
+	"open":        linear.StateTypeUnstarted,
+	"backlog":     linear.StateTypeBacklog,
+	"canceled":    linear.StateTypeCanceled,
+	"cancelled":   linear.StateTypeCanceled,
+}
+
+// Linear implements Tracker on top of the Linear GraphQL API
+type Linear struct {
+	client  linear.Client
+	teamKey string
+}
+
+// NewLinear creates a Linear tracker. teamKey scopes searches and is the
+// default team for new tickets.
+func NewLinear(client linear.Client, teamKey string) *Linear {
+	return &Linear{
+		client:  client,
+		teamKey: teamKey,
+	}
+}
+
+// Name returns the display name of the provider
+func (t *Linear) Name() string {
+	return "Linear"
+}
+
+// GetTicket retrieves an issue by identifier
+func (t *Linear) GetTicket(ctx context.Context, ticketID string) (*models.Task, error) {
+	return t.client.GetIssue(ctx, ticketID)
+}
+
+// SearchTickets runs a full-text search, limited to the configured team
+func (t *Linear) SearchTickets(ctx context.Context, query string) ([]*models.Task, error) {
+	return t.client.SearchIssues(ctx, t.teamKey, strings.TrimSpace(query))
+}
+
+// UpdateStatus moves an issue to the workflow state matching status
+func (t *Linear) UpdateStatus(ctx context.Context, ticketID string, status string) error {
+	states, err := t.client.GetWorkflowStates(ctx, ticketID)
+	if err != nil {
+		return err
+	}
+
+	state := matchWorkflowState(states, status)
+	if state == nil {
+		available := make([]string, len(states))
+		for i, s := range states {
+			available[i] = s.Name
+		}
+		return fmt.Errorf("no workflow state matching %q (available: %s)", status, strings.Join(available, ", "))
+	}
+
+	return t.client.UpdateIssueState(ctx, ticketID, state.ID)
+}
+
+// AddComment adds a comment to an issue
+func (t *Linear) AddComment(ctx context.Context, ticketID string, text string) (*models.Comment, error) {
+	return t.client.AddComment(ctx, ticketID, text)
+}
+
+// CreateTicket creates an issue in the team given by req.Container, or the
+// configured team
+func (t *Linear) CreateTicket(ctx context.Context, req *CreateRequest) (*models.Task, error) {
+	teamKey := req.Container
+	if teamKey == "" {
+		teamKey = t.teamKey
+	}
+	if teamKey == "" {
+		return nil, fmt.Errorf("a Linear team key is required to create a ticket (set linear.team_key)")
+	}
+	if len(req.Assignees) > 1 {
+		return nil, fmt.Errorf("linear issues have a single assignee, got %d", len(req.Assignees))
+	}
+
+	createReq := &linear.CreateIssueRequest{
+		TeamKey:     strings.ToUpper(teamKey),
+		Title:       req.Name,
+		Description: req.Description,
+		Labels:      req.Labels,
+		DueDate:     req.DueDate,
+	}
+
+	if req.Priority != "" {
+		priority, ok := linearPriorities[strings.ToLower(req.Priority)]
+		if !ok {
+			return nil, fmt.Errorf("invalid priority %q (use urgent, high, normal, or low)", req.Priority)
+		}
+		createReq.Priority = priority
+	}
+
+	if len(req.Assignees) == 1 {
+		assignee := req.Assignees[0]
+		if assignee == "me" {
+			viewer, err := t.client.GetViewer(ctx)
+			if err != nil {
+				return nil, err
+			}
+			assignee = viewer.ID
+		}
+		createReq.AssigneeID = assignee
+	}
+
+	return t.client.CreateIssue(ctx, createReq)
+}
+
+// matchWorkflowState returns the state named status, matched
+// case-insensitively. Failing that, a common status name such as "in
+// progress" or "done" selects the first state of the matching type.
+func matchWorkflowState(states []linear.WorkflowState, status string) *linear.WorkflowState {
+	for i := range states {
+		if strings.EqualFold(states[i].Name, status) {
+			return &states[i]
+		}
+	}
+
+	stateType, ok := linearStateTypes[strings.ToLower(strings.TrimSpace(status))]
+	if !ok {
+		return nil
+	}
+	for i := range states {
+		if states[i].Type == stateType {
+			return &states[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package tracker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rithyhuot/vibe/internal/models"
+	"github.com/rithyhuot/vibe/internal/services/linear"
+)
+
+// fakeLinearClient records the calls made by the Linear tracker
+type fakeLinearClient struct {
+	states  []linear.WorkflowState
+	stateID string
+	created *linear.CreateIssueRequest
+	viewer  *linear.User
+}
+
+func (f *fakeLinearClient) GetIssue(_ context.Context, identifier string) (*models.Task, error) {
+	return &models.Task{ID: identifier}, nil
+}
+
+func (f *fakeLinearClient) SearchIssues(_ context.Context, _ string, _ string) ([]*models.Task, error) {
+	return nil, nil
+}
+
+func (f *fakeLinearClient) GetWorkflowStates(_ context.Context, _ string) ([]linear.WorkflowState, error) {
+	return f.states, nil
+}
+
+func (f *fakeLinearClient) UpdateIssueState(_ context.Context, _ string, stateID string) error {
+	f.stateID = stateID
+	return nil
+}
+
+func (f *fakeLinearClient) AddComment(_ context.Context, _ string, body string) (*models.Comment, error) {
+	return &models.Comment{CommentText: body}, nil
+}
+
+func (f *fakeLinearClient) CreateIssue(_ context.Context, req *linear.CreateIssueRequest) (*models.Task, error) {
+	f.created = req
+	return &models.Task{ID: req.TeamKey + "-1"}, nil
+}
+
+func (f *fakeLinearClient) GetViewer(_ context.Context) (*linear.User, error) {
+	return f.viewer, nil
+}
+
+func TestLinear_UpdateStatus(t *testing.T) {
+	client := &fakeLinearClient{
+		states: []linear.WorkflowState{
+			{ID: "s1", Name: "Backlog", Type: linear.StateTypeBacklog},
+			{ID: "s2", Name: "Todo", Type: linear.StateTypeUnstarted},
+			{ID: "s3", Name: "Doing", Type: linear.StateTypeStarted},
+			{ID: "s4", Name: "In Review", Type: linear.StateTypeStarted},
+			{ID: "s5", Name: "Shipped", Type: linear.StateTypeCompleted},
+		},
+	}
+	tr := NewLinear(client, "ENG")
+
+	// Exact names win over state types
+	require.NoError(t, tr.UpdateStatus(context.Background(), "ENG-1", "in review"))
+	assert.Equal(t, "s4", client.stateID)
+
+	// Common names select the first state of the matching type
+	require.NoError(t, tr.UpdateStatus(context.Background(), "ENG-1", "in progress"))
+	assert.Equal(t, "s3", client.stateID)
+
+	require.NoError(t, tr.UpdateStatus(context.Background(), "ENG-1", "done"))
+	assert.Equal(t, "s5", client.stateID)
+
+	err := tr.UpdateStatus(context.Background(), "ENG-1", "Blocked")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Backlog, Todo, Doing, In Review, Shipped")
+}
+
+func TestLinear_CreateTicket(t *testing.T) {
+	client := &fakeLinearClient{viewer: &linear.User{ID: "user-1"}}
+	tr := NewLinear(client, "eng")
+
+	task, err := tr.CreateTicket(context.Background(), &CreateRequest{
+		Name:      "Add export",
+		Priority:  "high",
+		Assignees: []string{"me"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "ENG-1", task.ID)
+	assert.Equal(t, "ENG", client.created.TeamKey)
+	assert.Equal(t, 2, client.created.Priority)
+	assert.Equal(t, "user-1", client.created.AssigneeID)
+
+	_, err = tr.CreateTicket(context.Background(), &CreateRequest{Name: "x", Priority: "critical"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,35 @@
+// Package tracker provides a provider-neutral interface to ticket trackers,
+// with implementations for ClickUp and Jira.
+package tracker
+
+import (
+	"context"
+	"time"
+
+	"github.com/rithyhuot/vibe/internal/models"
+)
+
+// searchLimit is the maximum number of tickets returned by a search
+const searchLimit = 50
+
+// Tracker defines the ticket operations shared by all providers
+type Tracker interface {
+	// Name returns the display name of the provider, e.g. "ClickUp" or "Jira"
+	Name() string
+	GetTicket(ctx context.Context, ticketID string) (*models.Task, error)
+	SearchTickets(ctx context.Context, query string) ([]*models.Task, error)
+	UpdateStatus(ctx context.Context, ticketID string, status string) error
+	AddComment(ctx context.Context, ticketID string, text string) (*models.Comment, error)
+	CreateTicket(ctx context.Context, req *CreateRequest) (*models.Task, error)
+}
+
+// CreateRequest is a provider-neutral request to create a ticket
+type CreateRequest struct {
+	Container   string // ClickUp list ID or Jira project key
+	Name        string
+	Description string // Markdown
+	Labels      []string
+	Priority    string     // urgent, high, normal, or low
+	Assignees   []string   // Provider user IDs, or "me" for the current user
+	DueDate     *time.Time // Optional
+}
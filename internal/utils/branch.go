@@ -9,9 +9,25 @@ import (
 )
 
 var (
-	// ticketIDPattern matches ticket IDs from branch names
+	// ticketIDPattern matches ClickUp ticket IDs from branch names
 	ticketIDPattern = regexp.MustCompile(`/([a-z0-9]{9})/`)
 
+	// issueBranchKeyPattern matches Jira or Linear issue keys (e.g. ABC-123) from branch names
+	issueBranchKeyPattern = regexp.MustCompile(`(?:^|/)([A-Z][A-Z0-9_]+-[0-9]+)(?:/|$)`)
+
+	// linearBranchKeyPattern matches the lower-case issue keys that start
+	// Linear-style branch names (e.g. eng-123-fix-login)
+	linearBranchKeyPattern = regexp.MustCompile(`(?:^|/)([a-z][a-z0-9]{1,9}-[0-9]+)(?:[-/]|$)`)
+
+	// clickUpIDPattern matches a ClickUp ticket ID
+	clickUpIDPattern = regexp.MustCompile(`^[a-z0-9]{9}$`)
+
+	// issueKeyPattern matches a Jira or Linear issue key, e.g. ABC-123
+	issueKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]+-[0-9]+$`)
+
+	// anyCaseIssueKeyPattern matches an issue key typed in any case, e.g. abc-123
+	anyCaseIssueKeyPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]+-[0-9]+$`)
+
 	// invalidCharsPattern matches characters that shouldn't be in branch names
 	invalidCharsPattern = regexp.MustCompile(`[^a-zA-Z0-9\-_/]`)
 
@@ -22,22 +38,50 @@ var (
 	maxBranchPartLength = 50
 )
 
+// Branch naming styles
+const (
+	// BranchStyleDefault names branches prefix/ticketID/title
+	BranchStyleDefault = "default"
+	// BranchStyleLinear follows Linear's convention: prefix/eng-123-title
+	BranchStyleLinear = "linear"
+)
+
 // GenerateBranchName creates a branch name from components
 // Format: prefix/ticketID/sanitized-title (when title is provided)
 // Format: prefix/ticketID (when title is empty)
 // If prefix is empty and username is provided, uses sanitized username as prefix
 func GenerateBranchName(prefix, ticketID, title string, username ...string) string {
+	return GenerateBranchNameWithStyle(BranchStyleDefault, prefix, ticketID, title, username...)
+}
+
+// GenerateBranchNameWithStyle creates a branch name in the given style.
+// The linear style lower-cases the ticket ID and joins it to the title:
+// prefix/eng-123-sanitized-title. Unknown styles use the default format.
+func GenerateBranchNameWithStyle(style, prefix, ticketID, title string, username ...string) string {
 	// Use username as fallback if prefix is empty
 	branchPrefix := prefix
 	if branchPrefix == "" && len(username) > 0 && username[0] != "" {
 		branchPrefix = SanitizeUsername(username[0])
 	}
 
+	if style == BranchStyleLinear {
+		name := strings.ToLower(ticketID)
+		if sanitized := sanitizeTitle(title); sanitized != "" {
+			name += "-" + sanitized
+		}
+		return fmt.Sprintf("%s/%s", branchPrefix, name)
+	}
+
 	// If title is empty, return simple format: prefix/ticketID
 	if title == "" {
 		return fmt.Sprintf("%s/%s", branchPrefix, ticketID)
 	}
 
+	return fmt.Sprintf("%s/%s/%s", branchPrefix, ticketID, sanitizeTitle(title))
+}
+
+// sanitizeTitle turns a ticket title into a branch name part
+func sanitizeTitle(title string) string {
 	// Sanitize title: lowercase, replace spaces with hyphens, remove invalid chars
 	sanitized := strings.ToLower(title)
 	sanitized = strings.TrimSpace(sanitized)
@@ -51,9 +95,7 @@ func GenerateBranchName(prefix, ticketID, title string, username ...string) stri
 	sanitized = strings.Trim(sanitized, "-")
 
 	// Limit length and trim trailing hyphens
-	sanitized = limitLengthAndTrim(sanitized, maxBranchPartLength)
-
-	return fmt.Sprintf("%s/%s/%s", branchPrefix, ticketID, sanitized)
+	return limitLengthAndTrim(sanitized, maxBranchPartLength)
 }
 
 // limitLengthAndTrim limits a string to maxLen characters and trims trailing hyphens
@@ -170,21 +212,68 @@ func ValidateBranchName(name string) error {
 	return nil
 }
 
-// ExtractTicketID extracts a ticket ID from a branch name
+// ExtractTicketID extracts a ClickUp ticket ID or a Jira or Linear issue key
+// from a branch name
 func ExtractTicketID(branchName string) (string, error) {
-	matches := ticketIDPattern.FindStringSubmatch(branchName)
-	if len(matches) < 2 {
-		return "", fmt.Errorf("no ticket ID found in branch name: %s", branchName)
+	if matches := ticketIDPattern.FindStringSubmatch(branchName); len(matches) >= 2 {
+		return matches[1], nil
+	}
+	if matches := issueBranchKeyPattern.FindStringSubmatch(branchName); len(matches) >= 2 {
+		return matches[1], nil
+	}
+	return "", fmt.Errorf("no ticket ID found in branch name: %s", branchName)
+}
+
+// ExtractLinearTicketID extracts a ticket ID like ExtractTicketID, and also
+// the lower-case keys that start Linear-style branch names, returned
+// upper-cased. Only use it with Linear, since words followed by a number,
+// like fix-2-bugs or release-2024, look the same. With a team key, only
+// lower-case keys of that team are recognized.
+func ExtractLinearTicketID(branchName, teamKey string) (string, error) {
+	if ticketID, err := ExtractTicketID(branchName); err == nil {
+		return ticketID, nil
+	}
+	matches := linearBranchKeyPattern.FindStringSubmatch(branchName)
+	if len(matches) >= 2 && (teamKey == "" || strings.HasPrefix(matches[1], strings.ToLower(teamKey)+"-")) {
+		return strings.ToUpper(matches[1]), nil
 	}
-	return matches[1], nil
+	return "", fmt.Errorf("no ticket ID found in branch name: %s", branchName)
 }
 
-// IsTicketID checks if a string looks like a valid ticket ID
+// IsTicketID checks if a string looks like a valid ClickUp ticket ID or Jira or Linear issue key
 func IsTicketID(s string) bool {
-	if len(s) != 9 {
-		return false
+	return IsClickUpID(s) || IsIssueKey(s)
+}
+
+// IsClickUpID checks if a string looks like a ClickUp ticket ID: 9 lower-case
+// letters and digits
+func IsClickUpID(s string) bool {
+	return clickUpIDPattern.MatchString(s)
+}
+
+// IsIssueKey checks if a string looks like a Jira or Linear issue key, an
+// upper-case project or team key and a number, e.g. ABC-123
+func IsIssueKey(s string) bool {
+	return issueKeyPattern.MatchString(s)
+}
+
+// NormalizeTicketID returns a ticket ID in its canonical form: issue keys
+// are upper-cased, ClickUp IDs lower-cased
+func NormalizeTicketID(s string) string {
+	s = strings.TrimSpace(s)
+	if anyCaseIssueKeyPattern.MatchString(s) {
+		return strings.ToUpper(s)
+	}
+	return strings.ToLower(s)
+}
+
+// TicketReference returns how a ticket is referenced in PR descriptions:
+// ClickUp IDs get the CU- prefix, issue keys are used as-is
+func TicketReference(ticketID string) string {
+	if IsIssueKey(ticketID) {
+		return ticketID
 	}
-	return regexp.MustCompile(`^[a-z0-9]{9}$`).MatchString(s)
+	return "CU-" + ticketID
 }
 
 // SanitizeInput removes potentially dangerous characters from user input
@@ -193,38 +282,14 @@ func SanitizeInput(input string) string {
 }
 
 // GetRepoFromGitRemote extracts owner/repo from git remote URL
-// Supports both SSH and HTTPS formats:
+// Supports SSH and HTTPS formats on any host:
 // - git@github.com:owner/repo.git
 // - https://github.com/owner/repo.git
+// - https://gitlab.example.com/group/subgroup/repo.git (owner is group/subgroup)
 func GetRepoFromGitRemote(remoteURL string) (owner, repo string, err error) {
-	if remoteURL == "" {
-		return "", "", fmt.Errorf("empty remote URL")
-	}
-
-	// Handle SSH format: git@github.com:owner/repo.git
-	if strings.HasPrefix(remoteURL, "git@") {
-		parts := strings.Split(remoteURL, ":")
-		if len(parts) != 2 {
-			return "", "", fmt.Errorf("invalid SSH remote format")
-		}
-		path := strings.TrimSuffix(parts[1], ".git")
-		pathParts := strings.Split(path, "/")
-		if len(pathParts) != 2 {
-			return "", "", fmt.Errorf("invalid repository path in SSH remote")
-		}
-		return pathParts[0], pathParts[1], nil
-	}
-
-	// Handle HTTPS format: https://github.com/owner/repo.git
-	if path, found := strings.CutPrefix(remoteURL, "https://"); found {
-		path, _ = strings.CutPrefix(path, "github.com/")
-		path = strings.TrimSuffix(path, ".git")
-		parts := strings.Split(path, "/")
-		if len(parts) != 2 {
-			return "", "", fmt.Errorf("invalid repository path in HTTPS remote")
-		}
-		return parts[0], parts[1], nil
+	remote, err := ParseGitRemote(remoteURL)
+	if err != nil {
+		return "", "", err
 	}
-
-	return "", "", fmt.Errorf("unsupported remote URL format")
+	return remote.Owner, remote.Repo, nil
 }
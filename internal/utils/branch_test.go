@@ -96,6 +96,15 @@ func TestGenerateBranchName(t *testing.T) {
 	}
 }
 
+func TestGenerateBranchNameWithStyle(t *testing.T) {
+	assert.Equal(t, "john/ENG-482/fix-login-bug",
+		GenerateBranchNameWithStyle(BranchStyleDefault, "john", "ENG-482", "Fix Login Bug"))
+	assert.Equal(t, "john/eng-482-fix-login-bug",
+		GenerateBranchNameWithStyle(BranchStyleLinear, "john", "ENG-482", "Fix Login Bug!"))
+	assert.Equal(t, "john-doe/eng-482",
+		GenerateBranchNameWithStyle(BranchStyleLinear, "", "ENG-482", "", "John Doe"))
+}
+
 func TestValidateBranchName(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -170,6 +179,36 @@ func TestExtractTicketID(t *testing.T) {
 			expected:    "xyz789abc",
 			expectError: false,
 		},
+		{
+			name:        "branch with Jira key",
+			branchName:  "john/ABC-123/add-feature",
+			expected:    "ABC-123",
+			expectError: false,
+		},
+		{
+			name:        "branch ending with Jira key",
+			branchName:  "john/PROJ_2-7",
+			expected:    "PROJ_2-7",
+			expectError: false,
+		},
+		{
+			name:        "Linear-style branch needs ExtractLinearTicketID",
+			branchName:  "john/eng-482-fix-login",
+			expected:    "",
+			expectError: true,
+		},
+		{
+			name:        "words followed by a number",
+			branchName:  "rithy/fix-2-bugs",
+			expected:    "",
+			expectError: true,
+		},
+		{
+			name:        "release branch with a year",
+			branchName:  "rithy/release-2024-q3",
+			expected:    "",
+			expectError: true,
+		},
 		{
 			name:        "branch without ticket ID",
 			branchName:  "feature/add-something",
@@ -197,6 +236,87 @@ func TestExtractTicketID(t *testing.T) {
 	}
 }
 
+func TestExtractLinearTicketID(t *testing.T) {
+	tests := []struct {
+		name        string
+		branchName  string
+		teamKey     string
+		expected    string
+		expectError bool
+	}{
+		{
+			name:        "Linear-style branch",
+			branchName:  "john/eng-482-fix-login",
+			expected:    "ENG-482",
+			expectError: false,
+		},
+		{
+			name:        "Linear-style branch without title",
+			branchName:  "john/eng-482",
+			expected:    "ENG-482",
+			expectError: false,
+		},
+		{
+			name:        "branch with issue key",
+			branchName:  "john/ENG-482/fix-login",
+			expected:    "ENG-482",
+			expectError: false,
+		},
+		{
+			name:        "branch with ClickUp ID",
+			branchName:  "john/abc123xyz/add-feature",
+			expected:    "abc123xyz",
+			expectError: false,
+		},
+		{
+			name:        "branch without ticket ID",
+			branchName:  "feature/add-something",
+			expected:    "",
+			expectError: true,
+		},
+		{
+			name:        "key of the team",
+			branchName:  "john/eng-482-fix-login",
+			teamKey:     "ENG",
+			expected:    "ENG-482",
+			expectError: false,
+		},
+		{
+			name:        "release branch with a team key",
+			branchName:  "release-2024-q1",
+			teamKey:     "ENG",
+			expected:    "",
+			expectError: true,
+		},
+		{
+			name:        "key of another team",
+			branchName:  "john/ops-12-rotate-keys",
+			teamKey:     "ENG",
+			expected:    "",
+			expectError: true,
+		},
+		{
+			name:        "team key prefix of a longer word",
+			branchName:  "engine-2-upgrade",
+			teamKey:     "ENG",
+			expected:    "",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ExtractLinearTicketID(tt.branchName, tt.teamKey)
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
 func TestIsTicketID(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -238,6 +358,36 @@ func TestIsTicketID(t *testing.T) {
 			input:    "",
 			expected: false,
 		},
+		{
+			name:     "Jira key",
+			input:    "ABC-123",
+			expected: true,
+		},
+		{
+			name:     "lowercase Jira key",
+			input:    "abc-123",
+			expected: false,
+		},
+		{
+			name:     "release branch",
+			input:    "release-2024",
+			expected: false,
+		},
+		{
+			name:     "runtime version",
+			input:    "node-18",
+			expected: false,
+		},
+		{
+			name:     "Jira key without number",
+			input:    "ABC-",
+			expected: false,
+		},
+		{
+			name:     "single letter project",
+			input:    "A-1",
+			expected: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -248,6 +398,24 @@ func TestIsTicketID(t *testing.T) {
 	}
 }
 
+func TestIsClickUpID(t *testing.T) {
+	assert.True(t, IsClickUpID("abc123xyz"))
+	assert.False(t, IsClickUpID("ABC123XYZ"))
+	assert.False(t, IsClickUpID("ABC-123"))
+	assert.False(t, IsClickUpID("node-18"))
+}
+
+func TestNormalizeTicketID(t *testing.T) {
+	assert.Equal(t, "ABC-123", NormalizeTicketID("abc-123"))
+	assert.Equal(t, "ABC-123", NormalizeTicketID(" ABC-123 "))
+	assert.Equal(t, "abc123xyz", NormalizeTicketID("ABC123XYZ"))
+}
+
+func TestTicketReference(t *testing.T) {
+	assert.Equal(t, "CU-abc123xyz", TicketReference("abc123xyz"))
+	assert.Equal(t, "ABC-123", TicketReference("ABC-123"))
+}
+
 func TestSanitizeInput(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"encoding/json"
 	"sync"
 	"time"
 )
@@ -11,11 +12,13 @@ type CacheEntry struct {
 	Expiration time.Time
 }
 
-// Cache is a simple in-memory cache with TTL
+// Cache is a simple in-memory cache with TTL, optionally persisted to disk
 type Cache struct {
-	items map[string]*CacheEntry
-	mu    sync.RWMutex
-	ttl   time.Duration
+	items     map[string]*CacheEntry
+	mu        sync.RWMutex
+	ttl       time.Duration
+	store     *DiskCache
+	namespace string
 }
 
 // NewCache creates a new cache with the specified TTL
@@ -26,6 +29,16 @@ func NewCache(ttl time.Duration) *Cache {
 	}
 }
 
+// Persist writes entries through to a disk cache namespace so they survive
+// across invocations. Pass a nil store to keep the cache in memory only.
+func (c *Cache) Persist(store *DiskCache, namespace string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.store = store
+	c.namespace = namespace
+}
+
 // Get retrieves a value from the cache
 func (c *Cache) Get(key string) (interface{}, bool) {
 	c.mu.RLock()
@@ -49,10 +62,47 @@ func (c *Cache) Set(key string, value interface{}) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	expiration := time.Now().Add(c.ttl)
 	c.items[key] = &CacheEntry{
 		Value:      value,
-		Expiration: time.Now().Add(c.ttl),
+		Expiration: expiration,
+	}
+
+	if c.store != nil {
+		if data, err := json.Marshal(value); err == nil {
+			_ = c.store.Set(c.namespace, key, &DiskCacheEntry{
+				Key:       key,
+				Body:      data,
+				ExpiresAt: expiration,
+			})
+		}
+	}
+}
+
+// Load decodes a persisted value into dst. It is used when Get misses, since
+// values read back from disk need a concrete type. On success dst is also
+// cached in memory.
+func (c *Cache) Load(key string, dst interface{}) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.store == nil {
+		return false
+	}
+
+	entry, ok := c.store.Get(c.namespace, key)
+	if !ok {
+		return false
+	}
+	if err := json.Unmarshal(entry.Body, dst); err != nil {
+		return false
 	}
+
+	c.items[key] = &CacheEntry{
+		Value:      dst,
+		Expiration: entry.ExpiresAt,
+	}
+	return true
 }
 
 // Delete removes a value from the cache
@@ -61,6 +111,9 @@ func (c *Cache) Delete(key string) {
 	defer c.mu.Unlock()
 
 	delete(c.items, key)
+	if c.store != nil {
+		c.store.Delete(c.namespace, key)
+	}
 }
 
 // Clear removes all values from the cache
@@ -69,6 +122,9 @@ func (c *Cache) Clear() {
 	defer c.mu.Unlock()
 
 	c.items = make(map[string]*CacheEntry)
+	if c.store != nil {
+		_, _ = c.store.Clear(c.namespace)
+	}
 }
 
 // CleanExpired removes expired entries from the cache
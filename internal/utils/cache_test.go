@@ -149,3 +149,34 @@ func TestCache_DifferentTypes(t *testing.T) {
 	assert.True(t, found)
 	assert.Equal(t, struct{ Name string }{"test"}, value)
 }
+
+func TestCache_PersistAndLoad(t *testing.T) {
+	store := NewDiskCache(t.TempDir())
+
+	type value struct {
+		ID string `json:"id"`
+	}
+
+	writer := NewCache(1 * time.Minute)
+	writer.Persist(store, "sprint")
+	writer.Set("key1", &value{ID: "42"})
+
+	// A new cache instance reads the value back from disk
+	reader := NewCache(1 * time.Minute)
+	reader.Persist(store, "sprint")
+
+	_, found := reader.Get("key1")
+	assert.False(t, found)
+
+	loaded := &value{}
+	assert.True(t, reader.Load("key1", loaded))
+	assert.Equal(t, "42", loaded.ID)
+
+	cached, found := reader.Get("key1")
+	assert.True(t, found)
+	assert.Equal(t, loaded, cached)
+
+	// Delete removes the persisted copy too
+	reader.Delete("key1")
+	assert.False(t, writer.Load("key1", &value{}))
+}
@@ -0,0 +1,248 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DiskCacheEntry is a value stored in the disk cache
+type DiskCacheEntry struct {
+	Key          string    `json:"key"`                     // Human-readable key, e.g. the request URL
+	ETag         string    `json:"etag,omitempty"`          // Validator for If-None-Match
+	LastModified string    `json:"last_modified,omitempty"` // Validator for If-Modified-Since
+	Body         []byte    `json:"body"`
+	StoredAt     time.Time `json:"stored_at"`
+	ExpiresAt    time.Time `json:"expires_at"` // Entry is discarded after this time
+}
+
+// DiskCacheStats summarizes the entries stored for a single namespace
+type DiskCacheStats struct {
+	Namespace string
+	Entries   int
+	Expired   int
+	Bytes     int64
+	Oldest    time.Time
+	Newest    time.Time
+}
+
+// DiskCache is a file-backed cache that survives across invocations.
+// Entries are grouped into namespaces (one directory per service) and stored
+// as one JSON file per key.
+type DiskCache struct {
+	dir string
+	now func() time.Time
+}
+
+// NewDiskCache creates a disk cache rooted at dir. The directory is created
+// lazily on the first write.
+func NewDiskCache(dir string) *DiskCache {
+	return &DiskCache{
+		dir: dir,
+		now: time.Now,
+	}
+}
+
+// Dir returns the cache root directory
+func (c *DiskCache) Dir() string {
+	return c.dir
+}
+
+// Get returns the entry stored under key, or false if it is missing,
+// unreadable, or expired. Expired entries are removed.
+func (c *DiskCache) Get(namespace, key string) (*DiskCacheEntry, bool) {
+	path := c.path(namespace, key)
+
+	data, err := os.ReadFile(path) //nolint:gosec // Path is derived from a hash under the cache dir
+	if err != nil {
+		return nil, false
+	}
+
+	var entry DiskCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		_ = os.Remove(path)
+		return nil, false
+	}
+
+	if !entry.ExpiresAt.IsZero() && c.now().After(entry.ExpiresAt) {
+		_ = os.Remove(path)
+		return nil, false
+	}
+
+	return &entry, true
+}
+
+// Set stores entry under key. The file is written atomically so concurrent
+// invocations never read a partial entry.
+func (c *DiskCache) Set(namespace, key string, entry *DiskCacheEntry) error {
+	dir := filepath.Join(c.dir, namespace)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	if entry.StoredAt.IsZero() {
+		entry.StoredAt = c.now()
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode cache entry: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	tmpPath := tmp.Name()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, c.path(namespace, key)); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes the entry stored under key
+func (c *DiskCache) Delete(namespace, key string) {
+	_ = os.Remove(c.path(namespace, key))
+}
+
+// Clear removes all entries in the given namespaces, or every namespace if
+// none are given. It returns the number of entries removed.
+func (c *DiskCache) Clear(namespaces ...string) (int, error) {
+	if len(namespaces) == 0 {
+		var err error
+		namespaces, err = c.Namespaces()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	removed := 0
+	for _, namespace := range namespaces {
+		files, err := c.entryFiles(namespace)
+		if err != nil {
+			return removed, err
+		}
+		for _, file := range files {
+			if err := os.Remove(file); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return removed, fmt.Errorf("failed to remove cache entry: %w", err)
+			}
+			removed++
+		}
+	}
+
+	return removed, nil
+}
+
+// Namespaces returns the namespaces that have a directory in the cache, sorted by name
+func (c *DiskCache) Namespaces() ([]string, error) {
+	dirEntries, err := os.ReadDir(c.dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read cache directory: %w", err)
+	}
+
+	var namespaces []string
+	for _, d := range dirEntries {
+		if d.IsDir() {
+			namespaces = append(namespaces, d.Name())
+		}
+	}
+	sort.Strings(namespaces)
+
+	return namespaces, nil
+}
+
+// Stats returns per-namespace statistics, sorted by namespace
+func (c *DiskCache) Stats() ([]DiskCacheStats, error) {
+	namespaces, err := c.Namespaces()
+	if err != nil {
+		return nil, err
+	}
+
+	now := c.now()
+	stats := make([]DiskCacheStats, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		files, err := c.entryFiles(namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		s := DiskCacheStats{Namespace: namespace}
+		for _, file := range files {
+			data, err := os.ReadFile(file) //nolint:gosec // Path comes from listing the cache dir
+			if err != nil {
+				continue
+			}
+
+			var entry DiskCacheEntry
+			if err := json.Unmarshal(data, &entry); err != nil {
+				continue
+			}
+
+			s.Entries++
+			s.Bytes += int64(len(data))
+			if !entry.ExpiresAt.IsZero() && now.After(entry.ExpiresAt) {
+				s.Expired++
+			}
+			if s.Oldest.IsZero() || entry.StoredAt.Before(s.Oldest) {
+				s.Oldest = entry.StoredAt
+			}
+			if entry.StoredAt.After(s.Newest) {
+				s.Newest = entry.StoredAt
+			}
+		}
+		stats = append(stats, s)
+	}
+
+	return stats, nil
+}
+
+// entryFiles lists the entry files stored in a namespace
+func (c *DiskCache) entryFiles(namespace string) ([]string, error) {
+	dirEntries, err := os.ReadDir(filepath.Join(c.dir, namespace))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read cache directory: %w", err)
+	}
+
+	var files []string
+	for _, d := range dirEntries {
+		if d.IsDir() || !strings.HasSuffix(d.Name(), ".json") {
+			continue
+		}
+		files = append(files, filepath.Join(c.dir, namespace, d.Name()))
+	}
+
+	return files, nil
+}
+
+// path returns the file that stores key. Keys are hashed so they are safe to
+// use as file names and don't leak request details into the directory listing.
+func (c *DiskCache) path(namespace, key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, namespace, hex.EncodeToString(sum[:])+".json")
+}
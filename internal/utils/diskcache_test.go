@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiskCache_SetAndGet(t *testing.T) {
+	cache := NewDiskCache(t.TempDir())
+
+	require.NoError(t, cache.Set("github", "key1", &DiskCacheEntry{Key: "key1", ETag: `"abc"`, Body: []byte(`{"ok":true}`)}))
+
+	entry, found := cache.Get("github", "key1")
+	require.True(t, found)
+	assert.Equal(t, `"abc"`, entry.ETag)
+	assert.Equal(t, []byte(`{"ok":true}`), entry.Body)
+	assert.False(t, entry.StoredAt.IsZero())
+
+	// Namespaces are separate
+	_, found = cache.Get("clickup", "key1")
+	assert.False(t, found)
+}
+
+func TestDiskCache_ExpiredEntriesAreRemoved(t *testing.T) {
+	cache := NewDiskCache(t.TempDir())
+
+	require.NoError(t, cache.Set("sprint", "key1", &DiskCacheEntry{Body: []byte(`1`), ExpiresAt: time.Now().Add(-time.Minute)}))
+
+	_, found := cache.Get("sprint", "key1")
+	assert.False(t, found)
+
+	stats, err := cache.Stats()
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	assert.Equal(t, 0, stats[0].Entries)
+}
+
+func TestDiskCache_StatsAndClear(t *testing.T) {
+	cache := NewDiskCache(t.TempDir())
+
+	require.NoError(t, cache.Set("github", "a", &DiskCacheEntry{Body: []byte(`1`)}))
+	require.NoError(t, cache.Set("github", "b", &DiskCacheEntry{Body: []byte(`2`)}))
+	require.NoError(t, cache.Set("clickup", "c", &DiskCacheEntry{Body: []byte(`3`)}))
+
+	stats, err := cache.Stats()
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+	assert.Equal(t, "clickup", stats[0].Namespace)
+	assert.Equal(t, 1, stats[0].Entries)
+	assert.Equal(t, "github", stats[1].Namespace)
+	assert.Equal(t, 2, stats[1].Entries)
+	assert.Positive(t, stats[1].Bytes)
+
+	removed, err := cache.Clear("github")
+	require.NoError(t, err)
+	assert.Equal(t, 2, removed)
+
+	_, found := cache.Get("clickup", "c")
+	assert.True(t, found)
+
+	removed, err = cache.Clear()
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+}
+
+func TestDiskCache_MissingDirectory(t *testing.T) {
+	cache := NewDiskCache(t.TempDir() + "/missing")
+
+	stats, err := cache.Stats()
+	require.NoError(t, err)
+	assert.Empty(t, stats)
+
+	removed, err := cache.Clear()
+	require.NoError(t, err)
+	assert.Zero(t, removed)
+}
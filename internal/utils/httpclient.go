@@ -4,18 +4,79 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"math"
+	"math/rand/v2"
 	"net/http"
+	"net/url"
 	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 )
 
+// RetryPolicy controls how failed requests are retried
+type RetryPolicy struct {
+	MaxRetries int           // Maximum number of retries (0 disables retries)
+	BaseDelay  time.Duration // Initial backoff delay, doubled on each attempt
+	MaxDelay   time.Duration // Upper bound for a single backoff delay
+	MaxWait    time.Duration // Longest server-requested wait (Retry-After or rate-limit reset) to honor
+}
+
+// DefaultRetryPolicy returns the default retry policy
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		BaseDelay:  1 * time.Second,
+		MaxDelay:   30 * time.Second,
+		MaxWait:    60 * time.Second,
+	}
+}
+
+// RetryEvent describes a retry that is about to happen
+type RetryEvent struct {
+	Method     string
+	URL        string
+	Attempt    int
+	MaxRetries int
+	Delay      time.Duration
+	Err        error
+}
+
+// Reason returns a short human-readable reason for the retry
+func (e RetryEvent) Reason() string {
+	if httpErr := GetHTTPError(e.Err); httpErr != nil {
+		if httpErr.IsRateLimited() {
+			return "rate limited"
+		}
+		return fmt.Sprintf("server error (%d)", httpErr.StatusCode)
+	}
+	return "network error"
+}
+
+// responseCacheMaxAge is how long a cached response is kept for revalidation
+const responseCacheMaxAge = 7 * 24 * time.Hour
+
+// ResponseCache configures on-disk caching of GET responses. Responses with an
+// ETag or Last-Modified header are revalidated with a conditional request, so
+// unchanged data comes back as a 304 without a body.
+type ResponseCache struct {
+	Store     *DiskCache
+	Namespace string        // Groups entries per service, e.g. "github"
+	TTL       time.Duration // How long a response is reused without any request; 0 always revalidates
+	Refresh   bool          // Ignore cached responses but store fresh ones (--no-cache)
+}
+
 // HTTPClient wraps http.Client with additional utilities
 type HTTPClient struct {
 	client      *http.Client
-	maxRetries  int
+	retry       RetryPolicy
+	onRetry     func(RetryEvent)
+	cache       *ResponseCache
 	userAgent   string
 	enableDebug bool
 }
@@ -24,17 +85,101 @@ type HTTPClient struct {
 func NewHTTPClient(timeout time.Duration) *HTTPClient {
 	return &HTTPClient{
 		client: &http.Client{
-			Timeout: timeout,
+			Timeout:       timeout,
+			CheckRedirect: dropCredentialsOnRedirect,
 		},
-		maxRetries:  3,
+		retry:       DefaultRetryPolicy(),
+		onRetry:     printRetryEvent,
 		userAgent:   "vibe",
 		enableDebug: os.Getenv("VIBE_DEBUG") == "true",
 	}
 }
 
+// credentialHeaders are API token headers that, like Authorization, must not
+// follow a redirect to another host, e.g. from CircleCI to an artifact store,
+// or show up in debug logs. Names are canonical, as in http.Header.
+var credentialHeaders = []string{"Circle-Token", "Private-Token"}
+
+// debugHeaderValue returns a header value for debug logs, masking credentials
+func debugHeaderValue(key, value string) string {
+	key = http.CanonicalHeaderKey(key)
+	if key == "Authorization" || key == "Proxy-Authorization" || slices.Contains(credentialHeaders, key) {
+		return "***REDACTED***"
+	}
+	return value
+}
+
+// dropCredentialsOnRedirect removes credential headers from redirects to
+// another host and stops after 10 redirects, like the default policy
+func dropCredentialsOnRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= 10 {
+		return fmt.Errorf("stopped after 10 redirects")
+	}
+	if req.URL.Hostname() != via[0].URL.Hostname() {
+		for _, header := range credentialHeaders {
+			req.Header.Del(header)
+		}
+	}
+	return nil
+}
+
 // WithMaxRetries sets the maximum number of retries
 func (c *HTTPClient) WithMaxRetries(maxRetries int) *HTTPClient {
-	c.maxRetries = maxRetries
+	c.retry.MaxRetries = maxRetries
+	return c
+}
+
+// WithRetryPolicy sets the retry policy. Zero delays keep their defaults.
+func (c *HTTPClient) WithRetryPolicy(policy RetryPolicy) *HTTPClient {
+	defaults := DefaultRetryPolicy()
+	if policy.BaseDelay <= 0 {
+		policy.BaseDelay = defaults.BaseDelay
+	}
+	if policy.MaxDelay <= 0 {
+		policy.MaxDelay = defaults.MaxDelay
+	}
+	if policy.MaxWait <= 0 {
+		policy.MaxWait = defaults.MaxWait
+	}
+	if policy.MaxRetries < 0 {
+		policy.MaxRetries = 0
+	}
+	c.retry = policy
+	return c
+}
+
+// WithRetryNotifier sets the function called before each retry.
+// Pass nil to retry silently.
+func (c *HTTPClient) WithRetryNotifier(fn func(RetryEvent)) *HTTPClient {
+	c.onRetry = fn
+	return c
+}
+
+// printRetryEvent is the default retry notifier. It writes to stderr so it
+// doesn't interfere with command output.
+func printRetryEvent(e RetryEvent) {
+	host := e.URL
+	if u, err := url.Parse(e.URL); err == nil && u.Host != "" {
+		host = u.Host
+	}
+	fmt.Fprintf(os.Stderr, "\r\033[K⚠ %s: %s, retrying in %s (attempt %d/%d)\n",
+		host, e.Reason(), formatRetryDelay(e.Delay), e.Attempt, e.MaxRetries)
+}
+
+// formatRetryDelay formats a retry delay as whole seconds, e.g. "12s"
+func formatRetryDelay(d time.Duration) string {
+	if d < time.Second {
+		return d.Round(100 * time.Millisecond).String()
+	}
+	return fmt.Sprintf("%ds", int(math.Ceil(d.Seconds())))
+}
+
+// WithCache enables on-disk caching of GET responses. Pass nil to disable it.
+func (c *HTTPClient) WithCache(cache *ResponseCache) *HTTPClient {
+	if cache != nil && cache.Store == nil {
+		cache = nil
+	}
+	c.cache = cache
 	return c
 }
 
@@ -69,11 +214,7 @@ func (c *HTTPClient) DoRequest(
 		fmt.Fprintf(os.Stderr, "[DEBUG] %s %s\n", method, url)
 		for key, values := range req.Header {
 			for _, value := range values {
-				// Mask authorization headers
-				if key == "Authorization" {
-					value = "***REDACTED***"
-				}
-				fmt.Fprintf(os.Stderr, "[DEBUG] %s: %s\n", key, value)
+				fmt.Fprintf(os.Stderr, "[DEBUG] %s: %s\n", key, debugHeaderValue(key, value))
 			}
 		}
 	}
@@ -91,7 +232,11 @@ func (c *HTTPClient) DoRequest(
 	return resp, nil
 }
 
-// DoJSONRequest executes a JSON request and decodes the response
+// DoJSONRequest executes a JSON request and decodes the response. A *[]byte
+// respBody receives the raw response body, for endpoints that return text.
+// Rate-limited requests are retried for any method since the server rejected
+// them without processing. Server errors (5xx) and network errors are retried
+// only for idempotent methods.
 func (c *HTTPClient) DoJSONRequest(
 	ctx context.Context,
 	method, url string,
@@ -99,13 +244,13 @@ func (c *HTTPClient) DoJSONRequest(
 	respBody interface{},
 	headers map[string]string,
 ) error {
-	var bodyReader io.Reader
+	var jsonData []byte
 	if reqBody != nil {
-		jsonData, err := json.Marshal(reqBody)
+		var err error
+		jsonData, err = json.Marshal(reqBody)
 		if err != nil {
 			return fmt.Errorf("failed to marshal request body: %w", err)
 		}
-		bodyReader = bytes.NewReader(jsonData)
 
 		if c.enableDebug {
 			fmt.Fprintf(os.Stderr, "[DEBUG] Request body: %s\n", string(jsonData))
@@ -120,6 +265,61 @@ func (c *HTTPClient) DoJSONRequest(
 		headers["Content-Type"] = "application/json"
 	}
 
+	for attempt := 0; ; attempt++ {
+		// Rebuild the body reader for every attempt
+		var bodyReader io.Reader
+		if jsonData != nil {
+			bodyReader = bytes.NewReader(jsonData)
+		}
+
+		err := c.doJSONRequestOnce(ctx, method, url, bodyReader, respBody, headers)
+		if err == nil {
+			return nil
+		}
+
+		delay, retry := c.retryDelay(method, err, attempt+1)
+		if !retry {
+			return err
+		}
+
+		if c.onRetry != nil {
+			c.onRetry(RetryEvent{
+				Method:     method,
+				URL:        url,
+				Attempt:    attempt + 1,
+				MaxRetries: c.retry.MaxRetries,
+				Delay:      delay,
+				Err:        err,
+			})
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// doJSONRequestOnce performs a single JSON request attempt
+func (c *HTTPClient) doJSONRequestOnce(
+	ctx context.Context,
+	method, url string,
+	bodyReader io.Reader,
+	respBody interface{},
+	headers map[string]string,
+) error {
+	cacheKey, cached := c.lookupCache(method, url, headers)
+	if cached != nil {
+		if c.cache.TTL > 0 && time.Since(cached.StoredAt) < c.cache.TTL {
+			if c.enableDebug {
+				fmt.Fprintf(os.Stderr, "[DEBUG] Cache hit: %s\n", url)
+			}
+			return decodeResponseBody(cached.Body, respBody)
+		}
+		headers = conditionalHeaders(headers, cached)
+	}
+
 	resp, err := c.DoRequest(ctx, method, url, bodyReader, headers)
 	if err != nil {
 		return err
@@ -136,39 +336,236 @@ func (c *HTTPClient) DoJSONRequest(
 		fmt.Fprintf(os.Stderr, "[DEBUG] Response body: %s\n", string(respData))
 	}
 
+	// Unchanged since the cached copy: reuse it and extend its lifetime
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		if etag := resp.Header.Get("ETag"); etag != "" {
+			cached.ETag = etag
+		}
+		c.storeCache(cacheKey, cached)
+		return decodeResponseBody(cached.Body, respBody)
+	}
+
 	// Check for HTTP errors
 	if resp.StatusCode >= 400 {
-		return &HTTPError{
-			StatusCode: resp.StatusCode,
-			Status:     resp.Status,
-			Body:       string(respData),
-		}
+		return newHTTPError(resp, respData, time.Now())
 	}
 
-	// Decode response if respBody is provided
-	if respBody != nil && len(respData) > 0 {
-		if err := json.Unmarshal(respData, respBody); err != nil {
+	c.updateCache(method, url, cacheKey, resp, respData)
+
+	return decodeResponseBody(respData, respBody)
+}
+
+// decodeResponseBody decodes a JSON response body if respBody is provided
+func decodeResponseBody(data []byte, respBody interface{}) error {
+	if raw, ok := respBody.(*[]byte); ok {
+		*raw = data
+		return nil
+	}
+	if respBody != nil && len(data) > 0 {
+		if err := json.Unmarshal(data, respBody); err != nil {
 			return fmt.Errorf("failed to unmarshal response: %w", err)
 		}
 	}
-
 	return nil
 }
 
-// RetryWithBackoff retries an operation with exponential backoff
+// lookupCache returns the cache key for a GET request and the cached entry,
+// if any. The key is empty when the request isn't cacheable.
+func (c *HTTPClient) lookupCache(method, url string, headers map[string]string) (string, *DiskCacheEntry) {
+	if c.cache == nil || !strings.EqualFold(method, http.MethodGet) {
+		return "", nil
+	}
+
+	key := responseCacheKey(url, headers)
+	if c.cache.Refresh {
+		return key, nil
+	}
+
+	entry, ok := c.cache.Store.Get(c.cache.Namespace, key)
+	if !ok {
+		return key, nil
+	}
+	return key, entry
+}
+
+// updateCache stores a successful GET response. Successful writes drop the
+// service's entries when they can be served without revalidation, since
+// they may no longer reflect the server's state.
+func (c *HTTPClient) updateCache(method, url, key string, resp *http.Response, body []byte) {
+	if c.cache == nil || resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return
+	}
+
+	if key == "" {
+		if c.cache.TTL > 0 && !isSafeMethod(method) {
+			_, _ = c.cache.Store.Clear(c.cache.Namespace)
+		}
+		return
+	}
+
+	entry := &DiskCacheEntry{
+		Key:          url,
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+		Body:         body,
+	}
+	if entry.ETag == "" && entry.LastModified == "" && c.cache.TTL <= 0 {
+		return
+	}
+	c.storeCache(key, entry)
+}
+
+// storeCache writes an entry, ignoring failures since the cache is best-effort
+func (c *HTTPClient) storeCache(key string, entry *DiskCacheEntry) {
+	now := time.Now()
+	entry.StoredAt = now
+	entry.ExpiresAt = now.Add(responseCacheMaxAge)
+	if err := c.cache.Store.Set(c.cache.Namespace, key, entry); err != nil && c.enableDebug {
+		fmt.Fprintf(os.Stderr, "[DEBUG] Failed to cache response: %v\n", err)
+	}
+}
+
+// responseCacheKey identifies a GET request by URL and headers. Headers are
+// part of the key so responses for different tokens are never shared.
+func responseCacheKey(url string, headers map[string]string) string {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	b.WriteString(url)
+	for _, name := range names {
+		b.WriteString("\n")
+		b.WriteString(strings.ToLower(name))
+		b.WriteString(": ")
+		b.WriteString(headers[name])
+	}
+	return b.String()
+}
+
+// conditionalHeaders returns a copy of headers with the validators of a
+// cached entry added
+func conditionalHeaders(headers map[string]string, entry *DiskCacheEntry) map[string]string {
+	result := make(map[string]string, len(headers)+2)
+	for k, v := range headers {
+		result[k] = v
+	}
+	if entry.ETag != "" {
+		result["If-None-Match"] = entry.ETag
+	}
+	if entry.LastModified != "" {
+		result["If-Modified-Since"] = entry.LastModified
+	}
+	return result
+}
+
+// retryDelay decides whether a failed attempt should be retried and how long
+// to wait first. attempt is the 1-based number of the retry about to happen.
+func (c *HTTPClient) retryDelay(method string, err error, attempt int) (time.Duration, bool) {
+	if attempt > c.retry.MaxRetries {
+		return 0, false
+	}
+
+	// Never retry cancelled or timed-out contexts
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return 0, false
+	}
+
+	httpErr := GetHTTPError(err)
+	if httpErr == nil {
+		// Network error
+		if !isIdempotent(method) {
+			return 0, false
+		}
+		return c.backoff(attempt), true
+	}
+
+	if httpErr.IsRateLimited() {
+		wait := httpErr.RetryAfter
+		if wait <= 0 && httpErr.RateLimit != nil && !httpErr.RateLimit.Reset.IsZero() {
+			wait = time.Until(httpErr.RateLimit.Reset) + time.Second
+		}
+		if wait <= 0 {
+			wait = c.backoff(attempt)
+		}
+		// Give up rather than block for longer than the budget allows
+		if wait > c.retry.MaxWait {
+			return 0, false
+		}
+		return wait, true
+	}
+
+	if !isIdempotent(method) || !isRetryableStatus(httpErr.StatusCode) {
+		return 0, false
+	}
+
+	if httpErr.RetryAfter > 0 && httpErr.RetryAfter <= c.retry.MaxWait {
+		return httpErr.RetryAfter, true
+	}
+	return c.backoff(attempt), true
+}
+
+// backoff returns a jittered exponential backoff delay for the given attempt
+func (c *HTTPClient) backoff(attempt int) time.Duration {
+	delay := time.Duration(math.Pow(2, float64(attempt-1))) * c.retry.BaseDelay
+	if delay > c.retry.MaxDelay || delay <= 0 {
+		delay = c.retry.MaxDelay
+	}
+
+	// Equal jitter: half fixed, half random, to avoid synchronized retries
+	half := delay / 2
+	if half <= 0 {
+		return delay
+	}
+	return half + rand.N(half)
+}
+
+// isIdempotent reports whether a request with the given method is safe to repeat
+func isIdempotent(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// isSafeMethod reports whether a request with the given method doesn't modify server state
+func isSafeMethod(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
+
+// isRetryableStatus reports whether a status code indicates a transient server failure
+func isRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusRequestTimeout,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// RetryWithBackoff retries an operation with jittered exponential backoff
 func (c *HTTPClient) RetryWithBackoff(ctx context.Context, operation func() error) error {
 	var lastErr error
 
-	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+	for attempt := 0; attempt <= c.retry.MaxRetries; attempt++ {
 		if attempt > 0 {
-			// Calculate backoff duration
-			backoff := time.Duration(math.Pow(2, float64(attempt-1))) * time.Second
-			if backoff > 30*time.Second {
-				backoff = 30 * time.Second
-			}
+			backoff := c.backoff(attempt)
 
 			if c.enableDebug {
-				fmt.Fprintf(os.Stderr, "[DEBUG] Retrying in %v (attempt %d/%d)\n", backoff, attempt, c.maxRetries)
+				fmt.Fprintf(os.Stderr, "[DEBUG] Retrying in %v (attempt %d/%d)\n", backoff, attempt, c.retry.MaxRetries)
 			}
 
 			select {
@@ -186,14 +583,21 @@ func (c *HTTPClient) RetryWithBackoff(ctx context.Context, operation func() erro
 		lastErr = err
 
 		// Don't retry on client errors (4xx), only on 5xx or network errors
-		if httpErr, ok := err.(*HTTPError); ok {
+		if httpErr := GetHTTPError(err); httpErr != nil {
 			if httpErr.StatusCode >= 400 && httpErr.StatusCode < 500 {
 				return err
 			}
 		}
 	}
 
-	return fmt.Errorf("operation failed after %d retries: %w", c.maxRetries, lastErr)
+	return fmt.Errorf("operation failed after %d retries: %w", c.retry.MaxRetries, lastErr)
+}
+
+// RateLimitInfo holds the rate-limit state reported by the X-RateLimit-* headers
+type RateLimitInfo struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time // Zero if the server didn't report a reset time
 }
 
 // HTTPError represents an HTTP error response
@@ -201,21 +605,103 @@ type HTTPError struct {
 	StatusCode int
 	Status     string
 	Body       string
+	RetryAfter time.Duration  // Parsed Retry-After header, zero if absent
+	RateLimit  *RateLimitInfo // Parsed X-RateLimit-* headers, nil if absent
 }
 
 func (e *HTTPError) Error() string {
 	return fmt.Sprintf("HTTP %d: %s - %s", e.StatusCode, e.Status, e.Body)
 }
 
+// IsRateLimited reports whether the error was caused by a rate limit. GitHub
+// signals rate limits with 403 and X-RateLimit-Remaining: 0 or a Retry-After header.
+func (e *HTTPError) IsRateLimited() bool {
+	if e.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if e.StatusCode == http.StatusForbidden {
+		if e.RetryAfter > 0 {
+			return true
+		}
+		if e.RateLimit != nil && e.RateLimit.Remaining == 0 && !e.RateLimit.Reset.IsZero() {
+			return true
+		}
+	}
+	return false
+}
+
+// newHTTPError builds an HTTPError from a response, parsing rate-limit headers
+func newHTTPError(resp *http.Response, body []byte, now time.Time) *HTTPError {
+	return &HTTPError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Body:       string(body),
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), now),
+		RateLimit:  parseRateLimit(resp.Header, now),
+	}
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+		if seconds <= 0 {
+			return 0
+		}
+		return time.Duration(seconds * float64(time.Second))
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// parseRateLimit parses the X-RateLimit-* headers. The reset header is
+// interpreted as Unix seconds, Unix milliseconds, or seconds from now,
+// depending on its magnitude, since each API uses a different convention.
+func parseRateLimit(header http.Header, now time.Time) *RateLimitInfo {
+	limitStr := header.Get("X-RateLimit-Limit")
+	remainingStr := header.Get("X-RateLimit-Remaining")
+	resetStr := header.Get("X-RateLimit-Reset")
+	if limitStr == "" && remainingStr == "" && resetStr == "" {
+		return nil
+	}
+
+	info := &RateLimitInfo{Limit: -1, Remaining: -1}
+	if n, err := strconv.Atoi(strings.TrimSpace(limitStr)); err == nil {
+		info.Limit = n
+	}
+	if n, err := strconv.Atoi(strings.TrimSpace(remainingStr)); err == nil {
+		info.Remaining = n
+	}
+	if n, err := strconv.ParseInt(strings.TrimSpace(resetStr), 10, 64); err == nil && n > 0 {
+		switch {
+		case n > 1e12:
+			info.Reset = time.UnixMilli(n)
+		case n > 1e9:
+			info.Reset = time.Unix(n, 0)
+		default:
+			info.Reset = now.Add(time.Duration(n) * time.Second)
+		}
+	}
+
+	return info
+}
+
 // IsHTTPError checks if an error is an HTTP error
 func IsHTTPError(err error) bool {
-	_, ok := err.(*HTTPError)
-	return ok
+	return GetHTTPError(err) != nil
 }
 
-// GetHTTPError extracts HTTPError from an error
+// GetHTTPError extracts HTTPError from an error, unwrapping as needed
 func GetHTTPError(err error) *HTTPError {
-	if httpErr, ok := err.(*HTTPError); ok {
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
 		return httpErr
 	}
 	return nil
@@ -0,0 +1,322 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestHTTPClient creates a client with fast retries for tests
+func newTestHTTPClient(events *[]RetryEvent) *HTTPClient {
+	return NewHTTPClient(0).
+		WithRetryPolicy(RetryPolicy{
+			MaxRetries: 3,
+			BaseDelay:  time.Millisecond,
+			MaxDelay:   5 * time.Millisecond,
+			MaxWait:    2 * time.Second,
+		}).
+		WithRetryNotifier(func(e RetryEvent) {
+			if events != nil {
+				*events = append(*events, e)
+			}
+		})
+}
+
+func TestDoJSONRequest_RetriesServerErrorForGET(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	var events []RetryEvent
+	var resp struct {
+		OK bool `json:"ok"`
+	}
+	err := newTestHTTPClient(&events).DoJSONRequest(context.Background(), "GET", server.URL, nil, &resp, nil)
+
+	require.NoError(t, err)
+	assert.True(t, resp.OK)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	require.Len(t, events, 2)
+	assert.Equal(t, "server error (502)", events[0].Reason())
+}
+
+func TestDoJSONRequest_DoesNotRetryServerErrorForPOST(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	err := newTestHTTPClient(nil).DoJSONRequest(context.Background(), "POST", server.URL, map[string]string{"a": "b"}, nil, nil)
+
+	require.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	assert.Equal(t, http.StatusServiceUnavailable, GetHTTPError(err).StatusCode)
+}
+
+func TestDoJSONRequest_RetriesRateLimitForPOST(t *testing.T) {
+	var calls int32
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		buf := make([]byte, r.ContentLength)
+		_, _ = r.Body.Read(buf)
+		bodies = append(bodies, string(buf))
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var events []RetryEvent
+	err := newTestHTTPClient(&events).DoJSONRequest(context.Background(), "POST", server.URL, map[string]string{"a": "b"}, nil, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	assert.Equal(t, []string{`{"a":"b"}`, `{"a":"b"}`}, bodies, "body should be resent on retry")
+	require.Len(t, events, 1)
+	assert.Equal(t, "rate limited", events[0].Reason())
+}
+
+func TestDoJSONRequest_GivesUpWhenRetryAfterExceedsBudget(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Retry-After", "120")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	err := newTestHTTPClient(nil).DoJSONRequest(context.Background(), "GET", server.URL, nil, nil, nil)
+
+	require.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	httpErr := GetHTTPError(fmt.Errorf("wrapped: %w", err))
+	require.NotNil(t, httpErr)
+	assert.True(t, httpErr.IsRateLimited())
+	assert.Equal(t, 120*time.Second, httpErr.RetryAfter)
+}
+
+func TestDoJSONRequest_StopsAfterMaxRetries(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	err := newTestHTTPClient(nil).DoJSONRequest(context.Background(), "GET", server.URL, nil, nil, nil)
+
+	require.Error(t, err)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&calls))
+}
+
+func TestDoJSONRequest_DoesNotRetryClientErrors(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	err := newTestHTTPClient(nil).DoJSONRequest(context.Background(), "GET", server.URL, nil, nil, nil)
+
+	require.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestHTTPError_IsRateLimited_GitHubPrimaryLimit(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", "5000")
+	header.Set("X-RateLimit-Remaining", "0")
+	header.Set("X-RateLimit-Reset", "1700000012")
+
+	httpErr := newHTTPError(&http.Response{StatusCode: http.StatusForbidden, Header: header}, nil, now)
+
+	assert.True(t, httpErr.IsRateLimited())
+	require.NotNil(t, httpErr.RateLimit)
+	assert.Equal(t, 5000, httpErr.RateLimit.Limit)
+	assert.Equal(t, 0, httpErr.RateLimit.Remaining)
+	assert.Equal(t, 12*time.Second, httpErr.RateLimit.Reset.Sub(now))
+}
+
+func TestHTTPError_ForbiddenWithoutRateLimitIsNotRateLimited(t *testing.T) {
+	httpErr := newHTTPError(&http.Response{StatusCode: http.StatusForbidden, Header: http.Header{}}, nil, time.Now())
+
+	assert.False(t, httpErr.IsRateLimited())
+	assert.Nil(t, httpErr.RateLimit)
+}
+
+func TestParseRateLimit_ResetFormats(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name  string
+		reset string
+		want  time.Duration
+	}{
+		{"unix seconds", "1700000030", 30 * time.Second},
+		{"unix milliseconds", "1700000030000", 30 * time.Second},
+		{"seconds from now", "30", 30 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			header.Set("X-RateLimit-Reset", tt.reset)
+
+			info := parseRateLimit(header, now)
+
+			require.NotNil(t, info)
+			assert.Equal(t, tt.want, info.Reset.Sub(now))
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 12*time.Second, parseRetryAfter("12", now))
+	assert.Equal(t, 30*time.Second, parseRetryAfter(now.Add(30*time.Second).Format(http.TimeFormat), now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("soon", now))
+}
+
+func TestBackoff_IsJitteredAndCapped(t *testing.T) {
+	client := NewHTTPClient(0).WithRetryPolicy(RetryPolicy{
+		MaxRetries: 5,
+		BaseDelay:  time.Second,
+		MaxDelay:   4 * time.Second,
+	})
+
+	for i := 0; i < 20; i++ {
+		d := client.backoff(2)
+		assert.GreaterOrEqual(t, d, time.Second)
+		assert.Less(t, d, 2*time.Second)
+
+		capped := client.backoff(10)
+		assert.GreaterOrEqual(t, capped, 2*time.Second)
+		assert.Less(t, capped, 4*time.Second)
+	}
+}
+
+func TestDoJSONRequest_RevalidatesCachedResponseWithETag(t *testing.T) {
+	var calls, notModified int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			atomic.AddInt32(&notModified, 1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(`{"name":"cached"}`))
+	}))
+	defer server.Close()
+
+	cache := &ResponseCache{Store: NewDiskCache(t.TempDir()), Namespace: "github"}
+	headers := map[string]string{"Authorization": "token a"}
+
+	for i := 0; i < 2; i++ {
+		var resp struct {
+			Name string `json:"name"`
+		}
+		err := newTestHTTPClient(nil).WithCache(cache).DoJSONRequest(context.Background(), "GET", server.URL, nil, &resp, headers)
+		require.NoError(t, err)
+		assert.Equal(t, "cached", resp.Name)
+	}
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&notModified))
+
+	// A different token doesn't share the cached entry
+	err := newTestHTTPClient(nil).WithCache(cache).DoJSONRequest(context.Background(), "GET", server.URL, nil, nil,
+		map[string]string{"Authorization": "token b"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&notModified))
+}
+
+func TestDoJSONRequest_ServesCachedResponseWithinTTL(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		_, _ = fmt.Fprintf(w, `{"n":%d}`, n)
+	}))
+	defer server.Close()
+
+	cache := &ResponseCache{Store: NewDiskCache(t.TempDir()), Namespace: "clickup", TTL: time.Minute}
+	get := func(c *ResponseCache) int {
+		var resp struct {
+			N int `json:"n"`
+		}
+		require.NoError(t, newTestHTTPClient(nil).WithCache(c).DoJSONRequest(context.Background(), "GET", server.URL, nil, &resp, nil))
+		return resp.N
+	}
+
+	assert.Equal(t, 1, get(cache))
+	assert.Equal(t, 1, get(cache))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	// Refresh skips the cached copy but stores the fresh one
+	refresh := *cache
+	refresh.Refresh = true
+	assert.Equal(t, 2, get(&refresh))
+	assert.Equal(t, 2, get(cache))
+
+	// A successful write drops entries that would be served without revalidation
+	require.NoError(t, newTestHTTPClient(nil).WithCache(cache).DoJSONRequest(context.Background(), "PUT", server.URL, map[string]string{}, nil, nil))
+	assert.Equal(t, 4, get(cache))
+}
+
+func TestDoRequest_DropsCredentialHeadersOnCrossHostRedirect(t *testing.T) {
+	for _, header := range []string{"Circle-Token", "PRIVATE-TOKEN"} {
+		t.Run(header, func(t *testing.T) {
+			var token string
+			target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				token = r.Header.Get(header)
+				_, _ = w.Write([]byte("ok"))
+			}))
+			defer target.Close()
+
+			// Redirect to "localhost" so the hostname differs from 127.0.0.1
+			redirectURL := strings.Replace(target.URL, "127.0.0.1", "localhost", 1)
+			origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				http.Redirect(w, r, redirectURL, http.StatusFound)
+			}))
+			defer origin.Close()
+
+			resp, err := NewHTTPClient(0).DoRequest(context.Background(), "GET", origin.URL, nil, map[string]string{header: "secret"})
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Empty(t, token)
+		})
+	}
+}
+
+func TestDebugHeaderValue_MasksCredentials(t *testing.T) {
+	for _, key := range []string{"Authorization", "Proxy-Authorization", "Circle-Token", "PRIVATE-TOKEN", "circle-token"} {
+		assert.Equal(t, "***REDACTED***", debugHeaderValue(key, "secret"), key)
+	}
+	assert.Equal(t, "application/json", debugHeaderValue("Content-Type", "application/json"))
+	assert.Equal(t, "vibe", debugHeaderValue("User-Agent", "vibe"))
+}
@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// Notify shows a desktop notification, using osascript on macOS and
+// notify-send elsewhere
+func Notify(title, message string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		script := fmt.Sprintf("display notification %s with title %s", appleScriptString(message), appleScriptString(title))
+		cmd = exec.Command("osascript", "-e", script)
+	case "windows":
+		return fmt.Errorf("desktop notifications are not supported on windows")
+	default:
+		cmd = exec.Command("notify-send", title, message)
+	}
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to show notification: %w", err)
+	}
+	return nil
+}
+
+// appleScriptString quotes s as an AppleScript string literal
+func appleScriptString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
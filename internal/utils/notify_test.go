@@ -0,0 +1,12 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppleScriptString(t *testing.T) {
+	assert.Equal(t, `"CI passed"`, appleScriptString("CI passed"))
+	assert.Equal(t, `"say \"hi\" \\ bye"`, appleScriptString(`say "hi" \ bye`))
+}
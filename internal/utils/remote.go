@@ -0,0 +1,90 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"os/exec"
+	"strings"
+)
+
+// GitRemote is a parsed git remote URL
+type GitRemote struct {
+	Host  string // Hostname without port, e.g. github.com or gitlab.example.com
+	Owner string // Owner or namespace; GitLab subgroups are joined with "/"
+	Repo  string // Repository name without .git
+}
+
+// Path returns the full repository path, e.g. owner/repo or group/subgroup/repo
+func (r *GitRemote) Path() string {
+	return r.Owner + "/" + r.Repo
+}
+
+// ParseGitRemote parses a git remote URL on any host. Supported formats:
+// - git@host:owner/repo.git
+// - ssh://git@host[:port]/owner/repo.git
+// - https://host[:port]/owner/repo.git
+func ParseGitRemote(remoteURL string) (*GitRemote, error) {
+	remoteURL = strings.TrimSpace(remoteURL)
+	if remoteURL == "" {
+		return nil, fmt.Errorf("empty remote URL")
+	}
+
+	var host, path string
+	if strings.Contains(remoteURL, "://") {
+		u, err := url.Parse(remoteURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid remote URL: %w", err)
+		}
+		switch u.Scheme {
+		case "https", "http", "ssh":
+		default:
+			return nil, fmt.Errorf("unsupported remote URL format")
+		}
+		host, path = u.Hostname(), u.Path
+	} else {
+		// scp-like SSH syntax: [user@]host:owner/repo.git
+		hostPart, pathPart, found := strings.Cut(remoteURL, ":")
+		if !found {
+			return nil, fmt.Errorf("unsupported remote URL format")
+		}
+		if _, h, hasUser := strings.Cut(hostPart, "@"); hasUser {
+			hostPart = h
+		}
+		host, path = hostPart, pathPart
+	}
+
+	path = strings.Trim(strings.TrimSuffix(strings.Trim(path, "/"), ".git"), "/")
+	parts := strings.Split(path, "/")
+	if host == "" || len(parts) < 2 {
+		return nil, fmt.Errorf("invalid repository path in remote: %s", remoteURL)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("invalid repository path in remote: %s", remoteURL)
+		}
+	}
+
+	return &GitRemote{
+		Host:  strings.ToLower(host),
+		Owner: strings.Join(parts[:len(parts)-1], "/"),
+		Repo:  parts[len(parts)-1],
+	}, nil
+}
+
+// GetOriginRemote returns the parsed URL of the origin remote of the
+// repository in the current directory
+func GetOriginRemote() (*GitRemote, error) {
+	cmd := exec.Command("git", "remote", "get-url", "origin")
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get git remote: %w", err)
+	}
+
+	remoteURL := strings.TrimSpace(string(output))
+	remote, err := ParseGitRemote(remoteURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse git remote '%s': %w", remoteURL, err)
+	}
+
+	return remote, nil
+}
@@ -3,11 +3,15 @@ package utils
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"time"
 
 	"github.com/rithyhuot/vibe/internal/models"
 )
 
+// sprintDateRangeRegex matches a "(MM/DD - MM/DD)" range in a sprint name
+var sprintDateRangeRegex = regexp.MustCompile(`\((\d{1,2}/\d{1,2})\s*-\s*(\d{1,2}/\d{1,2})\)`)
+
 // SprintMatch represents a sprint with parsed dates
 type SprintMatch struct {
 	Folder    *models.Folder
@@ -20,16 +24,17 @@ type SprintMatch struct {
 //   - "Sprint 5 (1/19 - 2/1)"
 //   - "97 Commerce 1 (1/5 - 1/18)"
 //   - Any folder with "(MM/DD - MM/DD)" pattern
-func FindCurrentSprintByDate(folders []*models.Folder, patterns []string) *models.Folder {
+//
+// folderID is the sprint folder the folders come from. The cache is persisted,
+// so it's keyed by the folder as well as the patterns, which workspaces may share.
+func FindCurrentSprintByDate(folderID string, folders []*models.Folder, patterns []string) *models.Folder {
 	// Check cache first
-	cacheKey := fmt.Sprintf("sprint:%v", patterns)
-	if cached, found := GetSprintCache().Get(cacheKey); found {
-		if folder, ok := cached.(*models.Folder); ok {
-			// Verify the cached folder still exists in the list
-			for _, f := range folders {
-				if f.ID == folder.ID {
-					return folder
-				}
+	cacheKey := fmt.Sprintf("sprint:%s:%v", folderID, patterns)
+	if folder := cachedSprint(cacheKey); folder != nil {
+		// Verify the cached folder still exists in the list
+		for _, f := range folders {
+			if f.ID == folder.ID {
+				return folder
 			}
 		}
 	}
@@ -44,104 +49,34 @@ func FindCurrentSprintByDate(folders []*models.Folder, patterns []string) *model
 	return result
 }
 
-//nolint:gocyclo // Sprint date range parsing requires multiple conditions
-func findCurrentSprintByDateUncached(folders []*models.Folder, patterns []string) *models.Folder {
-	today := time.Now()
-	currentYear := today.Year()
-
-	var matches []SprintMatch
-
-	for _, folder := range folders {
-		// If patterns provided, check if folder matches any pattern
-		if len(patterns) > 0 {
-			matchesPattern := false
-			for _, pattern := range patterns {
-				if matched, _ := regexp.MatchString(pattern, folder.Name); matched {
-					matchesPattern = true
-					break
-				}
-			}
-			if !matchesPattern {
-				continue
-			}
-		}
-
-		// Extract date range: (MM/DD - MM/DD)
-		dateRegex := regexp.MustCompile(`\((\d{1,2}/\d{1,2})\s*-\s*(\d{1,2}/\d{1,2})\)`)
-		dateMatch := dateRegex.FindStringSubmatch(folder.Name)
-		if dateMatch == nil {
-			continue
-		}
-
-		startStr := dateMatch[1]
-		endStr := dateMatch[2]
-
-		// Parse dates
-		startDate, err := parseSprintDate(startStr, currentYear)
-		if err != nil {
-			continue
-		}
-
-		endDate, err := parseSprintDate(endStr, currentYear)
-		if err != nil {
-			continue
+// cachedSprint returns the cached sprint for a key, checking memory before disk
+func cachedSprint(cacheKey string) *models.Folder {
+	if cached, found := GetSprintCache().Get(cacheKey); found {
+		if folder, ok := cached.(*models.Folder); ok {
+			return folder
 		}
+	}
 
-		// Set to start of day for start date and end of day for end date
-		startDate = time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 0, 0, 0, 0, startDate.Location())
-		endDate = time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 23, 59, 59, 999999999, endDate.Location())
-
-		// Handle year boundary (e.g., Dec 15 - Jan 5)
-		if endDate.Before(startDate) {
-			// Sprint spans year boundary
-			if today.Month() <= time.Month(endDate.Month()) {
-				// We're in the new year part (Jan-Jun), so start was last year
-				startDate = time.Date(currentYear-1, startDate.Month(), startDate.Day(), 0, 0, 0, 0, startDate.Location())
-			} else {
-				// We're in the old year part (Jul-Dec), so end is next year
-				endDate = time.Date(currentYear+1, endDate.Month(), endDate.Day(), 23, 59, 59, 999999999, endDate.Location())
-			}
-		} else {
-			// Dates don't cross year boundary, but we need to handle year transitions
-			startMonth := int(startDate.Month())
-			todayMonth := int(today.Month())
-
-			// If sprint is in late year (Oct-Dec) and we're in early year (Jan-Mar),
-			// the sprint was probably last year
-			if startMonth >= 10 && todayMonth <= 3 {
-				startDate = time.Date(currentYear-1, startDate.Month(), startDate.Day(), 0, 0, 0, 0, startDate.Location())
-				endDate = time.Date(currentYear-1, endDate.Month(), endDate.Day(), 23, 59, 59, 999999999, endDate.Location())
-			} else if startMonth <= 3 && todayMonth >= 10 {
-				// If sprint is in early year (Jan-Mar) and we're in late year (Oct-Dec),
-				// the sprint is probably next year
-				startDate = time.Date(currentYear+1, startDate.Month(), startDate.Day(), 0, 0, 0, 0, startDate.Location())
-				endDate = time.Date(currentYear+1, endDate.Month(), endDate.Day(), 23, 59, 59, 999999999, endDate.Location())
-			}
-		}
+	folder := &models.Folder{}
+	if GetSprintCache().Load(cacheKey, folder) {
+		return folder
+	}
+	return nil
+}
 
-		matches = append(matches, SprintMatch{
-			Folder:    folder,
-			StartDate: startDate,
-			EndDate:   endDate,
-		})
+func findCurrentSprintByDateUncached(folders []*models.Folder, patterns []string) *models.Folder {
+	today := time.Now()
+	matches := ParseSprintMatches(folders, patterns, today)
 
-		// Check if today falls within this range
-		if (today.After(startDate) || today.Equal(startDate)) && (today.Before(endDate) || today.Equal(endDate)) {
-			return folder
+	// Check if today falls within any range
+	for _, match := range matches {
+		if (today.After(match.StartDate) || today.Equal(match.StartDate)) && (today.Before(match.EndDate) || today.Equal(match.EndDate)) {
+			return match.Folder
 		}
 	}
 
 	// If no exact match, find the closest upcoming or most recent sprint
 	if len(matches) > 0 {
-		// Sort by start date
-		for i := 0; i < len(matches)-1; i++ {
-			for j := i + 1; j < len(matches); j++ {
-				if matches[i].StartDate.After(matches[j].StartDate) {
-					matches[i], matches[j] = matches[j], matches[i]
-				}
-			}
-		}
-
 		// First, try to find an upcoming sprint that starts soon (within 7 days)
 		for _, match := range matches {
 			if match.StartDate.After(today) {
@@ -174,6 +109,137 @@ func findCurrentSprintByDateUncached(folders []*models.Folder, patterns []string
 	return nil
 }
 
+// ParseSprintMatches parses the date range of every folder matching the given
+// patterns and returns them sorted by start date. Folders without a
+// "(MM/DD - MM/DD)" range are skipped. Years are inferred relative to today.
+func ParseSprintMatches(folders []*models.Folder, patterns []string, today time.Time) []SprintMatch {
+	var matches []SprintMatch
+
+	for _, folder := range folders {
+		if !matchesAnyPattern(folder.Name, patterns) {
+			continue
+		}
+
+		match, ok := parseSprintMatch(folder, today)
+		if !ok {
+			continue
+		}
+		matches = append(matches, match)
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].StartDate.Before(matches[j].StartDate)
+	})
+
+	return matches
+}
+
+// FindAdjacentSprint returns the sprint offset positions away from the current
+// sprint (e.g. 1 for the next sprint, -1 for the previous one). It returns nil
+// if there is no current sprint or the offset runs past either end.
+func FindAdjacentSprint(folderID string, folders []*models.Folder, patterns []string, offset int) *models.Folder {
+	current := FindCurrentSprintByDate(folderID, folders, patterns)
+	if current == nil {
+		return nil
+	}
+	if offset == 0 {
+		return current
+	}
+
+	matches := ParseSprintMatches(folders, patterns, time.Now())
+	for i, match := range matches {
+		if match.Folder.ID != current.ID {
+			continue
+		}
+		target := i + offset
+		if target < 0 || target >= len(matches) {
+			return nil
+		}
+		return matches[target].Folder
+	}
+
+	return nil
+}
+
+// matchesAnyPattern reports whether name matches one of the patterns.
+// An empty pattern list matches everything.
+func matchesAnyPattern(name string, patterns []string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if matched, _ := regexp.MatchString(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// parseSprintMatch extracts the sprint date range from a folder name
+//
+//nolint:gocyclo // Sprint date range parsing requires multiple conditions
+func parseSprintMatch(folder *models.Folder, today time.Time) (SprintMatch, bool) {
+	currentYear := today.Year()
+
+	// Extract date range: (MM/DD - MM/DD)
+	dateMatch := sprintDateRangeRegex.FindStringSubmatch(folder.Name)
+	if dateMatch == nil {
+		return SprintMatch{}, false
+	}
+
+	startStr := dateMatch[1]
+	endStr := dateMatch[2]
+
+	// Parse dates
+	startDate, err := parseSprintDate(startStr, currentYear)
+	if err != nil {
+		return SprintMatch{}, false
+	}
+
+	endDate, err := parseSprintDate(endStr, currentYear)
+	if err != nil {
+		return SprintMatch{}, false
+	}
+
+	// Set to start of day for start date and end of day for end date
+	startDate = time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 0, 0, 0, 0, startDate.Location())
+	endDate = time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 23, 59, 59, 999999999, endDate.Location())
+
+	// Handle year boundary (e.g., Dec 15 - Jan 5)
+	if endDate.Before(startDate) {
+		// Sprint spans year boundary
+		if today.Month() <= time.Month(endDate.Month()) {
+			// We're in the new year part (Jan-Jun), so start was last year
+			startDate = time.Date(currentYear-1, startDate.Month(), startDate.Day(), 0, 0, 0, 0, startDate.Location())
+		} else {
+			// We're in the old year part (Jul-Dec), so end is next year
+			endDate = time.Date(currentYear+1, endDate.Month(), endDate.Day(), 23, 59, 59, 999999999, endDate.Location())
+		}
+	} else {
+		// Dates don't cross year boundary, but we need to handle year transitions
+		startMonth := int(startDate.Month())
+		todayMonth := int(today.Month())
+
+		// If sprint is in late year (Oct-Dec) and we're in early year (Jan-Mar),
+		// the sprint was probably last year
+		if startMonth >= 10 && todayMonth <= 3 {
+			startDate = time.Date(currentYear-1, startDate.Month(), startDate.Day(), 0, 0, 0, 0, startDate.Location())
+			endDate = time.Date(currentYear-1, endDate.Month(), endDate.Day(), 23, 59, 59, 999999999, endDate.Location())
+		} else if startMonth <= 3 && todayMonth >= 10 {
+			// If sprint is in early year (Jan-Mar) and we're in late year (Oct-Dec),
+			// the sprint is probably next year
+			startDate = time.Date(currentYear+1, startDate.Month(), startDate.Day(), 0, 0, 0, 0, startDate.Location())
+			endDate = time.Date(currentYear+1, endDate.Month(), endDate.Day(), 23, 59, 59, 999999999, endDate.Location())
+		}
+	}
+
+	return SprintMatch{
+		Folder:    folder,
+		StartDate: startDate,
+		EndDate:   endDate,
+	}, true
+}
+
 // parseSprintDate parses a date string in M/D format with a given year
 func parseSprintDate(dateStr string, year int) (time.Time, error) {
 	dateRegex := regexp.MustCompile(`(\d{1,2})/(\d{1,2})`)
@@ -183,7 +249,7 @@ func parseSprintDate(dateStr string, year int) (time.Time, error) {
 	}
 
 	var month, day int
-	_, err := parseInts(matches[1], matches[2], &month, &day)
+	_, err := parseInts(matches[1], &month, matches[2], &day)
 	if err != nil {
 		return time.Time{}, err
 	}
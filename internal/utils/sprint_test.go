@@ -0,0 +1,133 @@
+package utils
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rithyhuot/vibe/internal/models"
+)
+
+func TestParseSprintMatches_SortsByStartDate(t *testing.T) {
+	today := time.Date(2025, time.June, 10, 12, 0, 0, 0, time.Local)
+	folders := []*models.Folder{
+		{ID: "3", Name: "Sprint 3 (6/16 - 6/29)"},
+		{ID: "1", Name: "Sprint 1 (5/19 - 6/1)"},
+		{ID: "x", Name: "Backlog"},
+		{ID: "2", Name: "Sprint 2 (6/2 - 6/15)"},
+	}
+
+	matches := ParseSprintMatches(folders, []string{`Sprint \d+ \(`}, today)
+
+	require.Len(t, matches, 3)
+	assert.Equal(t, "1", matches[0].Folder.ID)
+	assert.Equal(t, "2", matches[1].Folder.ID)
+	assert.Equal(t, "3", matches[2].Folder.ID)
+	assert.Equal(t, time.Date(2025, time.June, 2, 0, 0, 0, 0, time.Local), matches[1].StartDate)
+	assert.Equal(t, 15, matches[1].EndDate.Day())
+}
+
+func TestParseSprintMatches_FiltersByPattern(t *testing.T) {
+	today := time.Date(2025, time.June, 10, 12, 0, 0, 0, time.Local)
+	folders := []*models.Folder{
+		{ID: "1", Name: "Sprint 1 (6/2 - 6/15)"},
+		{ID: "2", Name: "Commerce 1 (6/2 - 6/15)"},
+	}
+
+	matches := ParseSprintMatches(folders, []string{`^Commerce`}, today)
+
+	require.Len(t, matches, 1)
+	assert.Equal(t, "2", matches[0].Folder.ID)
+}
+
+func TestParseSprintMatches_YearBoundary(t *testing.T) {
+	today := time.Date(2025, time.January, 2, 12, 0, 0, 0, time.Local)
+	folders := []*models.Folder{
+		{ID: "1", Name: "Sprint 1 (12/22 - 1/4)"},
+	}
+
+	matches := ParseSprintMatches(folders, nil, today)
+
+	require.Len(t, matches, 1)
+	assert.Equal(t, 2024, matches[0].StartDate.Year())
+	assert.Equal(t, 2025, matches[0].EndDate.Year())
+}
+
+func TestFindAdjacentSprint_NoSprints(t *testing.T) {
+	folders := []*models.Folder{
+		{ID: "x", Name: "Backlog"},
+	}
+
+	assert.Nil(t, FindAdjacentSprint("folder-none", folders, []string{`adjacent-none`}, 1))
+	assert.Nil(t, FindAdjacentSprint("folder-none", nil, []string{`adjacent-none`}, -1))
+}
+
+func TestParseSprintMatches_NoMatch(t *testing.T) {
+	today := time.Date(2025, time.June, 10, 12, 0, 0, 0, time.Local)
+	folders := []*models.Folder{
+		{ID: "1", Name: "Sprint 1 (6/2 - 6/15)"},
+		{ID: "2", Name: "Backlog"},
+		{ID: "3", Name: "Commerce (no dates)"},
+	}
+
+	assert.Empty(t, ParseSprintMatches(folders, []string{`^Release`}, today))
+	assert.Empty(t, ParseSprintMatches(folders[1:], nil, today))
+	assert.Empty(t, ParseSprintMatches(nil, nil, today))
+}
+
+// sprintFolders returns three two-week sprints named after their dates: the
+// previous one, the current one, and the next one
+func sprintFolders() []*models.Folder {
+	start := time.Now().AddDate(0, 0, -3)
+	folders := make([]*models.Folder, 3)
+	for i := range folders {
+		from := start.AddDate(0, 0, (i-1)*14)
+		to := from.AddDate(0, 0, 13)
+		folders[i] = &models.Folder{
+			ID:   fmt.Sprintf("sprint-%d", i),
+			Name: fmt.Sprintf("Sprint %d (%d/%d - %d/%d)", i, from.Month(), from.Day(), to.Month(), to.Day()),
+		}
+	}
+	return folders
+}
+
+func TestFindAdjacentSprint(t *testing.T) {
+	folders := sprintFolders()
+	patterns := []string{`^Sprint`}
+
+	assert.Equal(t, "sprint-1", FindAdjacentSprint("folder-adjacent", folders, patterns, 0).ID)
+	assert.Equal(t, "sprint-2", FindAdjacentSprint("folder-adjacent", folders, patterns, 1).ID)
+	assert.Equal(t, "sprint-0", FindAdjacentSprint("folder-adjacent", folders, patterns, -1).ID)
+}
+
+func TestFindAdjacentSprint_Ends(t *testing.T) {
+	folders := sprintFolders()
+	patterns := []string{`^Sprint`}
+
+	// The current sprint is the first one, so there's no previous sprint
+	first := folders[1:]
+	assert.Equal(t, "sprint-1", FindAdjacentSprint("folder-first", first, patterns, 0).ID)
+	assert.Nil(t, FindAdjacentSprint("folder-first", first, patterns, -1))
+
+	// The current sprint is the last one, so there's no next sprint
+	last := folders[:2]
+	assert.Equal(t, "sprint-1", FindAdjacentSprint("folder-last", last, patterns, 0).ID)
+	assert.Nil(t, FindAdjacentSprint("folder-last", last, patterns, 1))
+	assert.Nil(t, FindAdjacentSprint("folder-last", last, patterns, 2))
+}
+
+func TestFindCurrentSprintByDate_CacheIsPerFolder(t *testing.T) {
+	patterns := []string{`^Sprint`}
+	folders := sprintFolders()
+	assert.Equal(t, "sprint-1", FindCurrentSprintByDate("folder-a", folders, patterns).ID)
+
+	// Another folder with the same patterns, where the cached ID is an old sprint
+	other := []*models.Folder{
+		{ID: "sprint-1", Name: folders[0].Name},
+		{ID: "other-current", Name: folders[1].Name},
+	}
+	assert.Equal(t, "other-current", FindCurrentSprintByDate("folder-b", other, patterns).ID)
+}